@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// Actions that can be applied to the resources found by GetAllResources.
+const (
+	// ActionDelete deletes the resources. It is the default.
+	ActionDelete = "delete"
+	// ActionSuspend pauses the resources, e.g. stops instances or scales them to zero, and records
+	// their previous state in a tag.
+	ActionSuspend = "suspend"
+	// ActionResume restores the state recorded by ActionSuspend.
+	ActionResume = "resume"
+)
+
+// SuspendableResourceTypes are the resource types that can be suspended and resumed.
+var SuspendableResourceTypes = []string{
+	"asg",
+	"ec2",
+	"ecs-service",
+	"rds-cluster",
+	"rds-instance",
+	"redshift",
+	"sagemaker-notebook-instance",
+}
+
+// ValidateAction returns an error if action is not one of the supported actions. An empty action
+// deletes.
+func ValidateAction(action string) error {
+	switch action {
+	case "", ActionDelete, ActionSuspend, ActionResume:
+		return nil
+	}
+	return InvalidActionError{Action: action}
+}
+
+// HandleSuspendResourceTypes restricts the resource types to those that can be suspended, unless
+// they were selected explicitly, in which case the others are kept so they can be reported as skipped.
+func HandleSuspendResourceTypes(resourceTypes []string, explicit bool) []string {
+	if explicit {
+		return resourceTypes
+	}
+	suspendable := []string{}
+	for _, resourceType := range resourceTypes {
+		if collections.ListContainsElement(SuspendableResourceTypes, resourceType) {
+			suspendable = append(suspendable, resourceType)
+		}
+	}
+	return suspendable
+}
+
+// actionUnsupportedError returns why the resources of a type are skipped by action, or nil if the
+// resource type supports it.
+func actionUnsupportedError(awsResource resources.AwsResource, action string) error {
+	if action != ActionSuspend && action != ActionResume {
+		return nil
+	}
+	if s, ok := awsResource.(resource.Suspendable); ok && s.CanSuspend() {
+		return nil
+	}
+	return fmt.Errorf("%s does not support %s", awsResource.ResourceName(), action)
+}
+
+// applyAction applies action to a batch of resources.
+func applyAction(ctx context.Context, awsResource resources.AwsResource, action string, batch []string) ([]resource.NukeResult, error) {
+	switch action {
+	case ActionSuspend:
+		return awsResource.(resource.Suspendable).Suspend(ctx, batch)
+	case ActionResume:
+		return awsResource.(resource.Suspendable).Resume(ctx, batch)
+	default:
+		return awsResource.Nuke(ctx, batch)
+	}
+}
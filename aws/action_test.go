@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspendableResourceTypesCanSuspend(t *testing.T) {
+	suspendable := map[string]bool{}
+	for _, r := range GetAllRegisteredResources() {
+		if s, ok := (*r).(resource.Suspendable); ok && s.CanSuspend() {
+			suspendable[(*r).ResourceName()] = true
+		}
+	}
+
+	for _, name := range SuspendableResourceTypes {
+		assert.True(t, suspendable[name], "%s is listed as suspendable but cannot be suspended", name)
+	}
+	assert.Len(t, suspendable, len(SuspendableResourceTypes), "every resource type that can be suspended must be listed")
+}
+
+func TestValidateAction(t *testing.T) {
+	for _, action := range []string{"", ActionDelete, ActionSuspend, ActionResume} {
+		require.NoError(t, ValidateAction(action))
+	}
+	require.Error(t, ValidateAction("stop"))
+}
+
+func TestHandleSuspendResourceTypes(t *testing.T) {
+	all := []string{"ec2", "s3", "asg", "vpc"}
+	assert.Equal(t, []string{"ec2", "asg"}, HandleSuspendResourceTypes(all, false))
+
+	// Explicitly selected types are kept so they can be reported as unsupported
+	assert.Equal(t, all, HandleSuspendResourceTypes(all, true))
+}
+
+// interruptedResource cancels the run while nuking its first batch, like a SIGINT during a deletion.
+type interruptedResource struct {
+	resources.AwsResource
+	identifiers []string
+	interrupt   context.CancelCauseFunc
+	batches     [][]string
+}
+
+func (r *interruptedResource) ResourceName() string           { return "interrupted" }
+func (r *interruptedResource) ResourceIdentifiers() []string  { return r.identifiers }
+func (r *interruptedResource) MaxBatchSize() int              { return 2 }
+func (r *interruptedResource) IsNukable(string) (bool, error) { return true, nil }
+func (r *interruptedResource) Nuke(ctx context.Context, identifiers []string) ([]resource.NukeResult, error) {
+	r.batches = append(r.batches, identifiers)
+	r.interrupt(util.ErrInterrupted)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	results := make([]resource.NukeResult, 0, len(identifiers))
+	for _, id := range identifiers {
+		results = append(results, resource.NukeResult{Identifier: id})
+	}
+	return results, nil
+}
+
+type eventRecorder struct {
+	events []reporting.Event
+}
+
+func (r *eventRecorder) OnEvent(event reporting.Event) { r.events = append(r.events, event) }
+
+func TestApplyActionInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	var r resources.AwsResource = &interruptedResource{identifiers: []string{"a", "b", "c", "d", "e"}, interrupt: cancel}
+	account := &AwsAccountResources{Resources: map[string]AwsResources{
+		"us-east-1": {Resources: []*resources.AwsResource{&r}},
+	}}
+	recorder := &eventRecorder{}
+	collector := reporting.NewCollector()
+	collector.AddRenderer(recorder)
+
+	err := ApplyAction(ctx, account, []string{"us-east-1"}, 1, collector, ActionDelete)
+	require.ErrorIs(t, err, util.ErrInterrupted)
+
+	// The batch in flight finishes and no other batch is started
+	assert.Equal(t, [][]string{{"a", "b"}}, r.(*interruptedResource).batches)
+
+	var deleted []string
+	var interrupted *reporting.NukeInterrupted
+	for _, event := range recorder.events {
+		switch e := event.(type) {
+		case reporting.ResourceDeleted:
+			assert.True(t, e.Success)
+			deleted = append(deleted, e.Identifier)
+		case reporting.NukeInterrupted:
+			interrupted = &e
+		}
+	}
+	assert.Equal(t, []string{"a", "b"}, deleted)
+	require.NotNil(t, interrupted)
+	assert.Equal(t, util.ErrInterrupted.Error(), interrupted.Reason)
+	assert.Equal(t, reporting.Unattempted("interrupted", "us-east-1", []string{"c", "d", "e"}), interrupted.NotAttempted)
+	assert.IsType(t, reporting.NukeComplete{}, recorder.events[len(recorder.events)-1])
+}
+
+// protectedResource reports "protected" as protected and "denied" as failing the permission dry-run.
+type protectedResource struct {
+	resources.AwsResource
+	nuked []string
+}
+
+func (r *protectedResource) ResourceName() string { return "protected" }
+func (r *protectedResource) ResourceIdentifiers() []string {
+	return []string{"ok", "protected", "denied"}
+}
+func (r *protectedResource) MaxBatchSize() int { return 10 }
+func (r *protectedResource) IsNukable(id string) (bool, error) {
+	switch id {
+	case "protected":
+		return false, util.ProtectedResourceError{Reason: "managed by terraform: aws_instance.a"}
+	case "denied":
+		return false, errors.New("UnauthorizedOperation")
+	}
+	return true, nil
+}
+func (r *protectedResource) Nuke(ctx context.Context, identifiers []string) ([]resource.NukeResult, error) {
+	r.nuked = append(r.nuked, identifiers...)
+	results := make([]resource.NukeResult, 0, len(identifiers))
+	for _, id := range identifiers {
+		results = append(results, resource.NukeResult{Identifier: id})
+	}
+	return results, nil
+}
+
+func TestApplyActionSkipsOnlyProtectedResources(t *testing.T) {
+	var r resources.AwsResource = &protectedResource{}
+	account := &AwsAccountResources{Resources: map[string]AwsResources{
+		"us-east-1": {Resources: []*resources.AwsResource{&r}},
+	}}
+
+	err := ApplyAction(context.Background(), account, []string{"us-east-1"}, 1, reporting.NewCollector(), ActionDelete)
+	require.NoError(t, err)
+
+	// Resources that failed the permission dry-run are still attempted
+	assert.Equal(t, []string{"ok", "denied"}, r.(*protectedResource).nuked)
+}
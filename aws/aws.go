@@ -3,19 +3,19 @@ package aws
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
 	"time"
 
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/gruntwork-io/cloud-nuke/aws/resources"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/hashicorp/go-multierror"
 
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
-
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/reporting"
@@ -25,6 +25,12 @@ import (
 
 // GetAllResources - Lists all aws resources
 func GetAllResources(c context.Context, query *Query, configObj config.Config, collector *reporting.Collector) (*AwsAccountResources, error) {
+	// Opt-in resource types are skipped unless selected explicitly or configured in the config file
+	explicit := !query.implicitResourceTypes && len(query.ResourceTypes) > 0 && !slices.Contains(query.ResourceTypes, "all")
+	unselected := unselectedOptInResourceTypes(configObj, explicit)
+
+	// Plugins and Cloud Control types need their own config entries before the global filters below are applied
+	configObj.PrepareDynamicResourceTypes(registeredPluginNames())
 	configObj.AddExcludeAfterTime(query.ExcludeAfter)
 	configObj.AddIncludeAfterTime(query.IncludeAfter)
 	configObj.AddIncludeTags(query.IncludeTags)
@@ -37,6 +43,24 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 	// Setting the DefaultOnly field
 	// This function only sets the objects that have the `DefaultOnly` field, currently VPC, Subnet, and Security Group.
 	configObj.AddEC2DefaultOnly(query.DefaultOnly)
+	configObj.AddVpcIDs(query.VpcIDs)
+	configObj.AddProtectedIdentifiers(query.ProtectedIdentifiers)
+
+	// Resources created by CloudFormation are skipped, and in stack-first mode their stacks are deleted instead
+	var stackOwners *config.CloudFormationStackSet
+	protectStackMembersIfFiltered(query, &configObj)
+	if configObj.CloudFormationMembers != "" {
+		stackOwners = config.NewCloudFormationStackSet()
+		configObj.SkipCloudFormationMembers(stackOwners)
+	}
+
+	// Listers record why resources were included, such as why they are orphaned or idle
+	findings := config.NewFindings()
+	configObj.RecordFindings(findings)
+	// In orphans-only mode, listers only return resources nothing uses
+	if query.OrphansOnly {
+		configObj.OnlyOrphans()
+	}
 
 	account := AwsAccountResources{
 		Resources: make(map[string]AwsResources),
@@ -54,7 +78,9 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 	}
 	type regionSetup struct {
 		regionCtx context.Context
+		session   awsgo.Config
 		nukeable  []indexedResource
+		stacksIdx int
 	}
 
 	// Phase 1: set up sessions and init resources for each region concurrently.
@@ -79,9 +105,12 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 				regionCtx = context.WithValue(c, util.AccountIdKey, accountId)
 			}
 			registeredResources := GetAndInitRegisteredResources(cloudNukeSession, region)
-			setup := &regionSetup{regionCtx: regionCtx}
+			setup := &regionSetup{regionCtx: regionCtx, session: cloudNukeSession}
 			for i, res := range registeredResources {
-				if IsNukeable((*res).ResourceName(), query.ResourceTypes) {
+				if (*res).ResourceName() == cloudFormationStackResourceType {
+					setup.stacksIdx = i
+				}
+				if IsNukeable((*res).ResourceName(), query.ResourceTypes) && !slices.Contains(unselected, (*res).ResourceName()) {
 					setup.nukeable = append(setup.nukeable, indexedResource{idx: i, resource: res})
 				}
 			}
@@ -139,11 +168,7 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 			if err != nil {
 				logging.Errorf("Unable to retrieve %v, %v", (*task.resource).ResourceName(), err)
 
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: fmt.Sprintf("error:GetIdentifiers:%s", (*task.resource).ResourceName()),
-				}, map[string]interface{}{
-					"region": task.region,
-				})
+				telemetry.Track(telemetry.GetIdentifiersError((*task.resource).ResourceName(), task.region, err))
 
 				collector.Emit(reporting.GeneralError{
 					ResourceType: (*task.resource).ResourceName(),
@@ -153,12 +178,7 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 				return nil
 			}
 
-			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: fmt.Sprintf("Done getting %s identifiers", (*task.resource).ResourceName()),
-			}, map[string]interface{}{
-				"recordCount": len(identifiers),
-				"actionTime":  time.Since(start).Seconds(),
-			})
+			telemetry.Track(telemetry.GetIdentifiersDone((*task.resource).ResourceName(), task.region, len(identifiers), time.Since(start)))
 
 			if len(identifiers) > 0 {
 				logging.Infof("Found %d %s resources in %s", len(identifiers), (*task.resource).ResourceName(), task.region)
@@ -167,19 +187,7 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 				foundByRegion[task.region] = append(foundByRegion[task.region], indexedResource{task.idx, task.resource})
 				foundMu.Unlock()
 
-				for _, id := range identifiers {
-					nukable, reason := true, ""
-					if _, err := (*task.resource).IsNukable(id); err != nil {
-						nukable, reason = false, err.Error()
-					}
-					collector.Emit(reporting.ResourceFound{
-						ResourceType: (*task.resource).ResourceName(),
-						Region:       task.region,
-						Identifier:   id,
-						Nukable:      nukable,
-						Reason:       reason,
-					})
-				}
+				emitResourcesFound(collector, task.resource, task.region, identifiers, findings, query.Action)
 			}
 			return nil
 		})
@@ -188,6 +196,45 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 		return nil, err
 	}
 
+	// In stack-first mode, list the stacks that own the skipped resources, except stacks that were
+	// already found by the cloudformation-stack resource type
+	if configObj.CloudFormationMembers == config.CloudFormationMembersStackFirst {
+		for _, region := range query.Regions {
+			setup, ok := setups[region]
+			if !ok {
+				continue
+			}
+			var found []string
+			for _, r := range foundByRegion[region] {
+				if (*r.resource).ResourceName() == cloudFormationStackResourceType {
+					found = append(found, (*r.resource).ResourceIdentifiers()...)
+				}
+			}
+			names := cloudFormationStackNames(stackOwners.IDs(), region, found)
+			if len(names) == 0 {
+				continue
+			}
+
+			stacks := resources.NewCloudFormationStacks()
+			stacks.Init(setup.session)
+			identifiers, err := stacks.GetAndSetIdentifiers(setup.regionCtx, stackOwnersConfig(configObj, names))
+			if err != nil {
+				logging.Errorf("Unable to retrieve the CloudFormation stacks owning skipped resources in %s, %v", region, err)
+				collector.Emit(reporting.GeneralError{
+					ResourceType: cloudFormationStackResourceType,
+					Description:  fmt.Sprintf("Unable to retrieve the CloudFormation stacks owning skipped resources in %s", region),
+					Error:        err.Error(),
+				})
+				continue
+			}
+			if len(identifiers) > 0 {
+				logging.Infof("Found %d CloudFormation stacks owning skipped resources in %s", len(identifiers), region)
+				foundByRegion[region] = append(foundByRegion[region], indexedResource{setup.stacksIdx, &stacks})
+				emitResourcesFound(collector, &stacks, region, identifiers, nil, query.Action)
+			}
+		}
+	}
+
 	// Sort resources within each region by original registry index to preserve
 	// the dependency ordering required for safe nuking (e.g. EC2 before VPCs).
 	for region, found := range foundByRegion {
@@ -207,6 +254,33 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 	return &account, nil
 }
 
+// emitResourcesFound reports the identifiers found for a resource type, with their nukable status and,
+// when findings is set, why they were included. Resources of types that do not support action are
+// reported as not nukable.
+func emitResourcesFound(collector *reporting.Collector, resource *resources.AwsResource, region string, identifiers []string, findings *config.Findings, action string) {
+	unsupported := actionUnsupportedError(*resource, action)
+	for _, id := range identifiers {
+		nukable, reason := true, ""
+		if _, err := (*resource).IsNukable(id); err != nil {
+			nukable, reason = false, err.Error()
+		} else if unsupported != nil {
+			nukable, reason = false, unsupported.Error()
+		}
+		var detail string
+		if findings != nil {
+			detail = findings.Detail(region, id)
+		}
+		collector.Emit(reporting.ResourceFound{
+			ResourceType: (*resource).ResourceName(),
+			Region:       region,
+			Identifier:   id,
+			Nukable:      nukable,
+			Reason:       reason,
+			Detail:       detail,
+		})
+	}
+}
+
 // ListResourceTypes - Returns list of resources which can be passed to --resource-type
 func ListResourceTypes() []string {
 	resourceTypes := []string{}
@@ -233,18 +307,40 @@ func IsNukeable(resourceType string, resourceTypes []string) bool {
 	return false
 }
 
-func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources, region string, collector *reporting.Collector) error {
+// nukeAllResourcesInRegion applies action to the resources of a region batch by batch. Once ctx is
+// canceled, the batch in flight is given util.InterruptGracePeriod to finish and no new batch is
+// started; the resources of the batches that were not started are returned.
+func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources, region string, collector *reporting.Collector, action string) ([]reporting.UnattemptedResource, error) {
 	var allErrors *multierror.Error
+	var notAttempted []reporting.UnattemptedResource
 	resourcesInRegion := account.Resources[region]
 
 	for _, awsResource := range resourcesInRegion.Resources {
-		length := len((*awsResource).ResourceIdentifiers())
+		if err := actionUnsupportedError(*awsResource, action); err != nil {
+			logging.Debugf("[Skipping] %s in %s because %v", (*awsResource).ResourceName(), region, err)
+			continue
+		}
+
+		// Skip protected resources. Resources that failed the permission dry-run are still attempted.
+		var nukableIdentifiers []string
+		for _, id := range (*awsResource).ResourceIdentifiers() {
+			if _, reason := (*awsResource).IsNukable(id); errors.As(reason, &util.ProtectedResourceError{}) {
+				logging.Debugf("[Skipping] %s %s because %v", (*awsResource).ResourceName(), id, reason)
+				continue
+			}
+			nukableIdentifiers = append(nukableIdentifiers, id)
+		}
 
 		// Split api calls into batches
-		logging.Debugf("Terminating %d awsResource in batches", length)
-		batches := util.Split((*awsResource).ResourceIdentifiers(), (*awsResource).MaxBatchSize())
+		logging.Debugf("Terminating %d awsResource in batches", len(nukableIdentifiers))
+		batches := util.Split(nukableIdentifiers, (*awsResource).MaxBatchSize())
 
 		for i, batch := range batches {
+			if ctx.Err() != nil {
+				notAttempted = append(notAttempted, reporting.Unattempted((*awsResource).ResourceName(), region, slices.Concat(batches[i:]...))...)
+				break
+			}
+
 			// Emit progress event (CLIRenderer updates its progress bar)
 			collector.Emit(reporting.NukeProgress{
 				ResourceType: (*awsResource).ResourceName(),
@@ -252,7 +348,9 @@ func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources,
 				BatchSize:    len(batch),
 			})
 
-			results, err := (*awsResource).Nuke(ctx, batch)
+			batchCtx, cancel := util.WithGracePeriod(ctx, util.InterruptGracePeriod)
+			results, err := applyAction(batchCtx, *awsResource, action, batch)
+			cancel()
 
 			// Emit ResourceDeleted for each result
 			for _, result := range results {
@@ -267,6 +365,9 @@ func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources,
 					Success:      result.Error == nil,
 					Warning:      result.Error != nil && util.IsWarningError(result.Error),
 					Error:        errStr,
+					Detail:       result.Detail,
+					Modified:     result.Modified,
+					Skipped:      result.Skipped,
 				})
 			}
 
@@ -276,63 +377,61 @@ func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources,
 					logging.Debug(
 						"Request limit reached. Waiting 1 minute before making new requests",
 					)
-					time.Sleep(1 * time.Minute)
+					util.Sleep(ctx, 1*time.Minute)
 					continue
 				}
 
 				allErrors = multierror.Append(allErrors, fmt.Errorf("[%s] %s: %w", region, (*awsResource).ResourceName(), err))
 
 				// Report to telemetry - aggregated metrics of failures per resources.
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: fmt.Sprintf("error:Nuke:%s", (*awsResource).ResourceName()),
-				}, map[string]interface{}{
-					"region": region,
-				})
+				telemetry.Track(telemetry.NukeError((*awsResource).ResourceName(), region, err))
 			}
 
 			if i != len(batches)-1 {
 				logging.Debug("Sleeping for 10 seconds before processing next batch...")
-				time.Sleep(10 * time.Second)
+				util.Sleep(ctx, 10*time.Second)
 			}
 		}
 	}
 
-	return allErrors.ErrorOrNil()
+	return notAttempted, allErrors.ErrorOrNil()
 }
 
 // NukeAllResources - Nukes all aws resources
 func NukeAllResources(ctx context.Context, account *AwsAccountResources, regions []string, parallelism int, collector *reporting.Collector) error {
+	return ApplyAction(ctx, account, regions, parallelism, collector, ActionDelete)
+}
+
+// ApplyAction applies an action (ActionDelete, ActionSuspend or ActionResume) to all aws resources.
+// Suspend and resume skip the resource types that do not support them.
+func ApplyAction(ctx context.Context, account *AwsAccountResources, regions []string, parallelism int, collector *reporting.Collector, action string) error {
+	if action == "" {
+		action = ActionDelete
+	}
+
 	// Inject parallelism into context so batch_deleter (called via Nuke) can read it.
 	ctx = context.WithValue(ctx, util.ParallelismKey, parallelism)
 	p := util.GetParallelism(ctx)
 
-	collector.Emit(reporting.NukeStarted{Total: account.TotalResourceCount()})
-	telemetry.TrackEvent(commonTelemetry.EventContext{
-		EventName: "Begin nuking resources",
-	}, map[string]interface{}{})
+	collector.Emit(reporting.NukeStarted{Total: account.TotalResourceCount(), Action: action})
+	telemetry.Track(telemetry.NukeBegan())
 
 	var mu sync.Mutex
 	var allErrors *multierror.Error
+	var notAttempted []reporting.UnattemptedResource
 
 	nukeRegion := func(region string) {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Creating session for region",
-		}, map[string]interface{}{
-			"region": region,
-		})
+		telemetry.Track(telemetry.RegionNukeStarted(region))
 
-		if err := nukeAllResourcesInRegion(ctx, account, region, collector); err != nil {
-			mu.Lock()
+		unattempted, err := nukeAllResourcesInRegion(ctx, account, region, collector, action)
+		mu.Lock()
+		notAttempted = append(notAttempted, unattempted...)
+		if err != nil {
 			allErrors = multierror.Append(allErrors, err)
-			mu.Unlock()
 		}
+		mu.Unlock()
 
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Done Nuking Region",
-		}, map[string]interface{}{
-			"region":        region,
-			"resourceCount": len(account.Resources[region].Resources),
-		})
+		telemetry.Track(telemetry.RegionNukeDone(region, len(account.Resources[region].Resources)))
 	}
 
 	// Phase 1: nuke all regional resources in parallel. GlobalRegion is
@@ -361,6 +460,13 @@ func NukeAllResources(ctx context.Context, account *AwsAccountResources, regions
 		}
 	}
 
+	// Report the resources that were never attempted if the operation was interrupted
+	if ctx.Err() != nil {
+		logging.Warnf("Nuking interrupted: %d resources were not attempted", len(notAttempted))
+		collector.Emit(reporting.NukeInterrupted{Reason: context.Cause(ctx).Error(), NotAttempted: notAttempted})
+		allErrors = multierror.Append(allErrors, context.Cause(ctx))
+	}
+
 	// Emit NukeComplete event (triggers final output in renderers)
 	collector.Emit(reporting.NukeComplete{})
 
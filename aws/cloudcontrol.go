@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"regexp"
+	"slices"
+	"sync"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+// cloudControlTypeName matches CloudFormation resource type names such as "AWS::Glue::Job".
+var cloudControlTypeName = regexp.MustCompile(`^[A-Za-z0-9]+::[A-Za-z0-9]+::[A-Za-z0-9]+$`)
+
+var (
+	cloudControlMu              sync.RWMutex
+	registeredCloudControlTypes []config.CloudControlResourceType
+)
+
+// RegisterCloudControlTypes replaces the set of resource types nuked through the Cloud Control API,
+// usually with the `cloudcontrol` entries of the config file. Passing nil removes all of them.
+func RegisterCloudControlTypes(decls []config.CloudControlResourceType) error {
+	seen := make(map[string]bool, len(decls))
+	for _, decl := range decls {
+		if !cloudControlTypeName.MatchString(decl.Type) {
+			return InvalidCloudControlTypeError{Type: decl.Type, Reason: "expected a CloudFormation type name such as AWS::Glue::Job"}
+		}
+		if seen[decl.Type] {
+			return InvalidCloudControlTypeError{Type: decl.Type, Reason: "declared more than once"}
+		}
+		seen[decl.Type] = true
+	}
+
+	cloudControlMu.Lock()
+	defer cloudControlMu.Unlock()
+	registeredCloudControlTypes = slices.Clone(decls)
+	return nil
+}
+
+// withCloudControl appends the registered Cloud Control types of the given scope to a list of
+// resources, so they are nuked after every built-in and plugin resource type of the same scope.
+func withCloudControl(res []resources.AwsResource, global bool) []resources.AwsResource {
+	cloudControlMu.RLock()
+	defer cloudControlMu.RUnlock()
+
+	for _, decl := range registeredCloudControlTypes {
+		if decl.Global == global {
+			res = append(res, resources.NewCloudControlResource(decl))
+		}
+	}
+	return res
+}
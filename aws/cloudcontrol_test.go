@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCloudControlTypes(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, RegisterCloudControlTypes(nil)) })
+
+	tests := []struct {
+		name  string
+		decls []config.CloudControlResourceType
+		err   string
+	}{
+		{name: "valid", decls: []config.CloudControlResourceType{{Type: "AWS::Glue::Job"}, {Type: "AWS::IAM::OIDCProvider", Global: true}}},
+		{name: "not a type name", decls: []config.CloudControlResourceType{{Type: "glue-job"}}, err: "expected a CloudFormation type name"},
+		{name: "duplicate", decls: []config.CloudControlResourceType{{Type: "AWS::Glue::Job"}, {Type: "AWS::Glue::Job"}}, err: "declared more than once"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := RegisterCloudControlTypes(tc.decls)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestWithCloudControl(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, RegisterCloudControlTypes(nil)) })
+
+	require.NoError(t, RegisterCloudControlTypes([]config.CloudControlResourceType{
+		{Type: "AWS::Glue::Job"},
+		{Type: "AWS::IAM::OIDCProvider", Global: true},
+	}))
+
+	regional := resourceNames(withCloudControl(getRegisteredRegionalResources(), false))
+	require.Equal(t, "AWS::Glue::Job", regional[len(regional)-1])
+	require.NotContains(t, regional, "AWS::IAM::OIDCProvider")
+
+	global := resourceNames(withCloudControl(getRegisteredGlobalResources(), true))
+	require.Equal(t, "AWS::IAM::OIDCProvider", global[len(global)-1])
+
+	require.Subset(t, ListResourceTypes(), []string{"AWS::Glue::Job", "AWS::IAM::OIDCProvider"})
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// cloudFormationStackResourceType is the resource type that deletes the stacks owning skipped
+// resources in stack-first mode.
+const cloudFormationStackResourceType = "cloudformation-stack"
+
+// cloudFormationStackNames returns the names of the stacks in region among the given stack IDs,
+// which are ARNs such as arn:aws:cloudformation:us-east-1:123456789012:stack/my-stack/<uuid>,
+// leaving out the stacks in exclude.
+func cloudFormationStackNames(stackIDs []string, region string, exclude []string) []string {
+	var names []string
+	for _, id := range stackIDs {
+		parts := strings.SplitN(id, ":", 6)
+		if len(parts) != 6 || parts[3] != region {
+			continue
+		}
+		resource := strings.Split(parts[5], "/")
+		if len(resource) < 2 || resource[0] != "stack" {
+			continue
+		}
+		if name := resource[1]; !slices.Contains(exclude, name) && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// stackOwnersConfig returns a config that lists exactly the named stacks. Exclusions configured for
+// CloudFormationStack still apply, so that stacks the user excluded are never deleted.
+func stackOwnersConfig(configObj config.Config, names []string) config.Config {
+	var expressions []config.Expression
+	for _, name := range names {
+		expressions = append(expressions, config.Expression{RE: *regexp.MustCompile("^" + regexp.QuoteMeta(name) + "$")})
+	}
+	configObj.CloudFormationStack.ResourceType = config.ResourceType{
+		IncludeRule:        config.FilterRule{NamesRegExp: expressions},
+		ExcludeRule:        configObj.CloudFormationStack.ExcludeRule,
+		Timeout:            configObj.CloudFormationStack.Timeout,
+		ProtectUntilExpire: configObj.CloudFormationStack.ProtectUntilExpire,
+	}
+	return configObj
+}
+
+// protectStackMembersIfFiltered downgrades stack-first to protect when resources are filtered by
+// what uses them. Stacks are recorded before those filters run, and a stack owning an orphan or an
+// idle resource may also own resources that are in use, so it must not be deleted as a unit.
+func protectStackMembersIfFiltered(query *Query, configObj *config.Config) {
+	if configObj.CloudFormationMembers != config.CloudFormationMembersStackFirst {
+		return
+	}
+	if query.OrphansOnly {
+		logging.Warnf("cloudformation_members is %s, but stack members are only protected with --orphans-only", config.CloudFormationMembersStackFirst)
+		configObj.CloudFormationMembers = config.CloudFormationMembersProtect
+	} else if configObj.HasIdleRules() {
+		logging.Warnf("cloudformation_members is %s, but stack members are only protected when idle rules are set", config.CloudFormationMembersStackFirst)
+		configObj.CloudFormationMembers = config.CloudFormationMembersProtect
+	}
+}
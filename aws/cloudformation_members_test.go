@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudFormationStackNames(t *testing.T) {
+	stackIDs := []string{
+		"arn:aws:cloudformation:us-east-1:123456789012:stack/app/1111",
+		"arn:aws:cloudformation:us-east-1:123456789012:stack/found/2222",
+		"arn:aws:cloudformation:us-west-2:123456789012:stack/west/3333",
+		"not-an-arn",
+	}
+
+	assert.Equal(t, []string{"app"}, cloudFormationStackNames(stackIDs, "us-east-1", []string{"found"}))
+	assert.Equal(t, []string{"west"}, cloudFormationStackNames(stackIDs, "us-west-2", nil))
+	assert.Empty(t, cloudFormationStackNames(stackIDs, GlobalRegion, nil))
+}
+
+func TestStackOwnersConfig(t *testing.T) {
+	configObj := config.Config{}
+	configObj.CloudFormationStack.ExcludeRule.NamesRegExp = []config.Expression{{RE: *regexp.MustCompile("^keep$")}}
+
+	rt := stackOwnersConfig(configObj, []string{"app.v1", "keep"}).CloudFormationStack
+	assert.True(t, rt.ShouldInclude(config.ResourceValue{Name: aws.String("app.v1")}))
+	assert.False(t, rt.ShouldInclude(config.ResourceValue{Name: aws.String("appxv1")}))
+	assert.False(t, rt.ShouldInclude(config.ResourceValue{Name: aws.String("keep")}))
+	assert.False(t, rt.ShouldInclude(config.ResourceValue{Name: aws.String("other")}))
+}
+
+func TestProtectStackMembersIfFiltered(t *testing.T) {
+	tests := map[string]struct {
+		query    Query
+		idle     *config.IdleRule
+		expected string
+	}{
+		"unfiltered": {expected: config.CloudFormationMembersStackFirst},
+		"orphansOnly": {
+			query:    Query{OrphansOnly: true},
+			expected: config.CloudFormationMembersProtect,
+		},
+		// Stacks are recorded before the idle filter runs, so a stack could own busy resources
+		"idleRule": {
+			idle:     &config.IdleRule{Max: 2, Days: 14},
+			expected: config.CloudFormationMembersProtect,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			configObj := config.Config{CloudFormationMembers: config.CloudFormationMembersStackFirst}
+			configObj.EC2.Idle = tc.idle
+			protectStackMembersIfFiltered(&tc.query, &configObj)
+			assert.Equal(t, tc.expected, configObj.CloudFormationMembers)
+		})
+	}
+}
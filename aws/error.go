@@ -26,6 +26,30 @@ func (err InvalidResourceTypesSuppliedError) Error() string {
 	return fmt.Sprintf("Invalid resourceTypes %s specified: %s", err.InvalidTypes, "Try --list-resource-types to get a list of valid resource types.")
 }
 
+type ResourceTypesNotVpcScopedError struct {
+	InvalidTypes []string
+}
+
+func (err ResourceTypesNotVpcScopedError) Error() string {
+	return fmt.Sprintf("Resource types %s can not be restricted to a VPC. Remove them or the --vpc-id flag.", err.InvalidTypes)
+}
+
+type ResourceTypesNotOrphanAwareError struct {
+	InvalidTypes []string
+}
+
+func (err ResourceTypesNotOrphanAwareError) Error() string {
+	return fmt.Sprintf("Resource types %s can not detect orphaned resources. Remove them or the --orphans-only flag.", err.InvalidTypes)
+}
+
+type InvalidActionError struct {
+	Action string
+}
+
+func (err InvalidActionError) Error() string {
+	return fmt.Sprintf("Invalid action %q: must be delete, suspend or resume.", err.Action)
+}
+
 type ResourceTypeAndExcludeFlagsBothPassedError struct{}
 
 func (err ResourceTypeAndExcludeFlagsBothPassedError) Error() string {
@@ -56,3 +80,21 @@ type ResourceInspectionError struct {
 func (err ResourceInspectionError) Error() string {
 	return fmt.Sprintf("Error encountered when querying for account resources. Original error: %v", err.Underlying)
 }
+
+type InvalidPluginError struct {
+	Path   string
+	Reason string
+}
+
+func (err InvalidPluginError) Error() string {
+	return fmt.Sprintf("Invalid plugin %s: %s", err.Path, err.Reason)
+}
+
+type InvalidCloudControlTypeError struct {
+	Type   string
+	Reason string
+}
+
+func (err InvalidCloudControlTypeError) Error() string {
+	return fmt.Sprintf("Invalid cloudcontrol resource type %q: %s", err.Type, err.Reason)
+}
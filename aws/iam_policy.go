@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// baseIAMActions are needed by every run, whatever the resource types: enabled regions are discovered
+// with ec2:DescribeRegions.
+var baseIAMActions = []string{"ec2:DescribeRegions"}
+
+// IAMPolicyDocument is an identity-based IAM policy.
+type IAMPolicyDocument struct {
+	Version   string               `json:"Version"`
+	Statement []IAMPolicyStatement `json:"Statement"`
+}
+
+// IAMPolicyStatement is a single statement of an IAMPolicyDocument.
+type IAMPolicyStatement struct {
+	Sid      string   `json:"Sid"`
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// IAMSimulatorAPI is the subset of the IAM client used to check the calling principal's permissions.
+type IAMSimulatorAPI interface {
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	SimulatePrincipalPolicy(ctx context.Context, params *iam.SimulatePrincipalPolicyInput, optFns ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error)
+}
+
+// CallerIdentityAPI is the subset of the STS client used to identify the calling principal.
+type CallerIdentityAPI interface {
+	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// RequiredIAMActions returns the sorted IAM actions needed to inspect the given resource types, or
+// with nuke set, to nuke them. All registered resource types are used when resourceTypes is empty.
+// Resource types that declare no actions, such as plugins without list_actions, are returned in
+// undeclared.
+func RequiredIAMActions(resourceTypes []string, nuke bool) (actions []string, undeclared []string) {
+	actions = slices.Clone(baseIAMActions)
+	seen := make(map[string]bool)
+
+	for _, r := range GetAllRegisteredResources() {
+		name := (*r).ResourceName()
+		if !IsNukeable(name, resourceTypes) || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		perms := (*r).IAMPermissions()
+		if len(perms.List) == 0 && len(perms.Nuke) == 0 {
+			undeclared = append(undeclared, name)
+			continue
+		}
+		actions = append(actions, perms.List...)
+		if nuke {
+			actions = append(actions, perms.Nuke...)
+		}
+	}
+
+	slices.Sort(actions)
+	slices.Sort(undeclared)
+	return slices.Compact(actions), undeclared
+}
+
+// NewIAMPolicy returns a policy that allows the given actions on all resources. cloud-nuke discovers
+// the resources it acts on, so the actions cannot be scoped to resource ARNs up front.
+func NewIAMPolicy(sid string, actions []string) IAMPolicyDocument {
+	return IAMPolicyDocument{
+		Version: "2012-10-17",
+		Statement: []IAMPolicyStatement{{
+			Sid:      sid,
+			Effect:   "Allow",
+			Action:   actions,
+			Resource: "*",
+		}},
+	}
+}
+
+// MissingIAMActions evaluates the given actions for the calling principal with
+// iam:SimulatePrincipalPolicy and returns those that are not allowed. The root user is allowed
+// every action and cannot be simulated, so nothing is reported missing for it.
+func MissingIAMActions(ctx context.Context, stsClient CallerIdentityAPI, iamClient IAMSimulatorAPI, actions []string) ([]string, error) {
+	identity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	principal, err := simulatablePrincipal(ctx, iamClient, aws.ToString(identity.Arn))
+	if err != nil {
+		return nil, err
+	}
+	if principal == "" {
+		return nil, nil
+	}
+
+	var missing []string
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(iamClient, &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principal),
+		ActionNames:     actions,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, result := range page.EvaluationResults {
+			if result.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+				missing = append(missing, aws.ToString(result.EvalActionName))
+			}
+		}
+	}
+
+	slices.Sort(missing)
+	return missing, nil
+}
+
+// simulatablePrincipal converts a caller identity ARN to the IAM user or role ARN accepted by
+// iam:SimulatePrincipalPolicy. Assumed-role sessions are resolved to their role, whose ARN may
+// include a path. An empty ARN is returned for the root user.
+func simulatablePrincipal(ctx context.Context, iamClient IAMSimulatorAPI, callerArn string) (string, error) {
+	parts := strings.SplitN(callerArn, ":", 6)
+	if len(parts) != 6 {
+		return callerArn, nil
+	}
+	resource := parts[5]
+
+	switch {
+	case resource == "root":
+		return "", nil
+	case strings.HasPrefix(resource, "assumed-role/"):
+		roleName := strings.Split(resource, "/")[1]
+		output, err := iamClient.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+		if err != nil {
+			return "", errors.WithStackTrace(err)
+		}
+		return aws.ToString(output.Role.Arn), nil
+	}
+	return callerArn, nil
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCallerIdentity struct {
+	arn string
+}
+
+func (m mockCallerIdentity) GetCallerIdentity(context.Context, *sts.GetCallerIdentityInput, ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{Arn: aws.String(m.arn)}, nil
+}
+
+type mockIAMSimulator struct {
+	roles     map[string]string
+	denied    map[string]bool
+	simulated string
+}
+
+func (m *mockIAMSimulator) GetRole(_ context.Context, params *iam.GetRoleInput, _ ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	return &iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(m.roles[aws.ToString(params.RoleName)])}}, nil
+}
+
+func (m *mockIAMSimulator) SimulatePrincipalPolicy(_ context.Context, params *iam.SimulatePrincipalPolicyInput, _ ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+	m.simulated = aws.ToString(params.PolicySourceArn)
+	output := &iam.SimulatePrincipalPolicyOutput{}
+	for _, action := range params.ActionNames {
+		decision := iamtypes.PolicyEvaluationDecisionTypeAllowed
+		if m.denied[action] {
+			decision = iamtypes.PolicyEvaluationDecisionTypeImplicitDeny
+		}
+		output.EvaluationResults = append(output.EvaluationResults, iamtypes.EvaluationResult{
+			EvalActionName: aws.String(action),
+			EvalDecision:   decision,
+		})
+	}
+	return output, nil
+}
+
+func TestRequiredIAMActions(t *testing.T) {
+	inspect, undeclared := RequiredIAMActions([]string{"sqs"}, false)
+	require.Empty(t, undeclared)
+	require.Equal(t, []string{"ec2:DescribeRegions", "sqs:GetQueueAttributes", "sqs:ListQueueTags", "sqs:ListQueues"}, inspect)
+
+	nuke, _ := RequiredIAMActions([]string{"sqs"}, true)
+	require.Equal(t, []string{"ec2:DescribeRegions", "sqs:DeleteQueue", "sqs:GetQueueAttributes", "sqs:ListQueueTags", "sqs:ListQueues"}, nuke)
+
+	all, undeclared := RequiredIAMActions(nil, true)
+	require.Empty(t, undeclared)
+	require.Subset(t, all, []string{"s3:ListAllMyBuckets", "ec2:DeleteVpc", "iam:DeleteUser"})
+}
+
+func TestRequiredIAMActions_CloudControl(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, RegisterCloudControlTypes(nil)) })
+	require.NoError(t, RegisterCloudControlTypes([]config.CloudControlResourceType{{Type: "AWS::Glue::Job"}}))
+
+	actions, _ := RequiredIAMActions([]string{"AWS::Glue::Job"}, true)
+	require.Contains(t, actions, "cloudformation:DeleteResource")
+}
+
+func TestMissingIAMActions(t *testing.T) {
+	tests := []struct {
+		name      string
+		caller    string
+		principal string
+		missing   []string
+	}{
+		{
+			name:      "user",
+			caller:    "arn:aws:iam::123456789012:user/ci",
+			principal: "arn:aws:iam::123456789012:user/ci",
+			missing:   []string{"sqs:DeleteQueue"},
+		},
+		{
+			name:      "assumed role resolves to role with path",
+			caller:    "arn:aws:sts::123456789012:assumed-role/nuker/session",
+			principal: "arn:aws:iam::123456789012:role/ops/nuker",
+			missing:   []string{"sqs:DeleteQueue"},
+		},
+		{
+			name:   "root is not simulated",
+			caller: "arn:aws:iam::123456789012:root",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			simulator := &mockIAMSimulator{
+				roles:  map[string]string{"nuker": "arn:aws:iam::123456789012:role/ops/nuker"},
+				denied: map[string]bool{"sqs:DeleteQueue": true},
+			}
+			missing, err := MissingIAMActions(context.Background(), mockCallerIdentity{arn: tc.caller}, simulator,
+				[]string{"sqs:ListQueues", "sqs:DeleteQueue"})
+			require.NoError(t, err)
+			require.Equal(t, tc.missing, missing)
+			require.Equal(t, tc.principal, simulator.simulated)
+		})
+	}
+}
@@ -1,9 +1,70 @@
 package aws
 
 import (
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/go-commons/collections"
 )
 
+// VpcScopedResourceTypes are the resource types that live inside a VPC and can be restricted to
+// given VPCs with --vpc-id.
+var VpcScopedResourceTypes = []string{
+	"ec2",
+	"ec2-endpoint",
+	"ec2-subnet",
+	"eks-cluster",
+	"elasticache",
+	"elb",
+	"elbv2",
+	"internet-gateway",
+	"lambda",
+	"nat-gateway",
+	"network-interface",
+	"rds-instance",
+	"route-table",
+	"security-group",
+	"vpc",
+}
+
+// OrphanResourceTypes are the resource types that can tell whether a resource is orphaned, and so
+// can be restricted to orphaned resources with --orphans-only.
+var OrphanResourceTypes = []string{
+	"ami",
+	"ebs",
+	"ebs-snapshot",
+	"ecr",
+	"eip",
+	"nat-gateway",
+	"network-interface",
+	"security-group",
+}
+
+// OptInResourceTypes are the resource types that prune what other resources contain, such as the
+// images of an ECR repository, and that would empty them without a filter. Unless they are selected
+// explicitly, they are only nuked when configured, as reported by the function they map to.
+var OptInResourceTypes = map[string]func(config.Config) bool{
+	"ecr-image":            func(c config.Config) bool { return c.ECRImage.HasImageFilters() },
+	"lambda-layer-version": func(c config.Config) bool { return c.LambdaLayerVersion.IsConfigured() },
+	"lambda-version":       func(c config.Config) bool { return c.LambdaVersion.IsConfigured() },
+	"s3-object":            func(c config.Config) bool { return c.S3Object.IsConfigured() },
+}
+
+// unselectedOptInResourceTypes returns the opt-in resource types to skip: none if resource types were
+// selected explicitly, otherwise those that are not configured.
+func unselectedOptInResourceTypes(configObj config.Config, explicit bool) []string {
+	if explicit {
+		return nil
+	}
+	unselected := []string{}
+	for resourceType, configured := range OptInResourceTypes {
+		if !configured(configObj) {
+			logging.Debugf("Skipping %s: select it with --resource-type or configure it to nuke it", resourceType)
+			unselected = append(unselected, resourceType)
+		}
+	}
+	return unselected
+}
+
 func ensureValidResourceTypes(resourceTypes []string) ([]string, error) {
 	invalidresourceTypes := []string{}
 	for _, resourceType := range resourceTypes {
@@ -50,3 +111,43 @@ func HandleResourceTypeSelections(
 	}
 	return resourceTypes, nil
 }
+
+// HandleVpcScopedResourceTypes narrows the selected resourceTypes to those that can be restricted to
+// a VPC. When resource types were picked explicitly, any type that is not VPC-scoped is rejected
+// instead, since it would otherwise be nuked across every VPC.
+func HandleVpcScopedResourceTypes(resourceTypes []string, explicit bool) ([]string, error) {
+	vpcScoped := []string{}
+	unsupported := []string{}
+	for _, resourceType := range resourceTypes {
+		if collections.ListContainsElement(VpcScopedResourceTypes, resourceType) {
+			vpcScoped = append(vpcScoped, resourceType)
+		} else {
+			unsupported = append(unsupported, resourceType)
+		}
+	}
+
+	if explicit && len(unsupported) > 0 {
+		return []string{}, ResourceTypesNotVpcScopedError{InvalidTypes: unsupported}
+	}
+	return vpcScoped, nil
+}
+
+// HandleOrphanResourceTypes narrows the selected resourceTypes to those that can tell whether a
+// resource is orphaned. When resource types were picked explicitly, any other type is rejected
+// instead, since every resource of it would otherwise be nuked.
+func HandleOrphanResourceTypes(resourceTypes []string, explicit bool) ([]string, error) {
+	orphanAware := []string{}
+	unsupported := []string{}
+	for _, resourceType := range resourceTypes {
+		if collections.ListContainsElement(OrphanResourceTypes, resourceType) {
+			orphanAware = append(orphanAware, resourceType)
+		} else {
+			unsupported = append(unsupported, resourceType)
+		}
+	}
+
+	if explicit && len(unsupported) > 0 {
+		return []string{}, ResourceTypesNotOrphanAwareError{InvalidTypes: unsupported}
+	}
+	return orphanAware, nil
+}
@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -93,3 +95,53 @@ func TestHandleResourceTypeSelectionsFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestVpcScopedResourceTypesAreRegistered(t *testing.T) {
+	for _, resourceType := range VpcScopedResourceTypes {
+		require.Contains(t, ListResourceTypes(), resourceType)
+	}
+}
+
+func TestHandleVpcScopedResourceTypes(t *testing.T) {
+	resourceTypes, err := HandleVpcScopedResourceTypes([]string{"ec2", "s3", "vpc"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ec2", "vpc"}, resourceTypes)
+
+	_, err = HandleVpcScopedResourceTypes([]string{"ec2", "s3"}, true)
+	require.ErrorAs(t, err, &ResourceTypesNotVpcScopedError{})
+}
+
+func TestOrphanResourceTypesAreRegistered(t *testing.T) {
+	for _, resourceType := range OrphanResourceTypes {
+		require.Contains(t, ListResourceTypes(), resourceType)
+	}
+}
+
+func TestHandleOrphanResourceTypes(t *testing.T) {
+	resourceTypes, err := HandleOrphanResourceTypes([]string{"ebs", "s3", "eip"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ebs", "eip"}, resourceTypes)
+
+	_, err = HandleOrphanResourceTypes([]string{"ebs", "s3"}, true)
+	require.ErrorAs(t, err, &ResourceTypesNotOrphanAwareError{})
+}
+
+func TestOptInResourceTypesAreRegistered(t *testing.T) {
+	for resourceType := range OptInResourceTypes {
+		require.Contains(t, ListResourceTypes(), resourceType)
+	}
+}
+
+func TestUnselectedOptInResourceTypes(t *testing.T) {
+	require.Contains(t, unselectedOptInResourceTypes(config.Config{}, false), "ecr-image")
+	require.Empty(t, unselectedOptInResourceTypes(config.Config{}, true))
+
+	configObj := config.Config{}
+	configObj.ECRImage.Untagged = true
+	require.NotContains(t, unselectedOptInResourceTypes(configObj, false), "ecr-image")
+
+	// Filtering repositories alone would still nuke every image in them
+	configObj = config.Config{}
+	configObj.ECRImage.IncludeRule.NamesRegExp = []config.Expression{{RE: *regexp.MustCompile("^shared-")}}
+	require.Contains(t, unselectedOptInResourceTypes(configObj, false), "ecr-image")
+}
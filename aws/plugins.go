@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/plugin"
+)
+
+var (
+	pluginsMu         sync.RWMutex
+	registeredPlugins []*plugin.Plugin
+)
+
+// LoadPlugins discovers the plugins in dir and registers them as AWS resource types.
+func LoadPlugins(ctx context.Context, dir string) error {
+	plugins, err := plugin.Discover(ctx, dir)
+	if err != nil {
+		return err
+	}
+	if err := RegisterPlugins(plugins); err != nil {
+		return err
+	}
+	for _, p := range plugins {
+		logging.Debugf("Loaded plugin %s from %s (%s)", p.Info.Name, p.Path, p.Info.Scope)
+	}
+	return nil
+}
+
+// RegisterPlugins replaces the set of plugin resource types. Plugins may not reuse the name of a
+// built-in resource type, and Before must name a built-in resource type of the same scope.
+// Passing nil removes all plugins.
+func RegisterPlugins(plugins []*plugin.Plugin) error {
+	globalNames := resourceNames(getRegisteredGlobalResources())
+	regionalNames := resourceNames(getRegisteredRegionalResources())
+
+	for _, p := range plugins {
+		if slices.Contains(globalNames, p.Info.Name) || slices.Contains(regionalNames, p.Info.Name) {
+			return InvalidPluginError{Path: p.Path, Reason: fmt.Sprintf("resource type %q is already built in", p.Info.Name)}
+		}
+		if p.Info.Before == "" {
+			continue
+		}
+		sameScope := regionalNames
+		if p.IsGlobal() {
+			sameScope = globalNames
+		}
+		if !slices.Contains(sameScope, p.Info.Before) {
+			return InvalidPluginError{Path: p.Path, Reason: fmt.Sprintf("before refers to unknown %s resource type %q", p.Info.Scope, p.Info.Before)}
+		}
+	}
+
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	registeredPlugins = plugins
+	return nil
+}
+
+// registeredPluginNames returns the resource type names of all registered plugins.
+func registeredPluginNames() []string {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	names := make([]string, 0, len(registeredPlugins))
+	for _, p := range registeredPlugins {
+		names = append(names, p.Info.Name)
+	}
+	return names
+}
+
+// withPlugins inserts the registered plugins of the given scope into a list of built-in resources.
+// A plugin with Before set is placed immediately ahead of that resource type, so it is nuked
+// first; other plugins are appended and therefore nuked after every built-in of the same scope.
+func withPlugins(builtins []resources.AwsResource, global bool) []resources.AwsResource {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+
+	result := builtins
+	for _, p := range registeredPlugins {
+		if p.IsGlobal() != global {
+			continue
+		}
+		res := resources.NewPluginResource(p)
+		idx := len(result)
+		if p.Info.Before != "" {
+			if i := slices.Index(resourceNames(result), p.Info.Before); i >= 0 {
+				idx = i
+			}
+		}
+		result = slices.Insert(result, idx, res)
+	}
+	return result
+}
+
+func resourceNames(res []resources.AwsResource) []string {
+	names := make([]string, len(res))
+	for i, r := range res {
+		names[i] = r.ResourceName()
+	}
+	return names
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/plugin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPlugins(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, RegisterPlugins(nil)) })
+
+	tests := []struct {
+		name string
+		info plugin.Info
+		err  string
+	}{
+		{name: "valid regional", info: plugin.Info{Name: "custom", Scope: plugin.ScopeRegional, Before: "vpc"}},
+		{name: "valid global", info: plugin.Info{Name: "custom", Scope: plugin.ScopeGlobal, Before: "iam-role"}},
+		{name: "built-in name", info: plugin.Info{Name: "ec2", Scope: plugin.ScopeRegional}, err: "already built in"},
+		{name: "unknown before", info: plugin.Info{Name: "custom", Scope: plugin.ScopeRegional, Before: "nope"}, err: "unknown regional resource type"},
+		{name: "before in other scope", info: plugin.Info{Name: "custom", Scope: plugin.ScopeGlobal, Before: "vpc"}, err: "unknown global resource type"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := RegisterPlugins([]*plugin.Plugin{{Path: "/plugins/custom", Info: tc.info}})
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestWithPlugins(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, RegisterPlugins(nil)) })
+
+	require.NoError(t, RegisterPlugins([]*plugin.Plugin{
+		{Info: plugin.Info{Name: "before-vpc", Scope: plugin.ScopeRegional, Before: "vpc"}},
+		{Info: plugin.Info{Name: "last-regional", Scope: plugin.ScopeRegional}},
+		{Info: plugin.Info{Name: "last-global", Scope: plugin.ScopeGlobal}},
+	}))
+
+	regional := resourceNames(withPlugins(getRegisteredRegionalResources(), false))
+	vpcIdx := slices.Index(regional, "vpc")
+	require.Equal(t, vpcIdx-1, slices.Index(regional, "before-vpc"))
+	require.Equal(t, "last-regional", regional[len(regional)-1])
+	require.NotContains(t, regional, "last-global")
+
+	global := resourceNames(withPlugins(getRegisteredGlobalResources(), true))
+	require.Equal(t, "last-global", global[len(global)-1])
+
+	require.Subset(t, ListResourceTypes(), []string{"before-vpc", "last-regional", "last-global"})
+	require.ElementsMatch(t, []string{"before-vpc", "last-regional", "last-global"}, registeredPluginNames())
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
@@ -19,8 +20,16 @@ type Query struct {
 	Timeout              *time.Duration
 	ExcludeFirstSeen     bool
 	DefaultOnly          bool
+	VpcIDs               []string
+	OrphansOnly          bool
+	Action               string
+	ProtectedIdentifiers map[string]string
 	IncludeTags          map[string]config.Expression
 	Parallelism          int
+
+	// implicitResourceTypes is set by Validate when ResourceTypes were not selected explicitly, so
+	// that the opt-in resource types are only nuked when configured.
+	implicitResourceTypes bool
 }
 
 // Validate ensures the configured values for a Query are valid, returning an error if there are
@@ -31,6 +40,31 @@ func (q *Query) Validate() error {
 		return err
 	}
 
+	if len(q.VpcIDs) > 0 {
+		explicit := len(q.ResourceTypes) > 0 && !slices.Contains(q.ResourceTypes, "all")
+		resourceTypes, err = HandleVpcScopedResourceTypes(resourceTypes, explicit)
+		if err != nil {
+			return err
+		}
+	}
+
+	if q.OrphansOnly {
+		explicit := len(q.ResourceTypes) > 0 && !slices.Contains(q.ResourceTypes, "all")
+		resourceTypes, err = HandleOrphanResourceTypes(resourceTypes, explicit)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateAction(q.Action); err != nil {
+		return err
+	}
+	if q.Action == ActionSuspend || q.Action == ActionResume {
+		explicit := len(q.ResourceTypes) > 0 && !slices.Contains(q.ResourceTypes, "all")
+		resourceTypes = HandleSuspendResourceTypes(resourceTypes, explicit)
+	}
+
+	q.implicitResourceTypes = len(q.ResourceTypes) == 0 || slices.Contains(q.ResourceTypes, "all")
 	q.ResourceTypes = resourceTypes
 
 	regions, err := GetEnabledRegions()
@@ -8,8 +8,8 @@ import (
 // GetAllRegisteredResources - returns a list of all registered resources without initialization.
 // This is useful for listing all resources without initializing them.
 func GetAllRegisteredResources() []*resources.AwsResource {
-	registeredResources := getRegisteredGlobalResources()
-	registeredResources = append(registeredResources, getRegisteredRegionalResources()...)
+	registeredResources := withCloudControl(withPlugins(getRegisteredGlobalResources(), true), true)
+	registeredResources = append(registeredResources, withCloudControl(withPlugins(getRegisteredRegionalResources(), false), false)...)
 
 	return toAwsResourcesPointer(registeredResources)
 }
@@ -18,9 +18,9 @@ func GetAllRegisteredResources() []*resources.AwsResource {
 func GetAndInitRegisteredResources(session aws.Config, region string) []*resources.AwsResource {
 	var registeredResources []resources.AwsResource
 	if region == GlobalRegion {
-		registeredResources = getRegisteredGlobalResources()
+		registeredResources = withCloudControl(withPlugins(getRegisteredGlobalResources(), true), true)
 	} else {
-		registeredResources = getRegisteredRegionalResources()
+		registeredResources = withCloudControl(withPlugins(getRegisteredRegionalResources(), false), false)
 	}
 
 	return initRegisteredResources(toAwsResourcesPointer(registeredResources), session, region)
@@ -50,6 +50,7 @@ func getRegisteredGlobalResources() []resources.AwsResource {
 		resources.NewRoute53CidrCollections(),
 		resources.NewRoute53TrafficPolicies(),
 		resources.NewS3MultiRegionAccessPoints(),
+		resources.NewS3Objects(),
 		resources.NewS3Buckets(),
 	}
 }
@@ -103,6 +104,7 @@ func getRegisteredRegionalResources() []resources.AwsResource {
 		resources.NewTransitGatewaysVpcAttachment(),
 		resources.NewVPCPeeringConnection(),
 		resources.NewEC2Endpoints(),
+		resources.NewECRImages(),
 		resources.NewECR(),
 		resources.NewECSClusters(),
 		resources.NewECSServices(),
@@ -120,6 +122,8 @@ func getRegisteredRegionalResources() []resources.AwsResource {
 		resources.NewKinesisFirehose(),
 		resources.NewKinesisStreams(),
 		resources.NewKmsCustomerKeys(),
+		resources.NewLambdaVersions(),
+		resources.NewLambdaLayerVersions(),
 		resources.NewLambdaFunctions(),
 		resources.NewLambdaLayers(),
 		resources.NewLaunchConfigs(),
@@ -3,6 +3,7 @@ package aws
 import (
 	"testing"
 
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -68,3 +69,35 @@ func TestRegisteredResources_RegionalContainsExpectedResources(t *testing.T) {
 	assert.Contains(t, names, "lambda")
 	assert.Contains(t, names, "vpc")
 }
+
+// subResourceTypes maps resource types that delete what another resource type contains to that
+// parent resource type.
+var subResourceTypes = map[string]string{
+	"ecr-image":            "ecr",
+	"lambda-layer-version": "lambda-layer",
+	"lambda-version":       "lambda",
+	"s3-object":            "s3",
+}
+
+func TestRegisteredResources_SubResourcesBeforeParents(t *testing.T) {
+	names := ListResourceTypes()
+	position := make(map[string]int)
+	for _, r := range append(getRegisteredGlobalResources(), getRegisteredRegionalResources()...) {
+		position[r.ResourceName()] = len(position)
+	}
+
+	for child, parent := range subResourceTypes {
+		require.Contains(t, names, child)
+		require.Contains(t, names, parent)
+		assert.Less(t, position[child], position[parent], "%s must be nuked before %s", child, parent)
+	}
+}
+
+func TestRegisteredResources_SubResourcesAreOptIn(t *testing.T) {
+	unselected := unselectedOptInResourceTypes(config.Config{}, false)
+	for child := range subResourceTypes {
+		assert.Contains(t, OptInResourceTypes, child)
+		assert.Contains(t, unselected, child, "%s must not be selected by an unfiltered run", child)
+	}
+	assert.Empty(t, unselectedOptInResourceTypes(config.Config{}, true))
+}
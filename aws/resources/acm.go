@@ -83,6 +83,7 @@ func listACMCertificates(ctx context.Context, client ACMAPI, scope resource.Scop
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   cert.CertificateArn,
 				Name: cert.DomainName,
 				Time: cert.CreatedAt,
 				Tags: tags,
@@ -103,6 +104,7 @@ func shouldIncludeACMCertificate(cert types.CertificateSummary, cfg config.Resou
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   cert.CertificateArn,
 		Name: cert.DomainName,
 		Time: cert.CreatedAt,
 	})
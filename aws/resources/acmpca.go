@@ -79,6 +79,7 @@ func listACMPCA(ctx context.Context, client ACMPCAAPI, scope resource.Scope, cfg
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   ca.Arn,
 				Time: &referenceTime,
 				Tags: tags,
 			}) {
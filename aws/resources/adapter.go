@@ -39,6 +39,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
 )
@@ -109,6 +110,9 @@ func WrapAwsInitClient[C any](fn AwsInitClientFunc[C]) func(r *resource.Resource
 // This adapter should not be constructed directly. Use NewAwsResource() instead.
 type AwsResourceAdapter[C any] struct {
 	*resource.Resource[C]
+
+	// idleMetrics reports whether the lister reads CloudWatch metrics to evaluate idle rules.
+	idleMetrics bool
 }
 
 // NewAwsResource creates an AwsResourceAdapter from a generic Resource.
@@ -146,17 +150,29 @@ func (a *AwsResourceAdapter[C]) Nuke(ctx context.Context, identifiers []string)
 // This ensures AwsResourceAdapter correctly implements AwsResource at compile time.
 var _ AwsResource = (*AwsResourceAdapter[any])(nil)
 
-// EC2ListerFunc is a lister function signature for EC2 resources that need DefaultOnly support.
-type EC2ListerFunc[C any] func(ctx context.Context, client C, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error)
+// EC2ListerFunc is a lister function signature for resources that live inside a VPC. It receives the
+// full EC2ResourceType so it can honor DefaultOnly and filter by VPC with InVpc.
+type EC2ListerFunc[C any] func(ctx context.Context, client C, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error)
 
 // EC2ResourceOptions contains optional configuration for NewEC2AwsResource.
 type EC2ResourceOptions[C any] struct {
+	// BatchSize overrides DefaultBatchSize.
+	BatchSize int
+
 	// PermissionVerifier is an optional function to verify deletion permissions via dry-run.
 	PermissionVerifier func(ctx context.Context, client C, id *string) error
+
+	// Idle describes the CloudWatch metrics of the resource type, allowing it to be filtered with an
+	// idle rule. Resource types without it reject idle rules.
+	Idle *IdleMetrics
+
+	// Suspender and Resumer allow the resources to be suspended and resumed instead of deleted.
+	Suspender resource.NukerFunc[C]
+	Resumer   resource.NukerFunc[C]
 }
 
-// NewEC2AwsResource creates an AWS resource that uses EC2ResourceType config (with DefaultOnly support).
-// This helper encapsulates the closure pattern needed to pass DefaultOnly from ConfigGetter to Lister.
+// NewEC2AwsResource creates an AWS resource that uses EC2ResourceType config (with DefaultOnly and VPC support).
+// This helper encapsulates the closure pattern needed to pass the EC2ResourceType from ConfigGetter to Lister.
 //
 // Example:
 //
@@ -181,26 +197,50 @@ func NewEC2AwsResource[C any](
 	nuker resource.NukerFunc[C],
 	opts *EC2ResourceOptions[C],
 ) AwsResource {
-	var defaultOnly bool
+	var ec2Cfg config.EC2ResourceType
+	var idleMetrics *IdleMetrics
+	var metricsClient IdleMetricsAPI
+	if opts != nil && opts.Idle != nil {
+		idleMetrics = opts.Idle
+		initResourceClient := initClient
+		initClient = func(r *resource.Resource[C], cfg any) {
+			initResourceClient(r, cfg)
+			if awsCfg, ok := cfg.(aws.Config); ok {
+				metricsClient = cloudwatch.NewFromConfig(awsCfg)
+			}
+		}
+	}
 
 	r := &resource.Resource[C]{
 		ResourceTypeName: resourceTypeName,
 		BatchSize:        DefaultBatchSize,
 		InitClient:       initClient,
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			ec2Cfg := configExtractor(c)
-			defaultOnly = ec2Cfg.DefaultOnly
+			ec2Cfg = configExtractor(c)
 			return ec2Cfg.ResourceType
 		},
 		Lister: func(ctx context.Context, client C, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-			return lister(ctx, client, scope, cfg, defaultOnly)
+			ec2Cfg.ResourceType = cfg
+			ids, err := lister(ctx, client, scope, ec2Cfg)
+			if err != nil || ec2Cfg.Idle == nil {
+				return ids, err
+			}
+			if idleMetrics == nil {
+				return nil, fmt.Errorf("%s does not support idle rules", resourceTypeName)
+			}
+			return filterIdle(ctx, metricsClient, scope, cfg, idleMetrics, *ec2Cfg.Idle, ids)
 		},
 		Nuker: nuker,
 	}
 
-	if opts != nil && opts.PermissionVerifier != nil {
+	if opts != nil {
+		if opts.BatchSize > 0 {
+			r.BatchSize = opts.BatchSize
+		}
 		r.PermissionVerifier = opts.PermissionVerifier
+		r.Suspender = opts.Suspender
+		r.Resumer = opts.Resumer
 	}
 
-	return NewAwsResource(r)
+	return &AwsResourceAdapter[C]{Resource: r, idleMetrics: idleMetrics != nil}
 }
@@ -18,10 +18,13 @@ type AMIsAPI interface {
 	DeleteSnapshot(ctx context.Context, params *ec2.DeleteSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
 	DeregisterImage(ctx context.Context, params *ec2.DeregisterImageInput, optFns ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
 	DescribeImages(ctx context.Context, params *ec2.DescribeImagesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	LaunchTemplateReferencesAPI
 }
 
 // NewAMIs creates a new AMIs resource using the generic resource pattern.
 func NewAMIs() AwsResource {
+	var retain *config.RetainRule
 	return NewAwsResource(&resource.Resource[AMIsAPI]{
 		ResourceTypeName: "ami",
 		BatchSize:        DefaultBatchSize,
@@ -30,16 +33,29 @@ func NewAMIs() AwsResource {
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.AMI
+			retain = c.AMI.Retain
+			return c.AMI.ResourceType
 		},
-		Lister: listAMIs,
-		Nuker:  resource.SimpleBatchDeleter(nukeAMI),
+		Lister: func(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			return listAMIs(ctx, client, scope, cfg, retain)
+		},
+		Nuker: resource.SimpleBatchDeleter(nukeAMI),
 	})
 }
 
-// listAMIs retrieves all user-owned AMIs that match the config filters.
-func listAMIs(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var imageIds []*string
+// listAMIs retrieves all user-owned AMIs that match the config filters, except those the retain
+// rule keeps.
+func listAMIs(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg config.ResourceType, retain *config.RetainRule) ([]*string, error) {
+	var inUse map[string]string
+	if cfg.OrphansOnly() {
+		var err error
+		if inUse, err = imagesInUse(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	images := map[string]types.Image{}
+	var values []config.ResourceValue
 	paginator := ec2.NewDescribeImagesPaginator(client, &ec2.DescribeImagesInput{
 		Owners: []string{"self"},
 	})
@@ -60,19 +76,66 @@ func listAMIs(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg con
 				return nil, err
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			images[aws.ToString(image.ImageId)] = image
+			values = append(values, config.ResourceValue{
+				ID:   image.ImageId,
 				Name: image.Name,
 				Time: createdTime,
 				Tags: util.ConvertTypesTagsToMap(image.Tags),
-			}) {
-				imageIds = append(imageIds, image.ImageId)
-			}
+			})
+		}
+	}
+
+	var imageIds []*string
+	for _, value := range retain.Surplus(cfg, values) {
+		image := images[aws.ToString(value.ID)]
+		if cfg.ShouldInclude(value) && cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(image.ImageId), amiOrphanReason(image, inUse)) {
+			imageIds = append(imageIds, image.ImageId)
 		}
 	}
 
 	return imageIds, nil
 }
 
+// imagesInUse maps the IDs of the images that instances or launch templates use to what uses them.
+func imagesInUse(ctx context.Context, client AMIsAPI) (map[string]string, error) {
+	inUse := make(map[string]string)
+
+	paginator := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.State != nil && instance.State.Name == types.InstanceStateNameTerminated {
+					continue
+				}
+				inUse[aws.ToString(instance.ImageId)] = "instance " + aws.ToString(instance.InstanceId)
+			}
+		}
+	}
+
+	refs, err := launchTemplateReferences(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for imageID, user := range refs.images {
+		inUse[imageID] = user
+	}
+	return inUse, nil
+}
+
+// amiOrphanReason returns why an AMI is orphaned, or "" if it is in use.
+func amiOrphanReason(image types.Image, inUse map[string]string) string {
+	if user, ok := inUse[aws.ToString(image.ImageId)]; ok {
+		logging.Debugf("AMI %s is used by %s", aws.ToString(image.ImageId), user)
+		return ""
+	}
+	return "image is not used by any instance or launch template"
+}
+
 // shouldSkipAMI checks if an AMI should be skipped (AWS managed or AWS Backup).
 func shouldSkipAMI(image types.Image) bool {
 	// Skip images created by AWS Backup
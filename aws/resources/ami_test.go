@@ -19,6 +19,22 @@ type mockAMIClient struct {
 	DeregisterImageOutput ec2.DeregisterImageOutput
 	DescribeImagesOutput  ec2.DescribeImagesOutput
 	DeletedSnapshots      []string
+
+	DescribeInstancesOutput              ec2.DescribeInstancesOutput
+	DescribeLaunchTemplatesOutput        ec2.DescribeLaunchTemplatesOutput
+	DescribeLaunchTemplateVersionsOutput ec2.DescribeLaunchTemplateVersionsOutput
+}
+
+func (m *mockAMIClient) DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	return &m.DescribeInstancesOutput, nil
+}
+
+func (m *mockAMIClient) DescribeLaunchTemplates(ctx context.Context, params *ec2.DescribeLaunchTemplatesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+	return &m.DescribeLaunchTemplatesOutput, nil
+}
+
+func (m *mockAMIClient) DescribeLaunchTemplateVersions(ctx context.Context, params *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	return &m.DescribeLaunchTemplateVersionsOutput, nil
 }
 
 func (m *mockAMIClient) DeleteSnapshot(ctx context.Context, params *ec2.DeleteSnapshotInput, optFns ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error) {
@@ -70,7 +86,7 @@ func TestListAMIs_SkipAWSManaged(t *testing.T) {
 		},
 	}
 
-	amis, err := listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	amis, err := listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{}, nil)
 	require.NoError(t, err)
 	require.NotContains(t, aws.ToStringSlice(amis), testImageId1)
 	require.NotContains(t, aws.ToStringSlice(amis), testImageId2)
@@ -93,7 +109,7 @@ func TestListAMIs(t *testing.T) {
 	}
 
 	// without filters
-	amis, err := listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	amis, err := listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{}, nil)
 	require.NoError(t, err)
 	require.Contains(t, aws.ToStringSlice(amis), testImageId)
 
@@ -104,7 +120,7 @@ func TestListAMIs(t *testing.T) {
 				RE: *regexp.MustCompile("test-ami"),
 			}},
 		},
-	})
+	}, nil)
 	require.NoError(t, err)
 	require.NotContains(t, aws.ToStringSlice(amis), testImageId)
 
@@ -113,7 +129,7 @@ func TestListAMIs(t *testing.T) {
 		ExcludeRule: config.FilterRule{
 			TimeAfter: aws.Time(now.Add(-12 * time.Hour)),
 		},
-	})
+	}, nil)
 	require.NoError(t, err)
 	require.NotContains(t, aws.ToStringSlice(amis), testImageId)
 }
@@ -46,6 +46,7 @@ func listApiGateways(ctx context.Context, client ApiGatewayAPI, scope resource.S
 
 		for _, api := range page.Items {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   api.Id,
 				Name: api.Name,
 				Time: api.CreatedDate,
 				Tags: api.Tags,
@@ -113,6 +113,35 @@ func TestAPIGateway_GetAll(t *testing.T) {
 	}
 }
 
+// The lister returns API IDs, which differ from the names it filters on, so the expiry of a TTL tag
+// must be recorded under the ID to show up with the resource.
+func TestAPIGateway_GetAll_TTLFinding(t *testing.T) {
+	t.Parallel()
+
+	created := time.Now().Add(-2 * time.Hour)
+	mock := mockedApiGateway{
+		GetRestApisOutput: apigateway.GetRestApisOutput{
+			Items: []types.RestApi{{
+				Id:          aws.String("api-1"),
+				Name:        aws.String("test-api-1"),
+				CreatedDate: aws.Time(created),
+				Tags:        map[string]string{config.CloudNukeTTLTagKey: "1h"},
+			}},
+		},
+	}
+
+	findings := config.NewFindings()
+	configObj := config.Config{}
+	configObj.RecordFindings(findings)
+
+	ids, err := listApiGateways(context.Background(), mock, resource.Scope{Region: "us-east-1"}, configObj.APIGateway.InRegion("us-east-1"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"api-1"}, aws.ToStringSlice(ids))
+	require.Equal(t,
+		"cloud-nuke-ttl=1h expired at "+created.Add(time.Hour).UTC().Format(time.RFC3339),
+		findings.Detail("us-east-1", "api-1"))
+}
+
 func TestAPIGateway_NukeAll(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +47,7 @@ func listApiGatewaysV2(ctx context.Context, client ApiGatewayV2API, scope resour
 
 		for _, api := range output.Items {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   api.ApiId,
 				Time: api.CreatedDate,
 				Name: api.Name,
 				Tags: api.Tags,
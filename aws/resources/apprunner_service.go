@@ -76,6 +76,7 @@ func listAppRunnerServices(ctx context.Context, client AppRunnerServiceAPI, scop
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   service.ServiceArn,
 				Name: service.ServiceName,
 				Time: service.CreatedAt,
 				Tags: tags,
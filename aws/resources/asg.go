@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/cloud-nuke/util"
@@ -16,6 +18,9 @@ import (
 type ASGroupsAPI interface {
 	DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
 	DeleteAutoScalingGroup(ctx context.Context, params *autoscaling.DeleteAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteAutoScalingGroupOutput, error)
+	UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error)
+	CreateOrUpdateTags(ctx context.Context, params *autoscaling.CreateOrUpdateTagsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CreateOrUpdateTagsOutput, error)
+	DeleteTags(ctx context.Context, params *autoscaling.DeleteTagsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteTagsOutput, error)
 }
 
 // NewASGroups creates a new ASGroups resource using the generic resource pattern.
@@ -30,8 +35,10 @@ func NewASGroups() AwsResource {
 		ConfigGetter: func(c config.Config) config.ResourceType {
 			return c.AutoScalingGroup
 		},
-		Lister: listASGroups,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteASG, waitForASGsDeleted),
+		Lister:    listASGroups,
+		Nuker:     resource.SequentialDeleteThenWaitAll(deleteASG, waitForASGsDeleted),
+		Suspender: resource.SequentialActor("Scaling to zero", suspendASG),
+		Resumer:   resource.SequentialActor("Restoring", resumeASG),
 	})
 }
 
@@ -76,3 +83,87 @@ func waitForASGsDeleted(ctx context.Context, client ASGroupsAPI, names []string)
 		AutoScalingGroupNames: names,
 	}, 5*time.Minute)
 }
+
+// describeASG returns a single Auto Scaling Group.
+func describeASG(ctx context.Context, client ASGroupsAPI, name *string) (types.AutoScalingGroup, error) {
+	output, err := client.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{aws.ToString(name)},
+	})
+	if err != nil {
+		return types.AutoScalingGroup{}, errors.WithStackTrace(err)
+	}
+	if len(output.AutoScalingGroups) == 0 {
+		return types.AutoScalingGroup{}, fmt.Errorf("auto scaling group %s not found", aws.ToString(name))
+	}
+	return output.AutoScalingGroups[0], nil
+}
+
+// suspendASG scales an Auto Scaling Group to zero and records its minimum and desired capacity in a
+// tag so that it can be restored.
+func suspendASG(ctx context.Context, client ASGroupsAPI, name *string) (string, error) {
+	group, err := describeASG(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+	if aws.ToInt32(group.DesiredCapacity) == 0 {
+		return "", util.SkippedResourceError{Reason: "desired capacity is already 0"}
+	}
+
+	state := formatSuspendedState(map[string]int32{
+		"min":     aws.ToInt32(group.MinSize),
+		"desired": aws.ToInt32(group.DesiredCapacity),
+	})
+	if _, err := client.UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: name,
+		MinSize:              aws.Int32(0),
+		DesiredCapacity:      aws.Int32(0),
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.CreateOrUpdateTags(ctx, &autoscaling.CreateOrUpdateTagsInput{
+		Tags: []types.Tag{{
+			ResourceId:        name,
+			ResourceType:      aws.String("auto-scaling-group"),
+			Key:               aws.String(suspendedTagKey),
+			Value:             aws.String(state),
+			PropagateAtLaunch: aws.Bool(false),
+		}},
+	}); err != nil {
+		return "", untaggedSuspendError("scaled to zero from "+state, err)
+	}
+	return state, nil
+}
+
+// resumeASG restores the capacity recorded by suspendASG and removes its tag.
+func resumeASG(ctx context.Context, client ASGroupsAPI, name *string) (string, error) {
+	group, err := describeASG(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+	value, ok := util.ConvertAutoScalingTagsToMap(group.Tags)[suspendedTagKey]
+	if !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+	state, err := parseSuspendedState(value)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := client.UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: name,
+		MinSize:              aws.Int32(state["min"]),
+		DesiredCapacity:      aws.Int32(state["desired"]),
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.DeleteTags(ctx, &autoscaling.DeleteTagsInput{
+		Tags: []types.Tag{{
+			ResourceId:   name,
+			ResourceType: aws.String("auto-scaling-group"),
+			Key:          aws.String(suspendedTagKey),
+		}},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return value, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,6 +19,9 @@ import (
 type mockASGroupsClient struct {
 	DescribeAutoScalingGroupsOutput autoscaling.DescribeAutoScalingGroupsOutput
 	DeleteAutoScalingGroupOutput    autoscaling.DeleteAutoScalingGroupOutput
+	UpdatedGroups                   []*autoscaling.UpdateAutoScalingGroupInput
+	CreatedTags                     []types.Tag
+	DeletedTags                     []types.Tag
 }
 
 func (m *mockASGroupsClient) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
@@ -28,6 +32,21 @@ func (m *mockASGroupsClient) DeleteAutoScalingGroup(ctx context.Context, params
 	return &m.DeleteAutoScalingGroupOutput, nil
 }
 
+func (m *mockASGroupsClient) UpdateAutoScalingGroup(ctx context.Context, params *autoscaling.UpdateAutoScalingGroupInput, optFns ...func(*autoscaling.Options)) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	m.UpdatedGroups = append(m.UpdatedGroups, params)
+	return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+}
+
+func (m *mockASGroupsClient) CreateOrUpdateTags(ctx context.Context, params *autoscaling.CreateOrUpdateTagsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.CreateOrUpdateTagsOutput, error) {
+	m.CreatedTags = append(m.CreatedTags, params.Tags...)
+	return &autoscaling.CreateOrUpdateTagsOutput{}, nil
+}
+
+func (m *mockASGroupsClient) DeleteTags(ctx context.Context, params *autoscaling.DeleteTagsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteTagsOutput, error) {
+	m.DeletedTags = append(m.DeletedTags, params.Tags...)
+	return &autoscaling.DeleteTagsOutput{}, nil
+}
+
 func TestASGroups_GetAll(t *testing.T) {
 	t.Parallel()
 
@@ -113,3 +132,45 @@ func TestASGroups_NukeAll(t *testing.T) {
 	err := deleteASG(context.Background(), mock, aws.String("test-asg"))
 	require.NoError(t, err)
 }
+
+func TestASGroups_SuspendResume(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockASGroupsClient{
+		DescribeAutoScalingGroupsOutput: autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []types.AutoScalingGroup{{
+				AutoScalingGroupName: aws.String("web"),
+				MinSize:              aws.Int32(1),
+				DesiredCapacity:      aws.Int32(3),
+			}},
+		},
+	}
+
+	detail, err := suspendASG(context.Background(), mock, aws.String("web"))
+	require.NoError(t, err)
+	require.Equal(t, "desired=3,min=1", detail)
+	require.Equal(t, "desired=3,min=1", aws.ToString(mock.CreatedTags[0].Value))
+	require.Equal(t, int32(0), aws.ToInt32(mock.UpdatedGroups[0].MinSize))
+	require.Equal(t, int32(0), aws.ToInt32(mock.UpdatedGroups[0].DesiredCapacity))
+
+	// Resume skips groups cloud-nuke did not suspend
+	_, err = resumeASG(context.Background(), mock, aws.String("web"))
+	require.Equal(t, util.SkippedResourceError{Reason: notSuspendedReason}, err)
+	require.Len(t, mock.UpdatedGroups, 1)
+
+	mock.DescribeAutoScalingGroupsOutput.AutoScalingGroups[0].DesiredCapacity = aws.Int32(0)
+	mock.DescribeAutoScalingGroupsOutput.AutoScalingGroups[0].Tags = []types.TagDescription{
+		{Key: aws.String(suspendedTagKey), Value: aws.String("desired=3,min=1")},
+	}
+	detail, err = resumeASG(context.Background(), mock, aws.String("web"))
+	require.NoError(t, err)
+	require.Equal(t, "desired=3,min=1", detail)
+	require.Equal(t, int32(1), aws.ToInt32(mock.UpdatedGroups[1].MinSize))
+	require.Equal(t, int32(3), aws.ToInt32(mock.UpdatedGroups[1].DesiredCapacity))
+	require.Equal(t, suspendedTagKey, aws.ToString(mock.DeletedTags[0].Key))
+
+	// Suspending an empty group is a no-op
+	_, err = suspendASG(context.Background(), mock, aws.String("web"))
+	require.Equal(t, util.SkippedResourceError{Reason: "desired capacity is already 0"}, err)
+	require.Len(t, mock.UpdatedGroups, 2)
+}
@@ -56,6 +56,7 @@ func listBackupPlans(ctx context.Context, client BackupPlanAPI, scope resource.S
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   plan.BackupPlanId,
 				Name: plan.BackupPlanName,
 				Time: plan.CreationDate,
 				Tags: tags,
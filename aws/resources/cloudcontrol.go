@@ -0,0 +1,238 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+const (
+	cloudControlPollInterval  = 5 * time.Second
+	cloudControlDeleteTimeout = 30 * time.Minute
+)
+
+// cloudControlTimeProperties are the creation time property names tried, in order, when the
+// declaration does not set time_property.
+var cloudControlTimeProperties = []string{
+	"CreationTime", "CreationDate", "CreationTimestamp", "CreatedAt", "CreatedTime", "CreatedDate", "CreateTime", "CreateDate",
+}
+
+// CloudControlAPI defines the interface for Cloud Control API operations.
+type CloudControlAPI interface {
+	ListResources(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error)
+	GetResource(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error)
+	DeleteResource(ctx context.Context, params *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error)
+	GetResourceRequestStatus(ctx context.Context, params *cloudcontrol.GetResourceRequestStatusInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceRequestStatusOutput, error)
+}
+
+// cloudControlClient pairs the Cloud Control client with the declaration of the type it operates on.
+type cloudControlClient struct {
+	api  CloudControlAPI
+	decl config.CloudControlResourceType
+}
+
+// NewCloudControlResource creates a resource for a CloudFormation type declared under `cloudcontrol`
+// in the config file. The resource type name is the CloudFormation type name, e.g. "AWS::Glue::Job".
+func NewCloudControlResource(decl config.CloudControlResourceType) AwsResource {
+	return NewAwsResource(&resource.Resource[*cloudControlClient]{
+		ResourceTypeName: decl.Type,
+		BatchSize:        DefaultBatchSize,
+		InitClient: WrapAwsInitClient(func(r *resource.Resource[*cloudControlClient], cfg aws.Config) {
+			r.Scope.Region = cfg.Region
+			if decl.Global {
+				r.Scope.Region = "global"
+			}
+			r.Client = &cloudControlClient{api: cloudcontrol.NewFromConfig(cfg), decl: decl}
+		}),
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			cc, _ := c.CloudControlType(decl.Type)
+			return cc.ResourceType
+		},
+		Lister: listCloudControlResources,
+		Nuker:  resource.SimpleBatchDeleter(deleteCloudControlResource),
+		// The handlers of the type itself need further permissions, listed in its registry schema.
+		Permissions: resource.Permissions{
+			List: []string{"cloudformation:GetResource", "cloudformation:ListResources"},
+			Nuke: []string{"cloudformation:DeleteResource", "cloudformation:GetResourceRequestStatus"},
+		},
+	})
+}
+
+// listCloudControlResources lists every resource of the declared type that matches the config filters.
+// Many list handlers return only the primary identifier, so the full model is fetched with GetResource
+// when the listed model has neither a creation time nor tags.
+func listCloudControlResources(ctx context.Context, client *cloudControlClient, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
+	var ids []*string
+
+	paginator := cloudcontrol.NewListResourcesPaginator(client.api, &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(client.decl.Type),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, desc := range page.ResourceDescriptions {
+			value := client.resourceValue(desc.Identifier, desc.Properties)
+			if value.Time == nil && value.Tags == nil {
+				output, err := client.api.GetResource(ctx, &cloudcontrol.GetResourceInput{
+					TypeName:   aws.String(client.decl.Type),
+					Identifier: desc.Identifier,
+				})
+				if err != nil {
+					logging.Debugf("[%s] Failed to get resource model for %s: %s", client.decl.Type, aws.ToString(desc.Identifier), err)
+				} else if output.ResourceDescription != nil {
+					value = client.resourceValue(desc.Identifier, output.ResourceDescription.Properties)
+				}
+			}
+
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, desc.Identifier)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// resourceValue extracts the name, creation time and tags from a JSON resource model. The name falls
+// back to the primary identifier, and Tags is nil when the model has no Tags property.
+func (c *cloudControlClient) resourceValue(identifier *string, properties *string) config.ResourceValue {
+	value := config.ResourceValue{ID: identifier, Name: identifier}
+
+	var model map[string]any
+	if err := json.Unmarshal([]byte(aws.ToString(properties)), &model); err != nil {
+		return value
+	}
+
+	nameProperties := []string{"Name", typeSuffix(c.decl.Type) + "Name"}
+	if c.decl.NameProperty != "" {
+		nameProperties = []string{c.decl.NameProperty}
+	}
+	for _, p := range nameProperties {
+		if name, ok := model[p].(string); ok && name != "" {
+			value.Name = aws.String(name)
+			break
+		}
+	}
+
+	timeProperties := cloudControlTimeProperties
+	if c.decl.TimeProperty != "" {
+		timeProperties = []string{c.decl.TimeProperty}
+	}
+	for _, p := range timeProperties {
+		if t := parseModelTime(model[p]); t != nil {
+			value.Time = t
+			break
+		}
+	}
+
+	value.Tags = parseModelTags(model["Tags"])
+	return value
+}
+
+// typeSuffix returns the last segment of a CloudFormation type name, e.g. "LogGroup" for "AWS::Logs::LogGroup".
+func typeSuffix(typeName string) string {
+	return typeName[strings.LastIndex(typeName, "::")+2:]
+}
+
+// parseModelTime accepts RFC 3339 timestamps and Unix epoch seconds or milliseconds.
+func parseModelTime(v any) *time.Time {
+	switch t := v.(type) {
+	case string:
+		for _, layout := range []string{time.RFC3339, time.DateTime} {
+			if parsed, err := time.Parse(layout, t); err == nil {
+				return &parsed
+			}
+		}
+	case float64:
+		var parsed time.Time
+		if t > 1e12 {
+			parsed = time.UnixMilli(int64(t))
+		} else {
+			parsed = time.Unix(int64(t), 0)
+		}
+		return &parsed
+	}
+	return nil
+}
+
+// parseModelTags accepts both tag shapes used by CloudFormation schemas: a list of Key/Value
+// objects and a plain string map.
+func parseModelTags(v any) map[string]string {
+	switch t := v.(type) {
+	case []any:
+		tags := make(map[string]string, len(t))
+		for _, item := range t {
+			if tag, ok := item.(map[string]any); ok {
+				key, _ := tag["Key"].(string)
+				val, _ := tag["Value"].(string)
+				tags[key] = val
+			}
+		}
+		return tags
+	case map[string]any:
+		tags := make(map[string]string, len(t))
+		for key, val := range t {
+			tags[key], _ = val.(string)
+		}
+		return tags
+	}
+	return nil
+}
+
+// deleteCloudControlResource requests deletion and polls the request status until it completes.
+// A resource that is already gone counts as deleted.
+func deleteCloudControlResource(ctx context.Context, client *cloudControlClient, id *string) error {
+	output, err := client.api.DeleteResource(ctx, &cloudcontrol.DeleteResourceInput{
+		TypeName:   aws.String(client.decl.Type),
+		Identifier: id,
+	})
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if done, err := progressEventDone(output.ProgressEvent); done || err != nil || output.ProgressEvent == nil {
+		return err
+	}
+
+	token := output.ProgressEvent.RequestToken
+	return util.PollUntil(ctx, fmt.Sprintf("%s %s deletion", client.decl.Type, aws.ToString(id)), cloudControlPollInterval, cloudControlDeleteTimeout,
+		func(ctx context.Context) (bool, error) {
+			status, err := client.api.GetResourceRequestStatus(ctx, &cloudcontrol.GetResourceRequestStatusInput{RequestToken: token})
+			if err != nil {
+				return false, err
+			}
+			return progressEventDone(status.ProgressEvent)
+		})
+}
+
+// progressEventDone reports whether a delete request has finished, returning an error if it failed.
+func progressEventDone(event *types.ProgressEvent) (bool, error) {
+	if event == nil {
+		return false, nil
+	}
+	switch event.OperationStatus {
+	case types.OperationStatusSuccess:
+		return true, nil
+	case types.OperationStatusFailed:
+		if event.ErrorCode == types.HandlerErrorCodeNotFound {
+			return true, nil
+		}
+		return false, fmt.Errorf("%s: %s", event.ErrorCode, aws.ToString(event.StatusMessage))
+	case types.OperationStatusCancelComplete:
+		return false, fmt.Errorf("delete request was cancelled")
+	}
+	return false, nil
+}
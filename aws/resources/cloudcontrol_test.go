@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCloudControlClient struct {
+	ListResourcesOutput            cloudcontrol.ListResourcesOutput
+	GetResourceOutput              map[string]cloudcontrol.GetResourceOutput
+	DeleteResourceOutput           cloudcontrol.DeleteResourceOutput
+	GetResourceRequestStatusOutput cloudcontrol.GetResourceRequestStatusOutput
+}
+
+func (m *mockCloudControlClient) ListResources(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+	return &m.ListResourcesOutput, nil
+}
+
+func (m *mockCloudControlClient) GetResource(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error) {
+	output := m.GetResourceOutput[aws.ToString(params.Identifier)]
+	return &output, nil
+}
+
+func (m *mockCloudControlClient) DeleteResource(ctx context.Context, params *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+	return &m.DeleteResourceOutput, nil
+}
+
+func (m *mockCloudControlClient) GetResourceRequestStatus(ctx context.Context, params *cloudcontrol.GetResourceRequestStatusInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+	return &m.GetResourceRequestStatusOutput, nil
+}
+
+func TestCloudControlResource_ResourceName(t *testing.T) {
+	t.Parallel()
+	r := NewCloudControlResource(config.CloudControlResourceType{Type: "AWS::Glue::Job"})
+	assert.Equal(t, "AWS::Glue::Job", r.ResourceName())
+}
+
+func TestListCloudControlResources(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	old := now.Add(-48 * time.Hour)
+
+	mock := &mockCloudControlClient{
+		ListResourcesOutput: cloudcontrol.ListResourcesOutput{
+			ResourceDescriptions: []types.ResourceDescription{
+				{Identifier: aws.String("job-1"), Properties: aws.String(`{"Name":"job-1","CreatedOn":"` + old.Format(time.RFC3339) + `","Tags":{"env":"dev"}}`)},
+				{Identifier: aws.String("job-2"), Properties: aws.String(`{"Name":"prod-job","CreatedOn":"` + old.Format(time.RFC3339) + `","Tags":{"env":"prod"}}`)},
+				{Identifier: aws.String("job-3"), Properties: aws.String(`{"Name":"job-3"}`)},
+			},
+		},
+		GetResourceOutput: map[string]cloudcontrol.GetResourceOutput{
+			"job-3": {ResourceDescription: &types.ResourceDescription{
+				Identifier: aws.String("job-3"),
+				Properties: aws.String(`{"Name":"job-3","CreatedOn":"` + now.Format(time.RFC3339) + `","Tags":{}}`),
+			}},
+		},
+	}
+	client := &cloudControlClient{api: mock, decl: config.CloudControlResourceType{Type: "AWS::Glue::Job", TimeProperty: "CreatedOn"}}
+
+	tests := map[string]struct {
+		configObj config.ResourceType
+		expected  []string
+	}{
+		"emptyFilter": {
+			configObj: config.ResourceType{},
+			expected:  []string{"job-1", "job-2", "job-3"},
+		},
+		"nameExclusionFilter": {
+			configObj: config.ResourceType{
+				ExcludeRule: config.FilterRule{
+					NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^prod-")}},
+				},
+			},
+			expected: []string{"job-1", "job-3"},
+		},
+		"timeAfterExclusionFilter": {
+			configObj: config.ResourceType{
+				ExcludeRule: config.FilterRule{TimeAfter: aws.Time(now.Add(-1 * time.Hour))},
+			},
+			expected: []string{"job-1", "job-2"},
+		},
+		"tagInclusionFilter": {
+			configObj: config.ResourceType{
+				IncludeRule: config.FilterRule{
+					Tags: map[string]config.Expression{"env": {RE: *regexp.MustCompile("^dev$")}},
+				},
+			},
+			expected: []string{"job-1"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ids, err := listCloudControlResources(context.Background(), client, resource.Scope{}, tc.configObj)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+		})
+	}
+}
+
+func TestCloudControlResourceValue(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	client := &cloudControlClient{decl: config.CloudControlResourceType{Type: "AWS::Logs::LogGroup"}}
+
+	value := client.resourceValue(aws.String("id"), aws.String(`{"LogGroupName":"app","CreationTime":1714564800000,"Tags":[{"Key":"team","Value":"platform"}]}`))
+	require.Equal(t, "app", aws.ToString(value.Name))
+	require.Equal(t, created, value.Time.UTC())
+	require.Equal(t, map[string]string{"team": "platform"}, value.Tags)
+
+	// Without known properties the identifier is used as the name and tags are unsupported.
+	value = client.resourceValue(aws.String("id"), aws.String(`{"Arn":"arn:aws:logs:::app"}`))
+	require.Equal(t, "id", aws.ToString(value.Name))
+	require.Nil(t, value.Time)
+	require.Nil(t, value.Tags)
+}
+
+func TestDeleteCloudControlResource(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		deleteEvent types.ProgressEvent
+		statusEvent types.ProgressEvent
+		err         string
+	}{
+		"pollsUntilSuccess": {
+			deleteEvent: types.ProgressEvent{OperationStatus: types.OperationStatusInProgress, RequestToken: aws.String("token")},
+			statusEvent: types.ProgressEvent{OperationStatus: types.OperationStatusSuccess},
+		},
+		"alreadyDeleted": {
+			deleteEvent: types.ProgressEvent{OperationStatus: types.OperationStatusFailed, ErrorCode: types.HandlerErrorCodeNotFound},
+		},
+		"failed": {
+			deleteEvent: types.ProgressEvent{OperationStatus: types.OperationStatusInProgress, RequestToken: aws.String("token")},
+			statusEvent: types.ProgressEvent{
+				OperationStatus: types.OperationStatusFailed,
+				ErrorCode:       types.HandlerErrorCodeResourceConflict,
+				StatusMessage:   aws.String("job is running"),
+			},
+			err: "ResourceConflict: job is running",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mock := &mockCloudControlClient{
+				DeleteResourceOutput:           cloudcontrol.DeleteResourceOutput{ProgressEvent: &tc.deleteEvent},
+				GetResourceRequestStatusOutput: cloudcontrol.GetResourceRequestStatusOutput{ProgressEvent: &tc.statusEvent},
+			}
+			client := &cloudControlClient{api: mock, decl: config.CloudControlResourceType{Type: "AWS::Glue::Job"}}
+
+			err := deleteCloudControlResource(context.Background(), client, aws.String("job-1"))
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -2,6 +2,10 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -11,6 +15,7 @@ import (
 	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
+	"golang.org/x/sync/errgroup"
 )
 
 // CloudFormationStacksAPI defines the interface for CloudFormation stack operations.
@@ -18,6 +23,39 @@ type CloudFormationStacksAPI interface {
 	ListStacks(ctx context.Context, params *cloudformation.ListStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListStacksOutput, error)
 	DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error)
 	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
+	DescribeStackResources(ctx context.Context, params *cloudformation.DescribeStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourcesOutput, error)
+	UpdateTerminationProtection(ctx context.Context, params *cloudformation.UpdateTerminationProtectionInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateTerminationProtectionOutput, error)
+}
+
+const (
+	cloudFormationStackPollInterval  = 10 * time.Second
+	cloudFormationStackDeleteTimeout = 30 * time.Minute
+
+	// cloudFormationNestedStackType is the CloudFormation type of a nested stack resource.
+	cloudFormationNestedStackType = "AWS::CloudFormation::Stack"
+)
+
+// retainedResourceTypes maps the CloudFormation types that commonly block stack deletion to the
+// resource types that delete them once the stack has retained them. The physical ID CloudFormation
+// reports for each of these types is the identifier their listers return.
+var retainedResourceTypes = map[string]func() AwsResource{
+	"AWS::S3::Bucket":            NewS3Buckets,
+	"AWS::EC2::NetworkInterface": NewNetworkInterface,
+	"AWS::EC2::SecurityGroup":    NewSecurityGroup,
+	"AWS::EC2::Instance":         NewEC2Instances,
+	"AWS::ECR::Repository":       NewECR,
+	"AWS::Logs::LogGroup":        NewCloudWatchLogGroups,
+}
+
+// cloudFormationStacksResource holds the stack options and the AWS config that the nuker needs
+// to recover stacks that fail to delete.
+type cloudFormationStacksResource struct {
+	opts   config.CloudFormationStackResourceType
+	awsCfg aws.Config
+
+	// nukeRetained deletes a resource that CloudFormation retained, given its CloudFormation type
+	// and physical ID. Tests replace it to avoid creating real clients.
+	nukeRetained func(ctx context.Context, cfnType string, physicalID string) error
 }
 
 // activeStackStatuses defines the stack statuses we care about (excluding DELETE_COMPLETE, DELETE_IN_PROGRESS).
@@ -48,18 +86,25 @@ var activeStackStatuses = []types.StackStatus{
 
 // NewCloudFormationStacks creates a new CloudFormationStacks resource using the generic resource pattern.
 func NewCloudFormationStacks() AwsResource {
+	stacks := &cloudFormationStacksResource{}
+	stacks.nukeRetained = stacks.nukeRetainedResource
+
 	return NewAwsResource(&resource.Resource[CloudFormationStacksAPI]{
 		ResourceTypeName: "cloudformation-stack",
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudFormationStacksAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = cloudformation.NewFromConfig(cfg)
+			// Retained resources are handed to other resource types, which need the same config.
+			stacks.awsCfg = cfg
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudFormationStack
+			// Capture the recovery options for use in the nuker
+			stacks.opts = c.CloudFormationStack
+			return c.CloudFormationStack.ResourceType
 		},
 		Lister: listCloudFormationStacks,
-		Nuker:  resource.SimpleBatchDeleter(deleteCloudFormationStack),
+		Nuker:  stacks.nuke,
 	})
 }
 
@@ -122,3 +167,288 @@ func deleteCloudFormationStack(ctx context.Context, client CloudFormationStacksA
 	})
 	return err
 }
+
+// nuke deletes the given stacks. Nested stacks whose parent is also being deleted are not deleted
+// directly, since CloudFormation deletes them with their parent; they are reported after it.
+func (r *cloudFormationStacksResource) nuke(ctx context.Context, client CloudFormationStacksAPI, scope resource.Scope, resourceType string, identifiers []*string) []resource.NukeResult {
+	if len(identifiers) == 0 {
+		logging.Debugf("No %s to nuke in %s", resourceType, scope)
+		return nil
+	}
+	logging.Infof("Deleting %d %s in %s", len(identifiers), resourceType, scope)
+
+	results := make([]resource.NukeResult, 0, len(identifiers))
+	stacks := make(map[string]types.Stack, len(identifiers))
+	byID := make(map[string]string, len(identifiers))
+	for _, id := range identifiers {
+		name := aws.ToString(id)
+		stack, err := describeStack(ctx, client, name)
+		if err != nil {
+			results = append(results, resource.NukeResult{Identifier: name, Error: err})
+			continue
+		}
+		stacks[name] = stack
+		byID[aws.ToString(stack.StackId)] = name
+	}
+
+	parents := make(map[string]string)
+	var roots, children []string
+	for _, id := range identifiers {
+		name := aws.ToString(id)
+		stack, ok := stacks[name]
+		if !ok {
+			continue
+		}
+		if parent := targetedAncestor(stack, byID); parent != "" {
+			parents[name] = parent
+			children = append(children, name)
+			continue
+		}
+		roots = append(roots, name)
+	}
+
+	// Start deleting every root stack first, then, with recover_delete_failed, wait for and recover
+	// them concurrently, so that a slow stack does not hold up the others.
+	rootResults := make([]resource.NukeResult, len(roots))
+	rootSteps := make([][]string, len(roots))
+	for i, name := range roots {
+		steps, err := r.startStackDeletion(ctx, client, stacks[name])
+		rootSteps[i] = steps
+		rootResults[i] = resource.NukeResult{Identifier: name, Error: err}
+	}
+	if r.opts.RecoverDeleteFailed {
+		group := new(errgroup.Group)
+		group.SetLimit(util.GetParallelism(ctx))
+		for i, name := range roots {
+			if rootResults[i].Error != nil {
+				continue
+			}
+			group.Go(func() error {
+				steps, err := r.recoverStackDeletion(ctx, client, stacks[name])
+				rootSteps[i] = append(rootSteps[i], steps...)
+				rootResults[i].Error = err
+				return nil
+			})
+		}
+		_ = group.Wait()
+	}
+
+	failed := make(map[string]bool)
+	for i, result := range rootResults {
+		if result.Error != nil {
+			failed[result.Identifier] = true
+		}
+		result.Detail = strings.Join(rootSteps[i], "; ")
+		results = append(results, result)
+	}
+
+	for _, name := range children {
+		root := parents[name]
+		for parents[root] != "" {
+			root = parents[root]
+		}
+		if failed[root] {
+			results = append(results, resource.NukeResult{Identifier: name, Error: fmt.Errorf("parent stack %s was not deleted", root)})
+			continue
+		}
+		results = append(results, resource.NukeResult{Identifier: name, Detail: "deleted with parent stack " + root})
+	}
+
+	return results
+}
+
+// targetedAncestor returns the name of the parent or, failing that, the root of a nested stack if
+// that stack is also being deleted, or "" otherwise.
+func targetedAncestor(stack types.Stack, byID map[string]string) string {
+	for _, id := range []*string{stack.ParentId, stack.RootId} {
+		if name, ok := byID[aws.ToString(id)]; ok && id != nil {
+			return name
+		}
+	}
+	return ""
+}
+
+// deleteStack deletes a stack and returns the steps taken. With recover_delete_failed, it waits for
+// the deletion and recovers the stack if it ends up in DELETE_FAILED.
+func (r *cloudFormationStacksResource) deleteStack(ctx context.Context, client CloudFormationStacksAPI, stack types.Stack) ([]string, error) {
+	steps, err := r.startStackDeletion(ctx, client, stack)
+	if err != nil || !r.opts.RecoverDeleteFailed {
+		return steps, err
+	}
+	recovered, err := r.recoverStackDeletion(ctx, client, stack)
+	return append(steps, recovered...), err
+}
+
+// startStackDeletion disables termination protection if allowed and requests the deletion of a stack,
+// without waiting for it, and returns the steps taken.
+func (r *cloudFormationStacksResource) startStackDeletion(ctx context.Context, client CloudFormationStacksAPI, stack types.Stack) ([]string, error) {
+	var steps []string
+	name := aws.ToString(stack.StackName)
+
+	if aws.ToBool(stack.EnableTerminationProtection) {
+		if !r.opts.DisableTerminationProtection {
+			return nil, TerminationProtectedStackError{StackName: name}
+		}
+		_, err := client.UpdateTerminationProtection(ctx, &cloudformation.UpdateTerminationProtectionInput{
+			StackName:                   stack.StackId,
+			EnableTerminationProtection: aws.Bool(false),
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		steps = append(steps, "disabled termination protection")
+	}
+
+	if err := deleteCloudFormationStack(ctx, client, stack.StackId); err != nil {
+		return steps, errors.WithStackTrace(err)
+	}
+	return steps, nil
+}
+
+// recoverStackDeletion waits for the deletion of a stack and, if the stack ends up in DELETE_FAILED,
+// retries while retaining the resources that failed to delete and then deletes those resources with
+// their own resource types. It returns the steps taken.
+func (r *cloudFormationStacksResource) recoverStackDeletion(ctx context.Context, client CloudFormationStacksAPI, stack types.Stack) ([]string, error) {
+	var steps []string
+	name := aws.ToString(stack.StackName)
+
+	status, err := waitForStackDeletion(ctx, client, stack.StackId)
+	if err != nil || status == types.StackStatusDeleteComplete {
+		return steps, err
+	}
+
+	// The stack is in DELETE_FAILED: retain the resources that failed and delete them afterwards.
+	retained, err := failedStackResources(ctx, client, stack.StackId)
+	if err != nil {
+		return steps, err
+	}
+	if len(retained) == 0 {
+		return steps, fmt.Errorf("stack %s is in %s: %s", name, status, aws.ToString(stack.StackStatusReason))
+	}
+
+	logicalIDs := make([]string, 0, len(retained))
+	for _, res := range retained {
+		logicalIDs = append(logicalIDs, aws.ToString(res.LogicalResourceId))
+	}
+	_, err = client.DeleteStack(ctx, &cloudformation.DeleteStackInput{
+		StackName:       stack.StackId,
+		RetainResources: logicalIDs,
+	})
+	if err != nil {
+		return steps, errors.WithStackTrace(err)
+	}
+	if status, err = waitForStackDeletion(ctx, client, stack.StackId); err != nil {
+		return steps, err
+	}
+	if status != types.StackStatusDeleteComplete {
+		return steps, fmt.Errorf("stack %s is still in %s after retaining %s", name, status, strings.Join(logicalIDs, ", "))
+	}
+	steps = append(steps, "retained "+strings.Join(logicalIDs, ", "))
+
+	var leftBehind []string
+	for _, res := range retained {
+		cfnType, physicalID := aws.ToString(res.ResourceType), aws.ToString(res.PhysicalResourceId)
+		if err := r.nukeRetainedOrNested(ctx, client, cfnType, physicalID); err != nil {
+			logging.Errorf("[Failed] %s %s retained by stack %s: %s", cfnType, physicalID, name, err)
+			leftBehind = append(leftBehind, fmt.Sprintf("%s %s", cfnType, physicalID))
+			continue
+		}
+		steps = append(steps, fmt.Sprintf("deleted %s %s", cfnType, physicalID))
+	}
+	if len(leftBehind) > 0 {
+		return steps, fmt.Errorf("stack deleted, but retained resources were left behind: %s", strings.Join(leftBehind, ", "))
+	}
+	return steps, nil
+}
+
+// nukeRetainedOrNested deletes a retained resource. Nested stacks are recovered like any other stack.
+func (r *cloudFormationStacksResource) nukeRetainedOrNested(ctx context.Context, client CloudFormationStacksAPI, cfnType string, physicalID string) error {
+	if physicalID == "" {
+		return fmt.Errorf("no physical ID")
+	}
+	if cfnType != cloudFormationNestedStackType {
+		return r.nukeRetained(ctx, cfnType, physicalID)
+	}
+	nested, err := describeStack(ctx, client, physicalID)
+	if err != nil {
+		return err
+	}
+	if nested.StackStatus == types.StackStatusDeleteComplete {
+		return nil
+	}
+	_, err = r.deleteStack(ctx, client, nested)
+	return err
+}
+
+// nukeRetainedResource hands a retained resource to the resource type that deletes it.
+func (r *cloudFormationStacksResource) nukeRetainedResource(ctx context.Context, cfnType string, physicalID string) error {
+	newResource, ok := retainedResourceTypes[cfnType]
+	if !ok {
+		return fmt.Errorf("cloud-nuke cannot delete resources of type %s", cfnType)
+	}
+	res := newResource()
+	res.Init(r.awsCfg)
+	_, err := res.Nuke(ctx, []string{physicalID})
+	return err
+}
+
+// describeStack returns the details of a single stack, by name or ID.
+func describeStack(ctx context.Context, client CloudFormationStacksAPI, stackName string) (types.Stack, error) {
+	output, err := client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return types.Stack{}, errors.WithStackTrace(err)
+	}
+	if len(output.Stacks) == 0 {
+		return types.Stack{}, fmt.Errorf("stack %s not found", stackName)
+	}
+	return output.Stacks[0], nil
+}
+
+// waitForStackDeletion polls a stack, by ID so that it can still be described once deleted, until
+// its deletion either completes or fails, and returns the final status.
+func waitForStackDeletion(ctx context.Context, client CloudFormationStacksAPI, stackID *string) (types.StackStatus, error) {
+	var status types.StackStatus
+	err := util.PollUntil(ctx, fmt.Sprintf("CloudFormation stack %s deletion", aws.ToString(stackID)), cloudFormationStackPollInterval, cloudFormationStackDeleteTimeout,
+		func(ctx context.Context) (bool, error) {
+			stack, err := describeStack(ctx, client, aws.ToString(stackID))
+			if err != nil {
+				return false, err
+			}
+			status = stack.StackStatus
+			return status == types.StackStatusDeleteComplete || status == types.StackStatusDeleteFailed, nil
+		})
+	return status, err
+}
+
+// failedStackResources returns the resources of a stack that failed to delete, sorted by logical ID.
+func failedStackResources(ctx context.Context, client CloudFormationStacksAPI, stackID *string) ([]types.StackResource, error) {
+	output, err := client.DescribeStackResources(ctx, &cloudformation.DescribeStackResourcesInput{
+		StackName: stackID,
+	})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	var failed []types.StackResource
+	for _, res := range output.StackResources {
+		if res.ResourceStatus == types.ResourceStatusDeleteFailed {
+			failed = append(failed, res)
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		return aws.ToString(failed[i].LogicalResourceId) < aws.ToString(failed[j].LogicalResourceId)
+	})
+	return failed, nil
+}
+
+// TerminationProtectedStackError is returned when a stack has termination protection enabled and
+// disable_termination_protection is not set.
+type TerminationProtectedStackError struct {
+	StackName string
+}
+
+func (e TerminationProtectedStackError) Error() string {
+	return fmt.Sprintf("stack %s has termination protection enabled; set disable_termination_protection to delete it", e.StackName)
+}
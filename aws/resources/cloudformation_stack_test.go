@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"regexp"
+	"sync"
 	"testing"
 	"time"
 
@@ -140,3 +141,197 @@ func TestCloudFormationStackNuke(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+// mockedRecoveringCloudFormationStacks tracks stack deletion so that a stack ends in DELETE_FAILED
+// until it is deleted again with every failed resource retained.
+type mockedRecoveringCloudFormationStacks struct {
+	CloudFormationStacksAPI
+	mu             sync.Mutex
+	Stacks         map[string]*types.Stack
+	FailedLogicals []string
+	DeleteCalls    []*cloudformation.DeleteStackInput
+	ProtectCalls   []*cloudformation.UpdateTerminationProtectionInput
+}
+
+func (m *mockedRecoveringCloudFormationStacks) DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name, stack := range m.Stacks {
+		if name == *params.StackName || aws.ToString(stack.StackId) == *params.StackName {
+			return &cloudformation.DescribeStacksOutput{Stacks: []types.Stack{*stack}}, nil
+		}
+	}
+	return &cloudformation.DescribeStacksOutput{}, nil
+}
+
+func (m *mockedRecoveringCloudFormationStacks) DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.DeleteCalls = append(m.DeleteCalls, params)
+	for _, stack := range m.Stacks {
+		if aws.ToString(stack.StackId) != aws.ToString(params.StackName) {
+			continue
+		}
+		stack.StackStatus = types.StackStatusDeleteComplete
+		if len(m.FailedLogicals) > 0 && len(params.RetainResources) != len(m.FailedLogicals) {
+			stack.StackStatus = types.StackStatusDeleteFailed
+		}
+	}
+	return &cloudformation.DeleteStackOutput{}, nil
+}
+
+func (m *mockedRecoveringCloudFormationStacks) DescribeStackResources(ctx context.Context, params *cloudformation.DescribeStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourcesOutput, error) {
+	output := &cloudformation.DescribeStackResourcesOutput{
+		StackResources: []types.StackResource{{
+			LogicalResourceId:  aws.String("Queue"),
+			PhysicalResourceId: aws.String("queue-url"),
+			ResourceType:       aws.String("AWS::SQS::Queue"),
+			ResourceStatus:     types.ResourceStatusDeleteComplete,
+		}},
+	}
+	for _, logicalID := range m.FailedLogicals {
+		output.StackResources = append(output.StackResources, types.StackResource{
+			LogicalResourceId:  aws.String(logicalID),
+			PhysicalResourceId: aws.String(logicalID + "-physical"),
+			ResourceType:       aws.String("AWS::S3::Bucket"),
+			ResourceStatus:     types.ResourceStatusDeleteFailed,
+		})
+	}
+	return output, nil
+}
+
+func (m *mockedRecoveringCloudFormationStacks) UpdateTerminationProtection(ctx context.Context, params *cloudformation.UpdateTerminationProtectionInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateTerminationProtectionOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ProtectCalls = append(m.ProtectCalls, params)
+	return &cloudformation.UpdateTerminationProtectionOutput{}, nil
+}
+
+func newMockedStack(name string, protected bool) *types.Stack {
+	return &types.Stack{
+		StackName:                   aws.String(name),
+		StackId:                     aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/" + name + "/id"),
+		StackStatus:                 types.StackStatusCreateComplete,
+		EnableTerminationProtection: aws.Bool(protected),
+	}
+}
+
+func TestCloudFormationStackNukeRecoversDeleteFailed(t *testing.T) {
+	t.Parallel()
+
+	client := &mockedRecoveringCloudFormationStacks{
+		Stacks:         map[string]*types.Stack{"test-stack": newMockedStack("test-stack", false)},
+		FailedLogicals: []string{"LogsBucket", "DataBucket"},
+	}
+	var nuked []string
+	stacks := &cloudFormationStacksResource{opts: config.CloudFormationStackResourceType{RecoverDeleteFailed: true}}
+	stacks.nukeRetained = func(ctx context.Context, cfnType string, physicalID string) error {
+		nuked = append(nuked, cfnType+" "+physicalID)
+		return nil
+	}
+
+	results := stacks.nuke(context.Background(), client, resource.Scope{Region: "us-east-1"}, "cloudformation-stack", aws.StringSlice([]string{"test-stack"}))
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Error)
+	require.Equal(t, "retained DataBucket, LogsBucket; deleted AWS::S3::Bucket DataBucket-physical; deleted AWS::S3::Bucket LogsBucket-physical", results[0].Detail)
+	require.Len(t, client.DeleteCalls, 2)
+	require.Equal(t, []string{"DataBucket", "LogsBucket"}, client.DeleteCalls[1].RetainResources)
+	require.Equal(t, []string{"AWS::S3::Bucket DataBucket-physical", "AWS::S3::Bucket LogsBucket-physical"}, nuked)
+}
+
+func TestCloudFormationStackNukeDeletesBeforeWaiting(t *testing.T) {
+	t.Parallel()
+
+	client := &mockedRecoveringCloudFormationStacks{
+		Stacks: map[string]*types.Stack{
+			"first":  newMockedStack("first", false),
+			"second": newMockedStack("second", false),
+		},
+		FailedLogicals: []string{"LogsBucket"},
+	}
+	var mu sync.Mutex
+	var nuked []string
+	stacks := &cloudFormationStacksResource{opts: config.CloudFormationStackResourceType{RecoverDeleteFailed: true}}
+	stacks.nukeRetained = func(ctx context.Context, cfnType string, physicalID string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		nuked = append(nuked, physicalID)
+		return nil
+	}
+
+	results := stacks.nuke(context.Background(), client, resource.Scope{Region: "us-east-1"}, "cloudformation-stack", aws.StringSlice([]string{"first", "second"}))
+	require.Len(t, results, 2)
+	require.Equal(t, "first", results[0].Identifier)
+	require.Equal(t, "second", results[1].Identifier)
+	for _, result := range results {
+		require.NoError(t, result.Error)
+	}
+
+	// Both stacks are deleted before either is waited for and retried with retained resources
+	require.Len(t, client.DeleteCalls, 4)
+	require.Empty(t, client.DeleteCalls[0].RetainResources)
+	require.Empty(t, client.DeleteCalls[1].RetainResources)
+	require.Equal(t, []string{"LogsBucket"}, client.DeleteCalls[2].RetainResources)
+	require.Equal(t, []string{"LogsBucket"}, client.DeleteCalls[3].RetainResources)
+	require.Len(t, nuked, 2)
+}
+
+func TestCloudFormationStackNukeTerminationProtection(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		disable bool
+		wantErr bool
+	}{
+		"protected":         {disable: false, wantErr: true},
+		"disableProtection": {disable: true, wantErr: false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockedRecoveringCloudFormationStacks{
+				Stacks: map[string]*types.Stack{"test-stack": newMockedStack("test-stack", true)},
+			}
+			stacks := &cloudFormationStacksResource{opts: config.CloudFormationStackResourceType{DisableTerminationProtection: tc.disable}}
+
+			results := stacks.nuke(context.Background(), client, resource.Scope{Region: "us-east-1"}, "cloudformation-stack", aws.StringSlice([]string{"test-stack"}))
+			require.Len(t, results, 1)
+			if tc.wantErr {
+				require.ErrorAs(t, results[0].Error, &TerminationProtectedStackError{})
+				require.Empty(t, client.DeleteCalls)
+				return
+			}
+			require.NoError(t, results[0].Error)
+			require.Equal(t, "disabled termination protection", results[0].Detail)
+			require.Len(t, client.ProtectCalls, 1)
+			require.False(t, aws.ToBool(client.ProtectCalls[0].EnableTerminationProtection))
+			require.Len(t, client.DeleteCalls, 1)
+		})
+	}
+}
+
+func TestCloudFormationStackNukeNestedStacks(t *testing.T) {
+	t.Parallel()
+
+	parent := newMockedStack("parent", false)
+	child := newMockedStack("child", false)
+	child.ParentId = parent.StackId
+	child.RootId = parent.StackId
+	grandchild := newMockedStack("grandchild", false)
+	grandchild.ParentId = child.StackId
+	grandchild.RootId = parent.StackId
+
+	client := &mockedRecoveringCloudFormationStacks{
+		Stacks: map[string]*types.Stack{"parent": parent, "child": child, "grandchild": grandchild},
+	}
+	stacks := &cloudFormationStacksResource{}
+
+	results := stacks.nuke(context.Background(), client, resource.Scope{Region: "us-east-1"}, "cloudformation-stack", aws.StringSlice([]string{"grandchild", "child", "parent"}))
+	require.Len(t, client.DeleteCalls, 1)
+	require.Equal(t, parent.StackId, client.DeleteCalls[0].StackName)
+	require.Equal(t, []resource.NukeResult{
+		{Identifier: "parent"},
+		{Identifier: "grandchild", Detail: "deleted with parent stack parent"},
+		{Identifier: "child", Detail: "deleted with parent stack parent"},
+	}, results)
+}
@@ -54,6 +54,7 @@ func listCloudMapNamespaces(ctx context.Context, client CloudMapNamespacesAPI, s
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   namespace.Id,
 				Name: namespace.Name,
 				Time: namespace.CreateDate,
 				Tags: tags,
@@ -59,6 +59,7 @@ func listCloudMapServices(ctx context.Context, client CloudMapServicesAPI, scope
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   service.Id,
 				Name: service.Name,
 				Time: service.CreateDate,
 				Tags: tags,
@@ -48,7 +48,7 @@ func listCloudtrailTrails(ctx context.Context, client CloudtrailTrailAPI, scope
 		// organization trails (from AWS Control Tower) with account-level trails.
 		// AWS ListTags API doesn't allow resources from multiple owners in a single call.
 		for _, trail := range page.Trails {
-			rv := config.ResourceValue{Name: trail.Name, Tags: make(map[string]string)}
+			rv := config.ResourceValue{ID: trail.TrailARN, Name: trail.Name, Tags: make(map[string]string)}
 
 			if tags, err := client.ListTags(ctx, &cloudtrail.ListTagsInput{
 				ResourceIdList: []string{*trail.TrailARN},
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -17,10 +18,14 @@ type CloudWatchLogGroupsAPI interface {
 	DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
 	DeleteLogGroup(ctx context.Context, params *cloudwatchlogs.DeleteLogGroupInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DeleteLogGroupOutput, error)
 	ListTagsForResource(ctx context.Context, params *cloudwatchlogs.ListTagsForResourceInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error)
+	PutRetentionPolicy(ctx context.Context, params *cloudwatchlogs.PutRetentionPolicyInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.PutRetentionPolicyOutput, error)
 }
 
 // NewCloudWatchLogGroups creates a new CloudWatch Log Groups resource using the generic resource pattern.
+// With enforce_retention_days set, the log groups are kept and their retention is bounded instead.
 func NewCloudWatchLogGroups() AwsResource {
+	var logGroupCfg config.CloudWatchLogGroupResourceType
+	deleteLogGroups := resource.SimpleBatchDeleter(deleteCloudWatchLogGroup)
 	return NewAwsResource(&resource.Resource[CloudWatchLogGroupsAPI]{
 		ResourceTypeName: "cloudwatch-loggroup",
 		// Tentative batch size to ensure AWS doesn't throttle. Note that CloudWatch Logs does not support bulk delete,
@@ -32,15 +37,25 @@ func NewCloudWatchLogGroups() AwsResource {
 			r.Client = cloudwatchlogs.NewFromConfig(cfg)
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudWatchLogGroup
+			logGroupCfg = c.CloudWatchLogGroup
+			return c.CloudWatchLogGroup.ResourceType
+		},
+		Lister: func(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			logGroupCfg.ResourceType = cfg
+			return listCloudWatchLogGroups(ctx, client, scope, logGroupCfg)
+		},
+		Nuker: func(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, resourceType string, identifiers []*string) []resource.NukeResult {
+			if days := logGroupCfg.EnforceRetentionDays; days > 0 {
+				return enforceLogGroupRetention(days)(ctx, client, scope, resourceType, identifiers)
+			}
+			return deleteLogGroups(ctx, client, scope, resourceType, identifiers)
 		},
-		Lister: listCloudWatchLogGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteCloudWatchLogGroup),
 	})
 }
 
-// listCloudWatchLogGroups retrieves all CloudWatch Log Groups that match the config filters.
-func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+// listCloudWatchLogGroups retrieves all CloudWatch Log Groups that match the config filters. When
+// retention is enforced, only the groups retaining events longer than allowed are returned.
+func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, cfg config.CloudWatchLogGroupResourceType) ([]*string, error) {
 	var allLogGroups []*string
 
 	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(client, &cloudwatchlogs.DescribeLogGroupsInput{})
@@ -51,6 +66,10 @@ func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI,
 		}
 
 		for _, logGroup := range page.LogGroups {
+			if days := cfg.EnforceRetentionDays; days > 0 && logGroup.RetentionInDays != nil && aws.ToInt32(logGroup.RetentionInDays) <= days {
+				continue
+			}
+
 			var creationTime *time.Time
 			if logGroup.CreationTime != nil {
 				// Convert milliseconds since epoch to time.Time object
@@ -81,6 +100,9 @@ func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI,
 				Time: creationTime,
 				Tags: tagsOutput.Tags,
 			}) {
+				if cfg.EnforceRetentionDays > 0 {
+					cfg.AddFinding(scope.Region, aws.ToString(logGroup.LogGroupName), fmt.Sprintf("retention %s, enforcing %d days", describeLogRetention(logGroup.RetentionInDays), cfg.EnforceRetentionDays))
+				}
 				allLogGroups = append(allLogGroups, logGroup.LogGroupName)
 			}
 		}
@@ -96,3 +118,33 @@ func deleteCloudWatchLogGroup(ctx context.Context, client CloudWatchLogGroupsAPI
 	})
 	return err
 }
+
+// enforceLogGroupRetention creates a nuker that sets the retention of log groups to days instead of
+// deleting them, reporting them as modified.
+func enforceLogGroupRetention(days int32) resource.NukerFunc[CloudWatchLogGroupsAPI] {
+	enforce := resource.SequentialActor("Enforcing retention on", func(ctx context.Context, client CloudWatchLogGroupsAPI, logGroupName *string) (string, error) {
+		_, err := client.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+			LogGroupName:    logGroupName,
+			RetentionInDays: aws.Int32(days),
+		})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("retention set to %d days", days), nil
+	})
+	return func(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, resourceType string, identifiers []*string) []resource.NukeResult {
+		results := enforce(ctx, client, scope, resourceType, identifiers)
+		for i := range results {
+			results[i].Modified = results[i].Error == nil
+		}
+		return results
+	}
+}
+
+// describeLogRetention describes the retention of a log group, which never expires when unset.
+func describeLogRetention(retentionInDays *int32) string {
+	if retentionInDays == nil {
+		return "never expires"
+	}
+	return fmt.Sprintf("%d days", aws.ToInt32(retentionInDays))
+}
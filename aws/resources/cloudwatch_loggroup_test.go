@@ -20,6 +20,7 @@ import (
 type mockCloudWatchLogGroupsClient struct {
 	DescribeLogGroupsOutput cloudwatchlogs.DescribeLogGroupsOutput
 	DeleteLogGroupOutput    cloudwatchlogs.DeleteLogGroupOutput
+	RetentionPolicies       map[string]int32
 }
 
 func (m *mockCloudWatchLogGroupsClient) DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
@@ -30,6 +31,11 @@ func (m *mockCloudWatchLogGroupsClient) DeleteLogGroup(ctx context.Context, para
 	return &m.DeleteLogGroupOutput, nil
 }
 
+func (m *mockCloudWatchLogGroupsClient) PutRetentionPolicy(ctx context.Context, params *cloudwatchlogs.PutRetentionPolicyInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.PutRetentionPolicyOutput, error) {
+	m.RetentionPolicies[aws.ToString(params.LogGroupName)] = aws.ToInt32(params.RetentionInDays)
+	return &cloudwatchlogs.PutRetentionPolicyOutput{}, nil
+}
+
 func (m *mockCloudWatchLogGroupsClient) ListTagsForResource(ctx context.Context, params *cloudwatchlogs.ListTagsForResourceInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error) {
 	arn := aws.ToString(params.ResourceArn)
 	// A trailing ":*" means the caller failed to normalize the ARN; mimic the real API,
@@ -69,7 +75,7 @@ func TestListCloudWatchLogGroups(t *testing.T) {
 		},
 	}
 
-	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.CloudWatchLogGroupResourceType{})
 	require.NoError(t, err)
 	require.ElementsMatch(t, []string{"log-group-1", "log-group-2"}, aws.ToStringSlice(names))
 }
@@ -93,7 +99,7 @@ func TestListCloudWatchLogGroups_WithFilter(t *testing.T) {
 		},
 	}
 
-	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, cfg)
+	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.CloudWatchLogGroupResourceType{ResourceType: cfg})
 	require.NoError(t, err)
 	require.Equal(t, []string{"log-group-1"}, aws.ToStringSlice(names))
 }
@@ -122,7 +128,7 @@ func TestListCloudWatchLogGroups_TagInclusionFilter(t *testing.T) {
 		},
 	}
 
-	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, cfg)
+	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.CloudWatchLogGroupResourceType{ResourceType: cfg})
 	require.NoError(t, err)
 	require.Equal(t, []string{"log-group-2"}, aws.ToStringSlice(names))
 }
@@ -142,7 +148,7 @@ func TestListCloudWatchLogGroups_SkipsOnTagListError(t *testing.T) {
 
 	// A log group whose tags cannot be read is skipped rather than nuked, since it may
 	// carry an exclude tag we could not observe.
-	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.CloudWatchLogGroupResourceType{})
 	require.NoError(t, err)
 	require.Equal(t, []string{"log-group-ok"}, aws.ToStringSlice(names))
 }
@@ -154,3 +160,33 @@ func TestDeleteCloudWatchLogGroup(t *testing.T) {
 	err := deleteCloudWatchLogGroup(context.Background(), mock, aws.String("test-log-group"))
 	require.NoError(t, err)
 }
+
+func TestCloudWatchLogGroups_EnforceRetention(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UnixMilli()
+	mock := &mockCloudWatchLogGroupsClient{
+		DescribeLogGroupsOutput: cloudwatchlogs.DescribeLogGroupsOutput{
+			LogGroups: []types.LogGroup{
+				{LogGroupName: aws.String("never-expires"), CreationTime: aws.Int64(now)},
+				{LogGroupName: aws.String("too-long"), CreationTime: aws.Int64(now), RetentionInDays: aws.Int32(365)},
+				{LogGroupName: aws.String("bounded"), CreationTime: aws.Int64(now), RetentionInDays: aws.Int32(14)},
+			},
+		},
+		RetentionPolicies: map[string]int32{},
+	}
+
+	cfg := config.CloudWatchLogGroupResourceType{EnforceRetentionDays: 30}
+	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"never-expires", "too-long"}, aws.ToStringSlice(names))
+
+	results := enforceLogGroupRetention(30)(context.Background(), mock, resource.Scope{}, "cloudwatch-loggroup", names)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.NoError(t, result.Error)
+		require.True(t, result.Modified)
+		require.Equal(t, "retention set to 30 days", result.Detail)
+	}
+	require.Equal(t, map[string]int32{"never-expires": 30, "too-long": 30}, mock.RetentionPolicies)
+}
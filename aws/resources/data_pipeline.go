@@ -77,6 +77,7 @@ func listDataPipelines(ctx context.Context, client DataPipelineAPI, scope resour
 				name := aws.ToString(desc.Name)
 
 				rv := config.ResourceValue{
+					ID:   desc.PipelineId,
 					Name: &name,
 					Tags: util.ConvertDataPipelineTagsToMap(desc.Tags),
 				}
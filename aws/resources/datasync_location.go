@@ -70,6 +70,7 @@ func listDataSyncLocations(ctx context.Context, client DataSyncLocationAPI, scop
 			// Use LocationUri as the name for filtering since LocationListEntry
 			// does not include a Name field or CreationTime.
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   location.LocationArn,
 				Name: location.LocationUri,
 				Tags: tags,
 			}) {
@@ -64,6 +64,7 @@ func listDataSyncTasks(ctx context.Context, client DataSyncTaskAPI, scope resour
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   task.TaskArn,
 				Name: task.Name,
 				Tags: tags,
 			}) {
@@ -60,10 +60,11 @@ func listEBSVolumes(ctx context.Context, client EBSVolumesAPI, scope resource.Sc
 
 		for _, volume := range page.Volumes {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   volume.VolumeId,
 				Name: util.GetEC2ResourceNameTagValue(volume.Tags),
 				Time: volume.CreateTime,
 				Tags: util.ConvertTypesTagsToMap(volume.Tags),
-			}) {
+			}) && cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(volume.VolumeId), ebsVolumeOrphanReason(volume)) {
 				volumeIds = append(volumeIds, volume.VolumeId)
 			}
 		}
@@ -72,6 +73,14 @@ func listEBSVolumes(ctx context.Context, client EBSVolumesAPI, scope resource.Sc
 	return volumeIds, nil
 }
 
+// ebsVolumeOrphanReason returns why a volume is orphaned, or "" if it may be in use.
+func ebsVolumeOrphanReason(volume types.Volume) string {
+	if volume.State != types.VolumeStateAvailable || len(volume.Attachments) > 0 {
+		return ""
+	}
+	return "volume is not attached to any instance"
+}
+
 // verifyEBSVolumePermission performs a dry-run delete to check permissions.
 func verifyEBSVolumePermission(ctx context.Context, client EBSVolumesAPI, id *string) error {
 	_, err := client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{
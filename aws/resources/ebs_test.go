@@ -92,6 +92,30 @@ func TestListEBSVolumes(t *testing.T) {
 	}
 }
 
+func TestListEBSVolumesOrphansOnly(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockEBSVolumesClient{
+		DescribeVolumesOutput: ec2.DescribeVolumesOutput{
+			Volumes: []types.Volume{
+				{VolumeId: aws.String("vol-available"), State: types.VolumeStateAvailable},
+				{VolumeId: aws.String("vol-creating"), State: types.VolumeStateCreating},
+			},
+		},
+	}
+
+	findings := config.NewFindings()
+	configObj := config.Config{}
+	configObj.RecordFindings(findings)
+	configObj.OnlyOrphans()
+
+	ids, err := listEBSVolumes(context.Background(), mock, resource.Scope{Region: "us-east-1"}, configObj.EBSVolume)
+	require.NoError(t, err)
+	require.Equal(t, []string{"vol-available"}, aws.ToStringSlice(ids))
+	require.Equal(t, "volume is not attached to any instance", findings.Detail("us-east-1", "vol-available"))
+	require.Empty(t, findings.Detail("us-east-1", "vol-creating"))
+}
+
 func TestDeleteEBSVolume(t *testing.T) {
 	t.Parallel()
 
@@ -2,8 +2,10 @@ package resources
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
@@ -20,32 +22,44 @@ type EC2InstancesAPI interface {
 	DescribeAddresses(ctx context.Context, params *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error)
 	ReleaseAddress(ctx context.Context, params *ec2.ReleaseAddressInput, optFns ...func(*ec2.Options)) (*ec2.ReleaseAddressOutput, error)
 	TerminateInstances(ctx context.Context, params *ec2.TerminateInstancesInput, optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
+	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	CreateTags(ctx context.Context, params *ec2.CreateTagsInput, optFns ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, params *ec2.DeleteTagsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
 }
 
 // NewEC2Instances creates a new EC2 Instances resource using the generic resource pattern.
 func NewEC2Instances() AwsResource {
-	return NewAwsResource(&resource.Resource[EC2InstancesAPI]{
-		ResourceTypeName: "ec2",
-		// Tentative batch size to ensure AWS doesn't throttle
-		BatchSize: DefaultBatchSize,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2InstancesAPI], cfg aws.Config) {
+	return NewEC2AwsResource[EC2InstancesAPI](
+		"ec2",
+		WrapAwsInitClient(func(r *resource.Resource[EC2InstancesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2
-		},
-		Lister: listEC2Instances,
-		Nuker: resource.MultiStepDeleter(
+		func(c config.Config) config.EC2ResourceType { return c.EC2 },
+		listEC2Instances,
+		resource.MultiStepDeleter(
 			releaseInstanceEIPs,
 			terminateEC2Instance,
 			waitForEC2InstanceTerminated,
 		),
-	})
+		&EC2ResourceOptions[EC2InstancesAPI]{
+			Idle: &IdleMetrics{
+				Source:        dimensionSource("AWS/EC2", "InstanceId"),
+				DefaultMetric: "CPUUtilization",
+				Statistics: map[string]cloudwatchtypes.Statistic{
+					"NetworkIn":  cloudwatchtypes.StatisticSum,
+					"NetworkOut": cloudwatchtypes.StatisticSum,
+				},
+			},
+			Suspender: resource.SequentialActor("Stopping", stopEC2Instance),
+			Resumer:   resource.SequentialActor("Starting", startEC2Instance),
+		},
+	)
 }
 
 // listEC2Instances retrieves all EC2 instances that match the config filters.
-func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	params := &ec2.DescribeInstancesInput{
 		Filters: []types.Filter{
 			{
@@ -77,10 +91,13 @@ func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resourc
 }
 
 // filterOutProtectedInstances returns only instance IDs of unprotected EC2 instances
-func filterOutProtectedInstances(ctx context.Context, client EC2InstancesAPI, output *ec2.DescribeInstancesOutput, cfg config.ResourceType) ([]*string, error) {
+func filterOutProtectedInstances(ctx context.Context, client EC2InstancesAPI, output *ec2.DescribeInstancesOutput, cfg config.EC2ResourceType) ([]*string, error) {
 	var filteredIds []*string
 	for _, reservation := range output.Reservations {
 		for _, instance := range reservation.Instances {
+			if !cfg.InVpc(instance.VpcId) {
+				continue
+			}
 			instanceID := *instance.InstanceId
 
 			attr, err := client.DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
@@ -91,7 +108,7 @@ func filterOutProtectedInstances(ctx context.Context, client EC2InstancesAPI, ou
 				return nil, errors.WithStackTrace(err)
 			}
 
-			if shouldIncludeInstanceId(instance, *attr.DisableApiTermination.Value, cfg) {
+			if shouldIncludeInstanceId(instance, *attr.DisableApiTermination.Value, cfg.ResourceType) {
 				filteredIds = append(filteredIds, &instanceID)
 			}
 		}
@@ -109,6 +126,7 @@ func shouldIncludeInstanceId(instance types.Instance, protected bool, cfg config
 	// Ignore this error and pass empty string to config.ShouldInclude
 	instanceName := util.GetEC2ResourceNameTagValue(instance.Tags)
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   instance.InstanceId,
 		Name: instanceName,
 		Time: instance.LaunchTime,
 		Tags: util.ConvertTypesTagsToMap(instance.Tags),
@@ -164,3 +182,75 @@ func waitForEC2InstanceTerminated(ctx context.Context, client EC2InstancesAPI, i
 		InstanceIds: []string{aws.ToString(instanceID)},
 	}, DefaultWaitTimeout)
 }
+
+// describeEC2Instance returns a single EC2 instance.
+func describeEC2Instance(ctx context.Context, client EC2InstancesAPI, instanceID *string) (types.Instance, error) {
+	output, err := client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{aws.ToString(instanceID)},
+	})
+	if err != nil {
+		return types.Instance{}, errors.WithStackTrace(err)
+	}
+	for _, reservation := range output.Reservations {
+		for _, instance := range reservation.Instances {
+			return instance, nil
+		}
+	}
+	return types.Instance{}, fmt.Errorf("instance %s not found", aws.ToString(instanceID))
+}
+
+// stopEC2Instance stops a running EC2 instance and tags it so that it can be started again.
+func stopEC2Instance(ctx context.Context, client EC2InstancesAPI, instanceID *string) (string, error) {
+	instance, err := describeEC2Instance(ctx, client, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if instance.State == nil || instance.State.Name != types.InstanceStateNameRunning {
+		return "", util.SkippedResourceError{Reason: fmt.Sprintf("instance is not running (%s)", instanceStateName(instance))}
+	}
+
+	if _, err := client.StopInstances(ctx, &ec2.StopInstancesInput{
+		InstanceIds: []string{aws.ToString(instanceID)},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: []string{aws.ToString(instanceID)},
+		Tags:      []types.Tag{{Key: aws.String(suspendedTagKey), Value: aws.String(string(types.InstanceStateNameRunning))}},
+	}); err != nil {
+		return "", untaggedSuspendError("stopped", err)
+	}
+	return "stopped", nil
+}
+
+// startEC2Instance starts an EC2 instance stopped by stopEC2Instance and removes its tag.
+func startEC2Instance(ctx context.Context, client EC2InstancesAPI, instanceID *string) (string, error) {
+	instance, err := describeEC2Instance(ctx, client, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := util.ConvertTypesTagsToMap(instance.Tags)[suspendedTagKey]; !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+
+	if _, err := client.StartInstances(ctx, &ec2.StartInstancesInput{
+		InstanceIds: []string{aws.ToString(instanceID)},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.DeleteTags(ctx, &ec2.DeleteTagsInput{
+		Resources: []string{aws.ToString(instanceID)},
+		Tags:      []types.Tag{{Key: aws.String(suspendedTagKey)}},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return "started", nil
+}
+
+// instanceStateName returns the state of an instance for messages.
+func instanceStateName(instance types.Instance) string {
+	if instance.State == nil {
+		return "unknown"
+	}
+	return string(instance.State.Name)
+}
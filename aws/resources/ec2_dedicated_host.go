@@ -87,6 +87,7 @@ func shouldIncludeHostId(host *types.Host, cfg config.ResourceType) bool {
 	hostNameTagValue := util.GetEC2ResourceNameTagValue(host.Tags)
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   host.HostId,
 		Name: hostNameTagValue,
 		Time: host.AllocationTime,
 		Tags: util.ConvertTypesTagsToMap(host.Tags),
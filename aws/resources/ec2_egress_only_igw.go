@@ -47,6 +47,7 @@ func listEgressOnlyInternetGateways(ctx context.Context, client EgressOnlyIGAPI,
 
 		for _, gateway := range page.EgressOnlyInternetGateways {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   gateway.EgressOnlyInternetGatewayId,
 				Name: util.GetEC2ResourceNameTagValue(gateway.Tags),
 				Tags: util.ConvertTypesTagsToMap(gateway.Tags),
 			}) {
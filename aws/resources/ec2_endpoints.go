@@ -38,10 +38,10 @@ func NewEC2Endpoints() AwsResource {
 }
 
 // listEC2Endpoints retrieves all VPC endpoints that match the config filters.
-func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	// When defaultOnly is true, get the list of default VPC IDs to filter by
+func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
+	// When DefaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		defaultVpcIds = make(map[string]bool)
 		vpcs, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 			Filters: []types.Filter{
@@ -76,8 +76,11 @@ func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resourc
 				continue
 			}
 
-			// When defaultOnly is true, skip endpoints not in default VPCs
-			if defaultOnly && !defaultVpcIds[aws.ToString(endpoint.VpcId)] {
+			// When DefaultOnly is true, skip endpoints not in default VPCs
+			if cfg.DefaultOnly && !defaultVpcIds[aws.ToString(endpoint.VpcId)] {
+				continue
+			}
+			if !cfg.InVpc(endpoint.VpcId) {
 				continue
 			}
 
@@ -94,6 +97,7 @@ func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resourc
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   endpoint.VpcEndpointId,
 				Name: &endpointName,
 				Time: firstSeenTime,
 				Tags: tagMap,
@@ -143,14 +143,14 @@ func TestListEC2Endpoints(t *testing.T) {
 				},
 			},
 		}
-		ids, err := listEC2Endpoints(ctx, mockWithReqManaged, resource.Scope{}, config.ResourceType{}, false)
+		ids, err := listEC2Endpoints(ctx, mockWithReqManaged, resource.Scope{}, config.EC2ResourceType{})
 		require.NoError(t, err)
 		require.Equal(t, []string{endpoint1, nilReqManagedEndpoint}, aws.ToStringSlice(ids))
 	})
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ids, err := listEC2Endpoints(ctx, mock, resource.Scope{}, tc.configObj, false)
+			ids, err := listEC2Endpoints(ctx, mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
 		})
@@ -48,11 +48,11 @@ func verifyInternetGatewayPermission(ctx context.Context, client InternetGateway
 }
 
 // listInternetGateways retrieves all Internet Gateways that match the config filters.
-// When defaultOnly is true, only IGWs attached to default VPCs are returned (for defaults-aws command).
-func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	// When defaultOnly is true, get the list of default VPC IDs to filter by
+// When DefaultOnly is true, only IGWs attached to default VPCs are returned (for defaults-aws command).
+func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
+	// When DefaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		defaultVpcIds = make(map[string]bool)
 		vpcs, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 			Filters: []types.Filter{
@@ -81,8 +81,8 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 		}
 
 		for _, ig := range page.InternetGateways {
-			// When defaultOnly is true, skip IGWs not attached to default VPCs
-			if defaultOnly {
+			// When DefaultOnly is true, skip IGWs not attached to default VPCs
+			if cfg.DefaultOnly {
 				attachedToDefault := false
 				for _, att := range ig.Attachments {
 					if defaultVpcIds[aws.ToString(att.VpcId)] {
@@ -95,6 +95,10 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 				}
 			}
 
+			if !cfg.InVpc(internetGatewayVpcID(ig)) {
+				continue
+			}
+
 			tagMap := util.ConvertTypesTagsToMap(ig.Tags)
 			firstSeenTime, err := util.GetOrCreateFirstSeen(ctx, client, ig.InternetGatewayId, tagMap)
 			if err != nil {
@@ -102,7 +106,7 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 				return nil, err
 			}
 
-			if shouldIncludeInternetGateway(ig, firstSeenTime, cfg) {
+			if shouldIncludeInternetGateway(ig, firstSeenTime, cfg.ResourceType) {
 				identifiers = append(identifiers, ig.InternetGatewayId)
 			}
 		}
@@ -111,6 +115,16 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 	return identifiers, nil
 }
 
+// internetGatewayVpcID returns the VPC an internet gateway is attached to, or nil if it is detached.
+func internetGatewayVpcID(ig types.InternetGateway) *string {
+	for _, att := range ig.Attachments {
+		if att.VpcId != nil {
+			return att.VpcId
+		}
+	}
+	return nil
+}
+
 // shouldIncludeInternetGateway determines if an internet gateway should be included based on config filters.
 func shouldIncludeInternetGateway(ig types.InternetGateway, firstSeenTime *time.Time, cfg config.ResourceType) bool {
 	tagMap := util.ConvertTypesTagsToMap(ig.Tags)
@@ -120,6 +134,7 @@ func shouldIncludeInternetGateway(ig types.InternetGateway, firstSeenTime *time.
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   ig.InternetGatewayId,
 		Name: &name,
 		Tags: tagMap,
 		Time: firstSeenTime,
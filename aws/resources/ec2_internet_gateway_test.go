@@ -100,7 +100,7 @@ func TestInternetGateway_GetAll(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listInternetGateways(ctx, mockClient, resource.Scope{Region: "us-east-1"}, tc.configObj, false)
+			names, err := listInternetGateways(ctx, mockClient, resource.Scope{Region: "us-east-1"}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
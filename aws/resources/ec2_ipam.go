@@ -71,6 +71,7 @@ func listEC2IPAMs(ctx context.Context, client EC2IPAMAPI, scope resource.Scope,
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   ipam.IpamId,
 				Name: &ipamName,
 				Time: firstSeenTime,
 				Tags: tagMap,
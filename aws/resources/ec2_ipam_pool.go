@@ -70,6 +70,7 @@ func listEC2IPAMPools(ctx context.Context, client EC2IPAMPoolAPI, scope resource
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   pool.IpamPoolId,
 				Name: &poolName,
 				Time: firstSeenTime,
 				Tags: tagMap,
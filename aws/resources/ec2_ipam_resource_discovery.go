@@ -70,6 +70,7 @@ func listEC2IPAMResourceDiscoveries(ctx context.Context, client EC2IPAMResourceD
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   discovery.IpamResourceDiscoveryId,
 				Name: &discoveryName,
 				Time: firstSeenTime,
 				Tags: tagMap,
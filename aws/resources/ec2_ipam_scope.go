@@ -70,6 +70,7 @@ func listEC2IPAMScopes(ctx context.Context, client EC2IPAMScopeAPI, scope resour
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   ipamScope.IpamScopeId,
 				Name: &scopeName,
 				Time: firstSeenTime,
 				Tags: tagMap,
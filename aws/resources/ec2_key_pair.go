@@ -45,6 +45,7 @@ func listEC2KeyPairs(ctx context.Context, client EC2KeyPairsAPI, scope resource.
 	var ids []*string
 	for _, keyPair := range result.KeyPairs {
 		if cfg.ShouldInclude(config.ResourceValue{
+			ID:   keyPair.KeyPairId,
 			Name: keyPair.KeyName,
 			Time: keyPair.CreateTime,
 			Tags: util.ConvertTypesTagsToMap(keyPair.Tags),
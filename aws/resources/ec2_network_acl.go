@@ -84,6 +84,7 @@ func shouldIncludeNetworkACL(networkAcl *types.NetworkAcl, firstSeenTime *time.T
 		naclName = name
 	}
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   networkAcl.NetworkAclId,
 		Name: &naclName,
 		Tags: tagMap,
 		Time: firstSeenTime,
@@ -48,10 +48,10 @@ func NewNetworkInterface() AwsResource {
 }
 
 // listNetworkInterfaces retrieves all Network Interfaces that match the config filters.
-func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	// When defaultOnly is true, get the list of default VPC IDs to filter by
+func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
+	// When DefaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		defaultVpcIds = make(map[string]bool)
 		vpcs, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 			Filters: []types.Filter{
@@ -80,8 +80,11 @@ func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scop
 		}
 
 		for _, networkInterface := range page.NetworkInterfaces {
-			// When defaultOnly is true, skip network interfaces not in default VPCs
-			if defaultOnly && !defaultVpcIds[aws.ToString(networkInterface.VpcId)] {
+			// When DefaultOnly is true, skip network interfaces not in default VPCs
+			if cfg.DefaultOnly && !defaultVpcIds[aws.ToString(networkInterface.VpcId)] {
+				continue
+			}
+			if !cfg.InVpc(networkInterface.VpcId) {
 				continue
 			}
 
@@ -102,7 +105,8 @@ func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scop
 				continue
 			}
 
-			if shouldIncludeNetworkInterface(networkInterface, firstSeenTime, cfg) {
+			if shouldIncludeNetworkInterface(networkInterface, firstSeenTime, cfg.ResourceType) &&
+				cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(networkInterface.NetworkInterfaceId), networkInterfaceOrphanReason(networkInterface)) {
 				interfaceIds = append(interfaceIds, networkInterface.NetworkInterfaceId)
 			}
 		}
@@ -119,12 +123,21 @@ func shouldIncludeNetworkInterface(networkInterface types.NetworkInterface, firs
 		interfaceName = name
 	}
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   networkInterface.NetworkInterfaceId,
 		Name: &interfaceName,
 		Tags: tagMap,
 		Time: firstSeenTime,
 	})
 }
 
+// networkInterfaceOrphanReason returns why a network interface is orphaned, or "" if it is in use.
+func networkInterfaceOrphanReason(networkInterface types.NetworkInterface) string {
+	if networkInterface.Status != types.NetworkInterfaceStatusAvailable || networkInterface.Attachment != nil {
+		return ""
+	}
+	return "network interface is available and not attached to anything"
+}
+
 // verifyNetworkInterfacePermission performs a dry-run delete to check permissions.
 func verifyNetworkInterfacePermission(ctx context.Context, client NetworkInterfaceAPI, id *string) error {
 	_, err := client.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
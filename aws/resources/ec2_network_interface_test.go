@@ -222,7 +222,7 @@ func TestListNetworkInterfaces(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
-			ids, err := listNetworkInterfaces(ctx, tc.mock, resource.Scope{}, tc.cfg, false)
+			ids, err := listNetworkInterfaces(ctx, tc.mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.cfg})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
 		})
@@ -50,11 +50,11 @@ func verifyRouteTableNukePermission(ctx context.Context, client RouteTableAPI, i
 
 // listRouteTables retrieves all non-main route tables that match the config filters.
 // Main route tables are automatically deleted when their VPC is deleted.
-// When defaultOnly is true, only route tables in default VPCs are returned (for defaults-aws command).
-func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	// When defaultOnly is true, get the list of default VPC IDs to filter by
+// When DefaultOnly is true, only route tables in default VPCs are returned (for defaults-aws command).
+func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
+	// When DefaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		defaultVpcIds = make(map[string]bool)
 		vpcs, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 			Filters: []types.Filter{
@@ -90,8 +90,11 @@ func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.S
 				continue
 			}
 
-			// When defaultOnly is true, skip route tables not in default VPCs
-			if defaultOnly && !defaultVpcIds[aws.ToString(rt.VpcId)] {
+			// When DefaultOnly is true, skip route tables not in default VPCs
+			if cfg.DefaultOnly && !defaultVpcIds[aws.ToString(rt.VpcId)] {
+				continue
+			}
+			if !cfg.InVpc(rt.VpcId) {
 				continue
 			}
 
@@ -102,7 +105,7 @@ func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.S
 				continue
 			}
 
-			if shouldIncludeRouteTable(rt, firstSeenTime, cfg) {
+			if shouldIncludeRouteTable(rt, firstSeenTime, cfg.ResourceType) {
 				identifiers = append(identifiers, rt.RouteTableId)
 			}
 		}
@@ -131,6 +134,7 @@ func shouldIncludeRouteTable(rt types.RouteTable, firstSeenTime *time.Time, cfg
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   rt.RouteTableId,
 		Name: &name,
 		Tags: tagMap,
 		Time: firstSeenTime,
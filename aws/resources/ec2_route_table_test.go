@@ -143,7 +143,7 @@ func TestListRouteTables(t *testing.T) {
 			}
 
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
-			result, err := listRouteTables(ctx, mock, resource.Scope{}, tc.config, false)
+			result, err := listRouteTables(ctx, mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.config})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(result))
 		})
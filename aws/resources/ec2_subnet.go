@@ -37,12 +37,12 @@ func NewEC2Subnet() AwsResource {
 }
 
 // listEC2Subnets retrieves all EC2 Subnets that match the config filters.
-func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	var subnetIds []*string
 
 	// Configure filters for default subnets if requested
 	var filters []types.Filter
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		logging.Debugf("[default only] Retrieving the default subnets")
 		filters = append(filters, types.Filter{
 			Name:   aws.String("default-for-az"),
@@ -62,9 +62,12 @@ func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Sco
 
 		for _, subnet := range page.Subnets {
 			// Skip default subnets when not explicitly targeting them.
-			// The defaults-aws command sets defaultOnly=true to target these;
+			// The defaults-aws command sets DefaultOnly=true to target these;
 			// the regular aws command should leave them alone.
-			if !defaultOnly && aws.ToBool(subnet.DefaultForAz) {
+			if !cfg.DefaultOnly && aws.ToBool(subnet.DefaultForAz) {
+				continue
+			}
+			if !cfg.InVpc(subnet.VpcId) {
 				continue
 			}
 
@@ -76,7 +79,7 @@ func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Sco
 				return nil, errors.WithStackTrace(err)
 			}
 
-			if shouldIncludeEC2Subnet(subnet, firstSeenTime, cfg) {
+			if shouldIncludeEC2Subnet(subnet, firstSeenTime, cfg.ResourceType) {
 				subnetIds = append(subnetIds, subnet.SubnetId)
 			}
 		}
@@ -89,6 +92,7 @@ func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Sco
 func shouldIncludeEC2Subnet(subnet types.Subnet, firstSeenTime *time.Time, cfg config.ResourceType) bool {
 	tagMap := util.ConvertTypesTagsToMap(subnet.Tags)
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   subnet.SubnetId,
 		Name: util.GetEC2ResourceNameTagValue(subnet.Tags),
 		Time: firstSeenTime,
 		Tags: tagMap,
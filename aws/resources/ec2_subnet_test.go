@@ -60,7 +60,7 @@ func TestListEC2Subnets(t *testing.T) {
 		},
 	}
 
-	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, config.ResourceType{}, false)
+	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, config.EC2ResourceType{})
 	require.NoError(t, err)
 	require.ElementsMatch(t, []string{"subnet-001", "subnet-002"}, aws.ToStringSlice(ids))
 }
@@ -93,7 +93,7 @@ func TestListEC2Subnets_WithFilter(t *testing.T) {
 		},
 	}
 
-	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, cfg, false)
+	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: cfg})
 	require.NoError(t, err)
 	require.Equal(t, []string{"subnet-001"}, aws.ToStringSlice(ids))
 }
@@ -112,7 +112,7 @@ func TestListEC2Subnets_SkipsDefaultSubnets(t *testing.T) {
 	}
 
 	// defaultOnly=false: default subnets are skipped, non-default and nil are kept
-	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, config.ResourceType{}, false)
+	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, config.EC2ResourceType{})
 	require.NoError(t, err)
 	require.Equal(t, []string{"subnet-custom", "subnet-nil"}, aws.ToStringSlice(ids))
 }
@@ -145,7 +145,7 @@ func TestListEC2Subnets_StampsFirstSeenTag(t *testing.T) {
 		ExcludeRule: config.FilterRule{TimeAfter: &excludeAfter},
 	}
 
-	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, cfg, false)
+	ids, err := listEC2Subnets(subnetTestContext(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: cfg})
 	require.NoError(t, err)
 
 	// The subnet first seen 24h ago passes the 12h filter; the untagged one is
@@ -169,7 +169,7 @@ func TestListEC2Subnets_ExcludeFirstSeenTag(t *testing.T) {
 
 	// With --exclude-first-seen, listing must not write any tags.
 	ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, true)
-	ids, err := listEC2Subnets(ctx, mock, resource.Scope{}, config.ResourceType{}, false)
+	ids, err := listEC2Subnets(ctx, mock, resource.Scope{}, config.EC2ResourceType{})
 	require.NoError(t, err)
 	require.Equal(t, []string{"subnet-untagged"}, aws.ToStringSlice(ids))
 	require.Empty(t, mock.CreateTagsCalls)
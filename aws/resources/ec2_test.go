@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,6 +47,22 @@ func (m mockedEC2Instances) ReleaseAddress(ctx context.Context, params *ec2.Rele
 	return &m.ReleaseAddressOutput, nil
 }
 
+func (m mockedEC2Instances) StopInstances(_ context.Context, _ *ec2.StopInstancesInput, _ ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	return &ec2.StopInstancesOutput{}, nil
+}
+
+func (m mockedEC2Instances) StartInstances(_ context.Context, _ *ec2.StartInstancesInput, _ ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	return &ec2.StartInstancesOutput{}, nil
+}
+
+func (m mockedEC2Instances) CreateTags(_ context.Context, _ *ec2.CreateTagsInput, _ ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (m mockedEC2Instances) DeleteTags(_ context.Context, _ *ec2.DeleteTagsInput, _ ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return &ec2.DeleteTagsOutput{}, nil
+}
+
 func TestEC2Instances_ResourceName(t *testing.T) {
 	r := NewEC2Instances()
 	require.Equal(t, "ec2", r.ResourceName())
@@ -134,7 +151,7 @@ func TestListEC2Instances(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listEC2Instances(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listEC2Instances(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -183,3 +200,36 @@ func TestTerminateEC2Instance(t *testing.T) {
 	err := terminateEC2Instance(context.Background(), mock, aws.String("testId1"))
 	require.NoError(t, err)
 }
+
+func TestEC2Instances_SuspendResume(t *testing.T) {
+	t.Parallel()
+
+	instance := func(state types.InstanceStateName, tags ...types.Tag) mockedEC2Instances {
+		return mockedEC2Instances{
+			DescribeInstancesOutput: ec2.DescribeInstancesOutput{
+				Reservations: []types.Reservation{{
+					Instances: []types.Instance{{
+						InstanceId: aws.String("i-1"),
+						State:      &types.InstanceState{Name: state},
+						Tags:       tags,
+					}},
+				}},
+			},
+		}
+	}
+
+	detail, err := stopEC2Instance(context.Background(), instance(types.InstanceStateNameRunning), aws.String("i-1"))
+	require.NoError(t, err)
+	require.Equal(t, "stopped", detail)
+
+	_, err = stopEC2Instance(context.Background(), instance(types.InstanceStateNameStopped), aws.String("i-1"))
+	require.Equal(t, util.SkippedResourceError{Reason: "instance is not running (stopped)"}, err)
+
+	_, err = startEC2Instance(context.Background(), instance(types.InstanceStateNameStopped), aws.String("i-1"))
+	require.Equal(t, util.SkippedResourceError{Reason: notSuspendedReason}, err)
+
+	suspended := types.Tag{Key: aws.String(suspendedTagKey), Value: aws.String("running")}
+	detail, err = startEC2Instance(context.Background(), instance(types.InstanceStateNameStopped, suspended), aws.String("i-1"))
+	require.NoError(t, err)
+	require.Equal(t, "started", detail)
+}
@@ -58,13 +58,13 @@ func NewEC2VPC() AwsResource {
 	)
 }
 
-func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	var ids []*string
 	paginator := ec2.NewDescribeVpcsPaginator(client, &ec2.DescribeVpcsInput{
 		Filters: []types.Filter{
 			{
 				Name:   aws.String("is-default"),
-				Values: []string{strconv.FormatBool(defaultOnly)},
+				Values: []string{strconv.FormatBool(cfg.DefaultOnly)},
 			},
 		},
 	})
@@ -76,6 +76,10 @@ func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg c
 		}
 
 		for _, vpc := range page.Vpcs {
+			if !cfg.InVpc(vpc.VpcId) {
+				continue
+			}
+
 			firstSeenTime, err := util.GetOrCreateFirstSeen(ctx, client, vpc.VpcId, util.ConvertTypesTagsToMap(vpc.Tags))
 			if err != nil {
 				logging.Errorf("Unable to retrieve first seen tag for VPC %s: %v", aws.ToString(vpc.VpcId), err)
@@ -83,6 +87,7 @@ func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg c
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   vpc.VpcId,
 				Time: firstSeenTime,
 				Name: util.GetEC2ResourceNameTagValue(vpc.Tags),
 				Tags: util.ConvertTypesTagsToMap(vpc.Tags),
@@ -100,6 +100,7 @@ func shouldIncludeVPCPeering(pcx types.VpcPeeringConnection, firstSeenTime *time
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   pcx.VpcPeeringConnectionId,
 		Name: &name,
 		Tags: tagMap,
 		Time: firstSeenTime,
@@ -212,7 +212,7 @@ func TestListVPCs(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ids, err := listVPCs(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.cfg, false)
+			ids, err := listVPCs(ctx, mock, resource.Scope{Region: "us-east-1"}, config.EC2ResourceType{ResourceType: tc.cfg})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
 		})
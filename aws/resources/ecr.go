@@ -16,6 +16,7 @@ type ECRAPI interface {
 	DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error)
 	DeleteRepository(ctx context.Context, params *ecr.DeleteRepositoryInput, optFns ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error)
 	ListTagsForResource(ctx context.Context, params *ecr.ListTagsForResourceInput, optFns ...func(*ecr.Options)) (*ecr.ListTagsForResourceOutput, error)
+	ListImages(ctx context.Context, params *ecr.ListImagesInput, optFns ...func(*ecr.Options)) (*ecr.ListImagesOutput, error)
 }
 
 // NewECR creates a new ECR resource using the generic resource pattern.
@@ -55,19 +56,45 @@ func listECRRepositories(ctx context.Context, client ECRAPI, scope resource.Scop
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			if !cfg.ShouldInclude(config.ResourceValue{
 				Time: repository.CreatedAt,
 				Name: repository.RepositoryName,
 				Tags: util.ConvertECRTagsToMap(tagsOutput.Tags),
 			}) {
-				repositoryNames = append(repositoryNames, repository.RepositoryName)
+				continue
+			}
+
+			if cfg.OrphansOnly() {
+				reason, err := ecrRepositoryOrphanReason(ctx, client, repository.RepositoryName)
+				if err != nil {
+					return nil, err
+				}
+				if !cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(repository.RepositoryName), reason) {
+					continue
+				}
 			}
+			repositoryNames = append(repositoryNames, repository.RepositoryName)
 		}
 	}
 
 	return repositoryNames, nil
 }
 
+// ecrRepositoryOrphanReason returns why a repository is orphaned, or "" if it holds images.
+func ecrRepositoryOrphanReason(ctx context.Context, client ECRAPI, repositoryName *string) (string, error) {
+	output, err := client.ListImages(ctx, &ecr.ListImagesInput{
+		RepositoryName: repositoryName,
+		MaxResults:     aws.Int32(1),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(output.ImageIds) > 0 {
+		return "", nil
+	}
+	return "repository has no images", nil
+}
+
 // deleteECRRepository deletes a single ECR repository.
 func deleteECRRepository(ctx context.Context, client ECRAPI, repositoryName *string) error {
 	_, err := client.DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
@@ -0,0 +1,316 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// maxBatchDeleteImages is the most images BatchDeleteImage accepts per call.
+const maxBatchDeleteImages = 100
+
+// ECRImagesAPI defines the interface for ECR image operations, and the ECS and Lambda operations used
+// to find the images in use.
+type ECRImagesAPI interface {
+	DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error)
+	DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error)
+	BatchDeleteImage(ctx context.Context, params *ecr.BatchDeleteImageInput, optFns ...func(*ecr.Options)) (*ecr.BatchDeleteImageOutput, error)
+	ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error)
+	ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error)
+	DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error)
+	ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error)
+	GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error)
+}
+
+// NewECRImages creates a new ECR image resource using the generic resource pattern.
+func NewECRImages() AwsResource {
+	var imageCfg config.ECRImageResourceType
+	return NewAwsResource(&resource.Resource[ECRImagesAPI]{
+		ResourceTypeName: "ecr-image",
+		BatchSize:        maxBatchDeleteImages,
+		InitClient: WrapAwsInitClient(func(r *resource.Resource[ECRImagesAPI], cfg aws.Config) {
+			r.Scope.Region = cfg.Region
+			r.Client = &ecrImagesClient{
+				ecrClient:    ecr.NewFromConfig(cfg),
+				ecsClient:    ecs.NewFromConfig(cfg),
+				lambdaClient: lambda.NewFromConfig(cfg),
+			}
+		}),
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			imageCfg = c.ECRImage
+			return c.ECRImage.ResourceType
+		},
+		Lister: func(ctx context.Context, client ECRImagesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			imageCfg.ResourceType = cfg
+			return listECRImages(ctx, client, scope, imageCfg)
+		},
+		Nuker: resource.BulkResultDeleter(deleteECRImages),
+	})
+}
+
+// listECRImages retrieves the images of all repositories that match the config filters, as
+// "repository@digest" identifiers.
+func listECRImages(ctx context.Context, client ECRImagesAPI, scope resource.Scope, cfg config.ECRImageResourceType) ([]*string, error) {
+	var inUse map[string]string
+	if cfg.SkipInUse {
+		var err error
+		if inUse, err = ecrImagesInUse(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	var identifiers []*string
+	repositories := ecr.NewDescribeRepositoriesPaginator(client, &ecr.DescribeRepositoriesInput{})
+	for repositories.HasMorePages() {
+		page, err := repositories.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, repository := range page.Repositories {
+			ids, err := listRepositoryImages(ctx, client, scope, cfg, repository.RepositoryName, inUse)
+			if err != nil {
+				return nil, err
+			}
+			identifiers = append(identifiers, ids...)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// listRepositoryImages retrieves the images of a single repository that match the config filters,
+// except those the retain rule keeps and, if requested, those in use.
+func listRepositoryImages(ctx context.Context, client ECRImagesAPI, scope resource.Scope, cfg config.ECRImageResourceType, repositoryName *string, inUse map[string]string) ([]*string, error) {
+	images := map[string]ecrtypes.ImageDetail{}
+	var values []config.ResourceValue
+
+	paginator := ecr.NewDescribeImagesPaginator(client, &ecr.DescribeImagesInput{RepositoryName: repositoryName})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, image := range page.ImageDetails {
+			id := fmt.Sprintf("%s@%s", aws.ToString(repositoryName), aws.ToString(image.ImageDigest))
+			images[id] = image
+			values = append(values, config.ResourceValue{
+				ID:   aws.String(id),
+				Name: retentionImageTag(cfg.Retain, image.ImageTags),
+				Time: image.ImagePushedAt,
+			})
+		}
+	}
+
+	var identifiers []*string
+	for _, value := range cfg.Retain.Surplus(cfg.ResourceType, values) {
+		image := images[aws.ToString(value.ID)]
+		if !cfg.ShouldIncludeImage(image.ImageTags, image.ImagePushedAt) {
+			continue
+		}
+		if user, ok := inUse[aws.ToString(image.ImageDigest)]; ok {
+			logging.Debugf("[Skip] ECR image %s is used by %s", aws.ToString(value.ID), user)
+			continue
+		}
+		if cfg.ShouldInclude(config.ResourceValue{
+			ID:   value.ID,
+			Name: repositoryName,
+			Time: image.ImagePushedAt,
+		}) {
+			identifiers = append(identifiers, value.ID)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// retentionImageTag returns the image tag that groups an image for the retain rule: the first tag
+// matching its name_regex, or the first tag when it has none.
+func retentionImageTag(retain *config.RetainRule, imageTags []string) *string {
+	for _, tag := range imageTags {
+		if retain == nil || retain.NameRegex == nil || retain.NameRegex.RE.MatchString(tag) {
+			return aws.String(tag)
+		}
+	}
+	return nil
+}
+
+// ecrImagesInUse maps the digests of the images that running ECS tasks or Lambda functions use to
+// what uses them.
+func ecrImagesInUse(ctx context.Context, client ECRImagesAPI) (map[string]string, error) {
+	inUse := make(map[string]string)
+
+	clusters := ecs.NewListClustersPaginator(client, &ecs.ListClustersInput{})
+	for clusters.HasMorePages() {
+		page, err := clusters.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, cluster := range page.ClusterArns {
+			if err := addECSTaskImages(ctx, client, cluster, inUse); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	functions := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
+	for functions.HasMorePages() {
+		page, err := functions.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, function := range page.Functions {
+			if function.PackageType != lambdatypes.PackageTypeImage {
+				continue
+			}
+			output, err := client.GetFunction(ctx, &lambda.GetFunctionInput{FunctionName: function.FunctionName})
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+			if output.Code == nil {
+				continue
+			}
+			if _, digest, ok := strings.Cut(aws.ToString(output.Code.ResolvedImageUri), "@"); ok {
+				inUse[digest] = "Lambda function " + aws.ToString(function.FunctionName)
+			}
+		}
+	}
+
+	return inUse, nil
+}
+
+// addECSTaskImages adds the digests of the images the running tasks of an ECS cluster use.
+func addECSTaskImages(ctx context.Context, client ECRImagesAPI, cluster string, inUse map[string]string) error {
+	tasks := ecs.NewListTasksPaginator(client, &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: ecstypes.DesiredStatusRunning,
+	})
+	for tasks.HasMorePages() {
+		page, err := tasks.NextPage(ctx)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		// DescribeTasks accepts at most 100 tasks per call
+		for _, batch := range util.Split(page.TaskArns, 100) {
+			output, err := client.DescribeTasks(ctx, &ecs.DescribeTasksInput{Cluster: aws.String(cluster), Tasks: batch})
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+			for _, task := range output.Tasks {
+				for _, container := range task.Containers {
+					if container.ImageDigest != nil {
+						inUse[aws.ToString(container.ImageDigest)] = "ECS task " + aws.ToString(task.TaskArn)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// deleteECRImages deletes images through BatchDeleteImage, one call per repository and chunk of 100
+// images, and reports each image's failure.
+func deleteECRImages(ctx context.Context, client ECRImagesAPI, ids []string) []resource.NukeResult {
+	byRepository := map[string][]string{}
+	var repositories []string
+	for _, id := range ids {
+		repository, _, _ := strings.Cut(id, "@")
+		if _, ok := byRepository[repository]; !ok {
+			repositories = append(repositories, repository)
+		}
+		byRepository[repository] = append(byRepository[repository], id)
+	}
+
+	results := make([]resource.NukeResult, 0, len(ids))
+	for _, repository := range repositories {
+		for _, batch := range util.Split(byRepository[repository], maxBatchDeleteImages) {
+			results = append(results, deleteECRImageBatch(ctx, client, repository, batch)...)
+		}
+	}
+	return results
+}
+
+// deleteECRImageBatch deletes up to 100 images of a single repository.
+func deleteECRImageBatch(ctx context.Context, client ECRImagesAPI, repository string, ids []string) []resource.NukeResult {
+	imageIds := make([]ecrtypes.ImageIdentifier, 0, len(ids))
+	for _, id := range ids {
+		_, digest, _ := strings.Cut(id, "@")
+		imageIds = append(imageIds, ecrtypes.ImageIdentifier{ImageDigest: aws.String(digest)})
+	}
+
+	output, err := client.BatchDeleteImage(ctx, &ecr.BatchDeleteImageInput{
+		RepositoryName: aws.String(repository),
+		ImageIds:       imageIds,
+	})
+
+	failures := map[string]error{}
+	if err == nil {
+		for _, failure := range output.Failures {
+			if failure.ImageId != nil {
+				failures[aws.ToString(failure.ImageId.ImageDigest)] = fmt.Errorf("%s: %s", failure.FailureCode, aws.ToString(failure.FailureReason))
+			}
+		}
+	}
+
+	results := make([]resource.NukeResult, len(ids))
+	for i, id := range ids {
+		results[i] = resource.NukeResult{Identifier: id, Error: err}
+		if err == nil {
+			results[i].Error = failures[aws.ToString(imageIds[i].ImageDigest)]
+		}
+	}
+	return results
+}
+
+// ecrImagesClient implements ECRImagesAPI with the clients of each service.
+type ecrImagesClient struct {
+	ecrClient    *ecr.Client
+	ecsClient    *ecs.Client
+	lambdaClient *lambda.Client
+}
+
+func (c *ecrImagesClient) DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	return c.ecrClient.DescribeRepositories(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	return c.ecrClient.DescribeImages(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) BatchDeleteImage(ctx context.Context, params *ecr.BatchDeleteImageInput, optFns ...func(*ecr.Options)) (*ecr.BatchDeleteImageOutput, error) {
+	return c.ecrClient.BatchDeleteImage(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	return c.ecsClient.ListClusters(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error) {
+	return c.ecsClient.ListTasks(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error) {
+	return c.ecsClient.DescribeTasks(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
+	return c.lambdaClient.ListFunctions(ctx, params, optFns...)
+}
+
+func (c *ecrImagesClient) GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error) {
+	return c.lambdaClient.GetFunction(ctx, params, optFns...)
+}
@@ -0,0 +1,180 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/require"
+)
+
+type mockECRImagesClient struct {
+	ECRImagesAPI
+	Repositories       []ecrtypes.Repository
+	Images             map[string][]ecrtypes.ImageDetail
+	Tasks              []ecstypes.Task
+	Functions          []lambdatypes.FunctionConfiguration
+	ResolvedImageUris  map[string]string
+	BatchDeleteInputs  []*ecr.BatchDeleteImageInput
+	BatchDeleteFailure string
+}
+
+func (m *mockECRImagesClient) DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	return &ecr.DescribeRepositoriesOutput{Repositories: m.Repositories}, nil
+}
+
+func (m *mockECRImagesClient) DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	return &ecr.DescribeImagesOutput{ImageDetails: m.Images[aws.ToString(params.RepositoryName)]}, nil
+}
+
+func (m *mockECRImagesClient) BatchDeleteImage(ctx context.Context, params *ecr.BatchDeleteImageInput, optFns ...func(*ecr.Options)) (*ecr.BatchDeleteImageOutput, error) {
+	m.BatchDeleteInputs = append(m.BatchDeleteInputs, params)
+	output := &ecr.BatchDeleteImageOutput{}
+	for _, id := range params.ImageIds {
+		if aws.ToString(id.ImageDigest) == m.BatchDeleteFailure {
+			output.Failures = append(output.Failures, ecrtypes.ImageFailure{
+				ImageId:       &ecrtypes.ImageIdentifier{ImageDigest: id.ImageDigest},
+				FailureCode:   ecrtypes.ImageFailureCodeImageReferencedByManifestList,
+				FailureReason: aws.String("referenced by a manifest list"),
+			})
+		}
+	}
+	return output, nil
+}
+
+func (m *mockECRImagesClient) ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error) {
+	return &ecs.ListClustersOutput{ClusterArns: []string{"cluster-1"}}, nil
+}
+
+func (m *mockECRImagesClient) ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error) {
+	var arns []string
+	for _, task := range m.Tasks {
+		arns = append(arns, aws.ToString(task.TaskArn))
+	}
+	return &ecs.ListTasksOutput{TaskArns: arns}, nil
+}
+
+func (m *mockECRImagesClient) DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error) {
+	return &ecs.DescribeTasksOutput{Tasks: m.Tasks}, nil
+}
+
+func (m *mockECRImagesClient) ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
+	return &lambda.ListFunctionsOutput{Functions: m.Functions}, nil
+}
+
+func (m *mockECRImagesClient) GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error) {
+	return &lambda.GetFunctionOutput{Code: &lambdatypes.FunctionCodeLocation{
+		ResolvedImageUri: aws.String(m.ResolvedImageUris[aws.ToString(params.FunctionName)]),
+	}}, nil
+}
+
+func TestListECRImages(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	image := func(digest string, age time.Duration, tags ...string) ecrtypes.ImageDetail {
+		return ecrtypes.ImageDetail{ImageDigest: aws.String(digest), ImagePushedAt: aws.Time(now.Add(-age)), ImageTags: tags}
+	}
+	mock := &mockECRImagesClient{
+		Repositories: []ecrtypes.Repository{{RepositoryName: aws.String("app")}, {RepositoryName: aws.String("base")}},
+		Images: map[string][]ecrtypes.ImageDetail{
+			"app": {
+				image("sha256:a1", 1*time.Hour, "v1.2"),
+				image("sha256:a2", 48*time.Hour, "v1.1"),
+				image("sha256:a3", 72*time.Hour, "v1.0"),
+				image("sha256:a4", 96*time.Hour),
+				image("sha256:a5", 96*time.Hour, "pr-12"),
+			},
+			"base": {image("sha256:b1", 96*time.Hour)},
+		},
+		Tasks: []ecstypes.Task{{
+			TaskArn:    aws.String("task-1"),
+			Containers: []ecstypes.Container{{ImageDigest: aws.String("sha256:a2")}},
+		}},
+		Functions: []lambdatypes.FunctionConfiguration{
+			{FunctionName: aws.String("fn"), PackageType: lambdatypes.PackageTypeImage},
+			{FunctionName: aws.String("zip"), PackageType: lambdatypes.PackageTypeZip},
+		},
+		ResolvedImageUris: map[string]string{"fn": "123456789012.dkr.ecr.us-east-1.amazonaws.com/base@sha256:b1"},
+	}
+
+	tests := map[string]struct {
+		cfg      config.ECRImageResourceType
+		expected []string
+	}{
+		"emptyFilter": {
+			expected: []string{"app@sha256:a1", "app@sha256:a2", "app@sha256:a3", "app@sha256:a4", "app@sha256:a5", "base@sha256:b1"},
+		},
+		"repositoryNameFilter": {
+			cfg: config.ECRImageResourceType{ResourceType: config.ResourceType{
+				ExcludeRule: config.FilterRule{NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^app$")}}},
+			}},
+			expected: []string{"base@sha256:b1"},
+		},
+		"untaggedOrTagRegex": {
+			cfg: config.ECRImageResourceType{
+				Untagged:       true,
+				ImageTagsRegex: []config.Expression{{RE: *regexp.MustCompile(`^pr-\d+$`)}},
+			},
+			expected: []string{"app@sha256:a4", "app@sha256:a5", "base@sha256:b1"},
+		},
+		"pushedBefore": {
+			cfg:      config.ECRImageResourceType{PushedBefore: 24 * time.Hour},
+			expected: []string{"app@sha256:a2", "app@sha256:a3", "app@sha256:a4", "app@sha256:a5", "base@sha256:b1"},
+		},
+		"retainPerTagPattern": {
+			cfg: config.ECRImageResourceType{
+				Retain: &config.RetainRule{Keep: 1, NameRegex: &config.Expression{RE: *regexp.MustCompile(`^(v\d+)\.`)}},
+			},
+			expected: []string{"app@sha256:a2", "app@sha256:a3"},
+		},
+		"skipInUse": {
+			cfg:      config.ECRImageResourceType{SkipInUse: true},
+			expected: []string{"app@sha256:a1", "app@sha256:a3", "app@sha256:a4", "app@sha256:a5"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ids, err := listECRImages(context.Background(), mock, resource.Scope{Region: "us-east-1"}, tc.cfg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+		})
+	}
+}
+
+func TestDeleteECRImages(t *testing.T) {
+	t.Parallel()
+
+	var ids []string
+	for i := 0; i < maxBatchDeleteImages+1; i++ {
+		ids = append(ids, fmt.Sprintf("app@sha256:%d", i))
+	}
+	ids = append(ids, "base@sha256:b1")
+	mock := &mockECRImagesClient{BatchDeleteFailure: "sha256:7"}
+
+	results := deleteECRImages(context.Background(), mock, ids)
+	require.Len(t, results, len(ids))
+	require.Len(t, mock.BatchDeleteInputs, 3)
+	require.Len(t, mock.BatchDeleteInputs[0].ImageIds, maxBatchDeleteImages)
+	require.Equal(t, "base", aws.ToString(mock.BatchDeleteInputs[2].RepositoryName))
+
+	for _, result := range results {
+		if result.Identifier == "app@sha256:7" {
+			require.ErrorContains(t, result.Error, "referenced by a manifest list")
+		} else {
+			require.NoError(t, result.Error)
+		}
+	}
+}
@@ -17,6 +17,12 @@ import (
 type mockECRClient struct {
 	DescribeRepositoriesOutput ecr.DescribeRepositoriesOutput
 	DeleteRepositoryOutput     ecr.DeleteRepositoryOutput
+	ListImagesOutputs          map[string]ecr.ListImagesOutput
+}
+
+func (m *mockECRClient) ListImages(ctx context.Context, params *ecr.ListImagesInput, optFns ...func(*ecr.Options)) (*ecr.ListImagesOutput, error) {
+	output := m.ListImagesOutputs[aws.ToString(params.RepositoryName)]
+	return &output, nil
 }
 
 func (m *mockECRClient) DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
@@ -164,6 +170,32 @@ func TestListECRRepositories_TagInclusionFilter(t *testing.T) {
 	require.Equal(t, []string{testName2}, aws.ToStringSlice(names))
 }
 
+func TestListECRRepositories_OrphansOnly(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockECRClient{
+		DescribeRepositoriesOutput: ecr.DescribeRepositoriesOutput{
+			Repositories: []types.Repository{
+				{RepositoryName: aws.String("empty-repo")},
+				{RepositoryName: aws.String("used-repo")},
+			},
+		},
+		ListImagesOutputs: map[string]ecr.ListImagesOutput{
+			"used-repo": {ImageIds: []types.ImageIdentifier{{ImageTag: aws.String("latest")}}},
+		},
+	}
+
+	findings := config.NewFindings()
+	configObj := config.Config{}
+	configObj.RecordFindings(findings)
+	configObj.OnlyOrphans()
+
+	names, err := listECRRepositories(context.Background(), mock, resource.Scope{Region: "us-east-1"}, configObj.ECRRepository)
+	require.NoError(t, err)
+	require.Equal(t, []string{"empty-repo"}, aws.ToStringSlice(names))
+	require.Equal(t, "repository has no images", findings.Detail("us-east-1", "empty-repo"))
+}
+
 func TestDeleteECRRepository(t *testing.T) {
 	t.Parallel()
 
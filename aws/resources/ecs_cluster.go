@@ -89,6 +89,7 @@ func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.
 			}
 
 			if !cfg.ShouldInclude(config.ResourceValue{
+				ID:   cluster.ClusterArn,
 				Name: cluster.ClusterName,
 				Tags: tags,
 			}) {
@@ -113,6 +114,7 @@ func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   cluster.ClusterArn,
 				Time: firstSeenTime,
 				Name: cluster.ClusterName,
 				Tags: tags,
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
@@ -20,6 +21,8 @@ type ECSServicesAPI interface {
 	DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error)
 	DeleteService(ctx context.Context, params *ecs.DeleteServiceInput, optFns ...func(*ecs.Options)) (*ecs.DeleteServiceOutput, error)
 	UpdateService(ctx context.Context, params *ecs.UpdateServiceInput, optFns ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error)
+	TagResource(ctx context.Context, params *ecs.TagResourceInput, optFns ...func(*ecs.Options)) (*ecs.TagResourceOutput, error)
+	UntagResource(ctx context.Context, params *ecs.UntagResourceInput, optFns ...func(*ecs.Options)) (*ecs.UntagResourceOutput, error)
 }
 
 // ecsServicesResource holds state needed for ECS service operations.
@@ -57,6 +60,14 @@ func NewECSServices() AwsResource {
 		return deleteECSServices(ctx, client, scope, resourceType, ids, r.serviceClusterMap)
 	}
 
+	r.Suspender = resource.SequentialActor("Scaling to zero", func(ctx context.Context, client ECSServicesAPI, id *string) (string, error) {
+		return suspendECSService(ctx, client, r.serviceClusterMap[aws.ToString(id)], id)
+	})
+
+	r.Resumer = resource.SequentialActor("Restoring", func(ctx context.Context, client ECSServicesAPI, id *string) (string, error) {
+		return resumeECSService(ctx, client, r.serviceClusterMap[aws.ToString(id)], id)
+	})
+
 	return &AwsResourceAdapter[ECSServicesAPI]{Resource: r.Resource}
 }
 
@@ -124,6 +135,7 @@ func filterECSServices(ctx context.Context, client ECSServicesAPI, clusterArn *s
 
 		for _, svc := range output.Services {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   svc.ServiceArn,
 				Name: svc.ServiceName,
 				Time: svc.CreatedAt,
 				Tags: convertECSTagsToMap(svc.Tags),
@@ -232,3 +244,78 @@ type errClusterNotFound struct {
 func (e errClusterNotFound) Error() string {
 	return "cluster not found for service: " + e.serviceArn
 }
+
+// describeECSService returns a single ECS service with its tags.
+func describeECSService(ctx context.Context, client ECSServicesAPI, clusterArn string, serviceArn *string) (types.Service, error) {
+	output, err := client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(clusterArn),
+		Services: []string{aws.ToString(serviceArn)},
+		Include:  []types.ServiceField{types.ServiceFieldTags},
+	})
+	if err != nil {
+		return types.Service{}, errors.WithStackTrace(err)
+	}
+	if len(output.Services) == 0 {
+		return types.Service{}, fmt.Errorf("service %s not found", aws.ToString(serviceArn))
+	}
+	return output.Services[0], nil
+}
+
+// suspendECSService scales an ECS service to zero tasks and records its desired count in a tag so
+// that it can be restored.
+func suspendECSService(ctx context.Context, client ECSServicesAPI, clusterArn string, serviceArn *string) (string, error) {
+	service, err := describeECSService(ctx, client, clusterArn, serviceArn)
+	if err != nil {
+		return "", err
+	}
+	if service.DesiredCount == 0 {
+		return "", util.SkippedResourceError{Reason: "desired count is already 0"}
+	}
+
+	state := formatSuspendedState(map[string]int32{"desired": service.DesiredCount})
+	if _, err := client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterArn),
+		Service:      serviceArn,
+		DesiredCount: aws.Int32(0),
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.TagResource(ctx, &ecs.TagResourceInput{
+		ResourceArn: serviceArn,
+		Tags:        []types.Tag{{Key: aws.String(suspendedTagKey), Value: aws.String(state)}},
+	}); err != nil {
+		return "", untaggedSuspendError("scaled to zero from "+state, err)
+	}
+	return state, nil
+}
+
+// resumeECSService restores the desired count recorded by suspendECSService and removes its tag.
+func resumeECSService(ctx context.Context, client ECSServicesAPI, clusterArn string, serviceArn *string) (string, error) {
+	service, err := describeECSService(ctx, client, clusterArn, serviceArn)
+	if err != nil {
+		return "", err
+	}
+	value, ok := convertECSTagsToMap(service.Tags)[suspendedTagKey]
+	if !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+	state, err := parseSuspendedState(value)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      aws.String(clusterArn),
+		Service:      serviceArn,
+		DesiredCount: aws.Int32(state["desired"]),
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.UntagResource(ctx, &ecs.UntagResourceInput{
+		ResourceArn: serviceArn,
+		TagKeys:     []string{suspendedTagKey},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return value, nil
+}
@@ -60,6 +60,7 @@ func listElasticFileSystems(ctx context.Context, client ElasticFileSystemAPI, sc
 
 		for _, system := range page.FileSystems {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   system.FileSystemId,
 				Name: system.Name,
 				Time: system.CreationTime,
 				Tags: util.ConvertEFSTagsToMap(system.Tags),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/resource"
@@ -54,10 +55,11 @@ func listEIPAddresses(ctx context.Context, client EIPAddressesAPI, scope resourc
 		// If Name is unset, GetEC2ResourceNameTagValue returns nil
 		allocationName := util.GetEC2ResourceNameTagValue(address.Tags)
 		if cfg.ShouldInclude(config.ResourceValue{
+			ID:   address.AllocationId,
 			Time: firstSeenTime,
 			Name: allocationName,
 			Tags: util.ConvertTypesTagsToMap(address.Tags),
-		}) {
+		}) && cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(address.AllocationId), eipAddressOrphanReason(address)) {
 			allocationIds = append(allocationIds, address.AllocationId)
 		}
 	}
@@ -65,6 +67,14 @@ func listEIPAddresses(ctx context.Context, client EIPAddressesAPI, scope resourc
 	return allocationIds, nil
 }
 
+// eipAddressOrphanReason returns why an Elastic IP is orphaned, or "" if it is associated.
+func eipAddressOrphanReason(address types.Address) string {
+	if address.AssociationId != nil || address.NetworkInterfaceId != nil || address.InstanceId != nil {
+		return ""
+	}
+	return "address is not associated with any instance or network interface"
+}
+
 // releaseEIPAddress releases a single Elastic IP address.
 func releaseEIPAddress(ctx context.Context, client EIPAddressesAPI, allocationId *string) error {
 	_, err := client.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
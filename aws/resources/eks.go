@@ -37,26 +37,24 @@ type EKSClustersAPI interface {
 
 // NewEKSClusters creates a new EKS Clusters resource using the generic resource pattern.
 func NewEKSClusters() AwsResource {
-	return NewAwsResource(&resource.Resource[EKSClustersAPI]{
-		ResourceTypeName: "eks-cluster",
-		// Tentative batch size to ensure AWS doesn't throttle. Note that deleting EKS clusters involves deleting many
-		// associated sub resources in tight loops, and they happen in parallel in go routines. We conservatively pick 10
-		// here, both to limit overloading the runtime and to avoid AWS throttling with many API calls.
-		BatchSize: 10,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[EKSClustersAPI], cfg aws.Config) {
+	return NewEC2AwsResource[EKSClustersAPI](
+		"eks-cluster",
+		WrapAwsInitClient(func(r *resource.Resource[EKSClustersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = eks.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EKSCluster
-		},
-		Lister: listEKSClusters,
-		Nuker:  deleteEKSClusters,
-	})
+		func(c config.Config) config.EC2ResourceType { return c.EKSCluster },
+		listEKSClusters,
+		deleteEKSClusters,
+		// Tentative batch size to ensure AWS doesn't throttle. Note that deleting EKS clusters involves deleting many
+		// associated sub resources in tight loops, and they happen in parallel in go routines. We conservatively pick 10
+		// here, both to limit overloading the runtime and to avoid AWS throttling with many API calls.
+		&EC2ResourceOptions[EKSClustersAPI]{BatchSize: 10},
+	)
 }
 
 // listEKSClusters retrieves all EKS clusters that match the config filters.
-func listEKSClusters(ctx context.Context, client EKSClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEKSClusters(ctx context.Context, client EKSClustersAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	var allClusters []*string
 
 	paginator := eks.NewListClustersPaginator(client, &eks.ListClustersInput{})
@@ -77,7 +75,7 @@ func listEKSClusters(ctx context.Context, client EKSClustersAPI, scope resource.
 }
 
 // filterEKSClusters filters EKS clusters based on the config.
-func filterEKSClusters(ctx context.Context, client EKSClustersAPI, clusterNames []*string, cfg config.ResourceType) ([]*string, error) {
+func filterEKSClusters(ctx context.Context, client EKSClustersAPI, clusterNames []*string, cfg config.EC2ResourceType) ([]*string, error) {
 	var filteredEksClusterNames []*string
 	for _, clusterName := range clusterNames {
 		describeResult, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: clusterName})
@@ -91,6 +89,14 @@ func filterEKSClusters(ctx context.Context, client EKSClustersAPI, clusterNames
 			return nil, goerrors.WithStackTrace(err)
 		}
 
+		var vpcID *string
+		if describeResult.Cluster.ResourcesVpcConfig != nil {
+			vpcID = describeResult.Cluster.ResourcesVpcConfig.VpcId
+		}
+		if !cfg.InVpc(vpcID) {
+			continue
+		}
+
 		if !cfg.ShouldInclude(config.ResourceValue{
 			Name: clusterName,
 			Time: describeResult.Cluster.CreatedAt,
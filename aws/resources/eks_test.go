@@ -156,7 +156,7 @@ func TestListEKSClusters(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listEKSClusters(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listEKSClusters(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -187,7 +187,7 @@ func TestListEKSClusters_GhostClusterSkipped(t *testing.T) {
 		},
 	}
 
-	names, err := listEKSClusters(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listEKSClusters(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{})
 	require.NoError(t, err)
 	require.Equal(t, []string{realCluster}, aws.ToStringSlice(names))
 }
@@ -22,30 +22,29 @@ type ElasticachesAPI interface {
 	DeleteCacheCluster(ctx context.Context, params *elasticache.DeleteCacheClusterInput, optFns ...func(*elasticache.Options)) (*elasticache.DeleteCacheClusterOutput, error)
 	DeleteReplicationGroup(ctx context.Context, params *elasticache.DeleteReplicationGroupInput, optFns ...func(*elasticache.Options)) (*elasticache.DeleteReplicationGroupOutput, error)
 	ListTagsForResource(ctx context.Context, params *elasticache.ListTagsForResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error)
+	DescribeCacheSubnetGroups(ctx context.Context, params *elasticache.DescribeCacheSubnetGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error)
 }
 
 // NewElasticaches creates a new Elasticaches resource using the generic resource pattern.
 func NewElasticaches() AwsResource {
-	return NewAwsResource(&resource.Resource[ElasticachesAPI]{
-		ResourceTypeName: "elasticache",
-		// Tentative batch size to ensure AWS doesn't throttle
-		BatchSize: DefaultBatchSize,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[ElasticachesAPI], cfg aws.Config) {
+	return NewEC2AwsResource[ElasticachesAPI](
+		"elasticache",
+		WrapAwsInitClient(func(r *resource.Resource[ElasticachesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticache.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElastiCache
-		},
-		Lister: listElasticaches,
+		func(c config.Config) config.EC2ResourceType { return c.ElastiCache },
+		listElasticaches,
 		// Use SequentialDeleter since each deletion involves waiters
-		Nuker: resource.SequentialDeleter(deleteElasticacheCluster),
-	})
+		resource.SequentialDeleter(deleteElasticacheCluster),
+		nil,
+	)
 }
 
 // listElasticaches retrieves all Elasticache clusters that match the config filters.
-func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	var clusterIds []*string
+	vpcs := &elasticacheVpcResolver{client: client, enabled: len(cfg.VpcIDs) > 0}
 
 	// First, get any cache clusters that are replication groups, which will be the case for all multi-node Redis clusters
 	replicationGroupsPaginator := elasticache.NewDescribeReplicationGroupsPaginator(client, &elasticache.DescribeReplicationGroupsInput{})
@@ -56,6 +55,14 @@ func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resourc
 		}
 
 		for _, replicationGroup := range page.ReplicationGroups {
+			vpcID, err := vpcs.replicationGroupVpcID(ctx, replicationGroup.MemberClusters)
+			if err != nil {
+				return nil, err
+			}
+			if !cfg.InVpc(vpcID) {
+				continue
+			}
+
 			tags, err := client.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{
 				ResourceName: replicationGroup.ARN,
 			})
@@ -87,6 +94,14 @@ func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resourc
 		}
 
 		for _, cluster := range page.CacheClusters {
+			vpcID, err := vpcs.subnetGroupVpcID(ctx, cluster.CacheSubnetGroupName)
+			if err != nil {
+				return nil, err
+			}
+			if !cfg.InVpc(vpcID) {
+				continue
+			}
+
 			tags, err := client.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{
 				ResourceName: cluster.ARN,
 			})
@@ -108,6 +123,58 @@ func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resourc
 	return clusterIds, nil
 }
 
+// elasticacheVpcResolver looks up the VPC of ElastiCache clusters through their cache subnet groups,
+// which are cached since many clusters usually share one. Lookups are skipped when not enabled, as
+// they are only needed to filter by VPC.
+type elasticacheVpcResolver struct {
+	client       ElasticachesAPI
+	enabled      bool
+	subnetGroups map[string]*string
+}
+
+// replicationGroupVpcID returns the VPC of a replication group, which is the VPC of its member clusters.
+func (r *elasticacheVpcResolver) replicationGroupVpcID(ctx context.Context, memberClusters []string) (*string, error) {
+	if !r.enabled || len(memberClusters) == 0 {
+		return nil, nil
+	}
+	output, err := r.client.DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId: aws.String(memberClusters[0]),
+	})
+	if err != nil {
+		return nil, goerrors.WithStackTrace(err)
+	}
+	if len(output.CacheClusters) == 0 {
+		return nil, nil
+	}
+	return r.subnetGroupVpcID(ctx, output.CacheClusters[0].CacheSubnetGroupName)
+}
+
+// subnetGroupVpcID returns the VPC of a cache subnet group. Clusters outside a VPC have no subnet group.
+func (r *elasticacheVpcResolver) subnetGroupVpcID(ctx context.Context, name *string) (*string, error) {
+	if !r.enabled || aws.ToString(name) == "" {
+		return nil, nil
+	}
+	if vpcID, ok := r.subnetGroups[*name]; ok {
+		return vpcID, nil
+	}
+
+	output, err := r.client.DescribeCacheSubnetGroups(ctx, &elasticache.DescribeCacheSubnetGroupsInput{
+		CacheSubnetGroupName: name,
+	})
+	if err != nil {
+		return nil, goerrors.WithStackTrace(err)
+	}
+	var vpcID *string
+	if len(output.CacheSubnetGroups) > 0 {
+		vpcID = output.CacheSubnetGroups[0].VpcId
+	}
+	if r.subnetGroups == nil {
+		r.subnetGroups = make(map[string]*string)
+	}
+	r.subnetGroups[*name] = vpcID
+	return vpcID, nil
+}
+
 type CacheClusterType string
 
 const (
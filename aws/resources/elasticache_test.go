@@ -20,6 +20,7 @@ type mockedElasticache struct {
 	DescribeCacheClustersOutput     elasticache.DescribeCacheClustersOutput
 	DeleteCacheClusterOutput        elasticache.DeleteCacheClusterOutput
 	DeleteReplicationGroupOutput    elasticache.DeleteReplicationGroupOutput
+	DescribeCacheSubnetGroupsOutput elasticache.DescribeCacheSubnetGroupsOutput
 	TagsByARN                       map[string][]types.Tag
 }
 
@@ -43,6 +44,16 @@ func (m mockedElasticache) ListTagsForResource(ctx context.Context, params *elas
 	return &elasticache.ListTagsForResourceOutput{TagList: m.TagsByARN[aws.ToString(params.ResourceName)]}, nil
 }
 
+func (m mockedElasticache) DescribeCacheSubnetGroups(ctx context.Context, params *elasticache.DescribeCacheSubnetGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
+	var groups []types.CacheSubnetGroup
+	for _, group := range m.DescribeCacheSubnetGroupsOutput.CacheSubnetGroups {
+		if aws.ToString(group.CacheSubnetGroupName) == aws.ToString(params.CacheSubnetGroupName) {
+			groups = append(groups, group)
+		}
+	}
+	return &elasticache.DescribeCacheSubnetGroupsOutput{CacheSubnetGroups: groups}, nil
+}
+
 func TestElasticaches_ResourceName(t *testing.T) {
 	r := NewElasticaches()
 	require.Equal(t, "elasticache", r.ResourceName())
@@ -123,13 +134,37 @@ func TestListElasticaches(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listElasticaches(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listElasticaches(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
 	}
 }
 
+func TestListElasticachesInVpc(t *testing.T) {
+	t.Parallel()
+
+	mock := mockedElasticache{
+		DescribeCacheClustersOutput: elasticache.DescribeCacheClustersOutput{
+			CacheClusters: []types.CacheCluster{
+				{CacheClusterId: aws.String("in-vpc"), CacheSubnetGroupName: aws.String("group-1")},
+				{CacheClusterId: aws.String("other-vpc"), CacheSubnetGroupName: aws.String("group-2")},
+				{CacheClusterId: aws.String("no-vpc")},
+			},
+		},
+		DescribeCacheSubnetGroupsOutput: elasticache.DescribeCacheSubnetGroupsOutput{
+			CacheSubnetGroups: []types.CacheSubnetGroup{
+				{CacheSubnetGroupName: aws.String("group-1"), VpcId: aws.String("vpc-1")},
+				{CacheSubnetGroupName: aws.String("group-2"), VpcId: aws.String("vpc-2")},
+			},
+		},
+	}
+
+	names, err := listElasticaches(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{VpcResourceFilters: config.VpcResourceFilters{VpcIDs: []string{"vpc-1"}}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"in-vpc"}, aws.ToStringSlice(names))
+}
+
 func TestDeleteElasticacheCluster(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -27,23 +28,29 @@ type LoadBalancersAPI interface {
 
 // NewLoadBalancers creates a new LoadBalancers resource using the generic resource pattern.
 func NewLoadBalancers() AwsResource {
-	return NewAwsResource(&resource.Resource[LoadBalancersAPI]{
-		ResourceTypeName: "elb",
-		BatchSize:        DefaultBatchSize,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[LoadBalancersAPI], cfg aws.Config) {
+	return NewEC2AwsResource[LoadBalancersAPI](
+		"elb",
+		WrapAwsInitClient(func(r *resource.Resource[LoadBalancersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticloadbalancing.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ELBv1
+		func(c config.Config) config.EC2ResourceType { return c.ELBv1 },
+		listLoadBalancers,
+		resource.SequentialDeleter(deleteLoadBalancer),
+		&EC2ResourceOptions[LoadBalancersAPI]{
+			Idle: &IdleMetrics{
+				Source:        dimensionSource("AWS/ELB", "LoadBalancerName"),
+				DefaultMetric: "RequestCount",
+				Statistics: map[string]cloudwatchtypes.Statistic{
+					"RequestCount": cloudwatchtypes.StatisticSum,
+				},
+			},
 		},
-		Lister: listLoadBalancers,
-		Nuker:  resource.SequentialDeleter(deleteLoadBalancer),
-	})
+	)
 }
 
 // listLoadBalancers retrieves all Classic ELB load balancers that match the config filters.
-func listLoadBalancers(ctx context.Context, client LoadBalancersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listLoadBalancers(ctx context.Context, client LoadBalancersAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	paginator := elasticloadbalancing.NewDescribeLoadBalancersPaginator(client.(LoadBalancersPaginatorAPI), &elasticloadbalancing.DescribeLoadBalancersInput{})
 
 	var names []*string
@@ -54,6 +61,10 @@ func listLoadBalancers(ctx context.Context, client LoadBalancersAPI, scope resou
 		}
 
 		for _, balancer := range page.LoadBalancerDescriptions {
+			if !cfg.InVpc(balancer.VPCId) {
+				continue
+			}
+
 			tagMap := map[string]string{}
 			tagsOutput, err := client.DescribeTags(ctx, &elasticloadbalancing.DescribeTagsInput{
 				LoadBalancerNames: []string{aws.ToString(balancer.LoadBalancerName)},
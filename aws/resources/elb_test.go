@@ -97,7 +97,7 @@ func TestElb_GetAll(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listLoadBalancers(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listLoadBalancers(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -27,23 +28,30 @@ type LoadBalancersV2API interface {
 
 // NewLoadBalancersV2 creates a new LoadBalancersV2 resource using the generic resource pattern.
 func NewLoadBalancersV2() AwsResource {
-	return NewAwsResource(&resource.Resource[LoadBalancersV2API]{
-		ResourceTypeName: "elbv2",
-		BatchSize:        DefaultBatchSize,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[LoadBalancersV2API], cfg aws.Config) {
+	return NewEC2AwsResource[LoadBalancersV2API](
+		"elbv2",
+		WrapAwsInitClient(func(r *resource.Resource[LoadBalancersV2API], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticloadbalancingv2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ELBv2
+		func(c config.Config) config.EC2ResourceType { return c.ELBv2 },
+		listLoadBalancersV2,
+		resource.SequentialDeleter(resource.DeleteThenWait(deleteLoadBalancerV2, waitForLoadBalancerV2Deleted)),
+		&EC2ResourceOptions[LoadBalancersV2API]{
+			Idle: &IdleMetrics{
+				Source:        loadBalancerV2MetricSource,
+				DefaultMetric: "ProcessedBytes",
+				Statistics: map[string]cloudwatchtypes.Statistic{
+					"ProcessedBytes": cloudwatchtypes.StatisticSum,
+					"RequestCount":   cloudwatchtypes.StatisticSum,
+				},
+			},
 		},
-		Lister: listLoadBalancersV2,
-		Nuker:  resource.SequentialDeleter(resource.DeleteThenWait(deleteLoadBalancerV2, waitForLoadBalancerV2Deleted)),
-	})
+	)
 }
 
 // listLoadBalancersV2 retrieves all ELBv2 load balancers that match the config filters.
-func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	paginator := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(client.(LoadBalancersV2PaginatorAPI), &elasticloadbalancingv2.DescribeLoadBalancersInput{})
 
 	var arns []*string
@@ -54,6 +62,10 @@ func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope r
 		}
 
 		for _, balancer := range page.LoadBalancers {
+			if !cfg.InVpc(balancer.VpcId) {
+				continue
+			}
+
 			tagMap := map[string]string{}
 			tagsOutput, err := client.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{
 				ResourceArns: []string{aws.ToString(balancer.LoadBalancerArn)},
@@ -69,6 +81,7 @@ func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope r
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   balancer.LoadBalancerArn,
 				Name: balancer.LoadBalancerName,
 				Time: balancer.CreatedTime,
 				Tags: tagMap,
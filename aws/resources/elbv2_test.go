@@ -104,13 +104,29 @@ func TestElbV2_GetAll(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listLoadBalancersV2(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listLoadBalancersV2(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
 	}
 }
 
+func TestElbV2_GetAllInVpc(t *testing.T) {
+	t.Parallel()
+	mock := mockedElbV2{
+		DescribeLoadBalancersOutput: elasticloadbalancingv2.DescribeLoadBalancersOutput{
+			LoadBalancers: []types.LoadBalancer{
+				{LoadBalancerArn: aws.String("test-arn-1"), LoadBalancerName: aws.String("test-name-1"), VpcId: aws.String("vpc-1")},
+				{LoadBalancerArn: aws.String("test-arn-2"), LoadBalancerName: aws.String("test-name-2"), VpcId: aws.String("vpc-2")},
+			},
+		},
+	}
+
+	names, err := listLoadBalancersV2(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{VpcResourceFilters: config.VpcResourceFilters{VpcIDs: []string{"vpc-2"}}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-arn-2"}, aws.ToStringSlice(names))
+}
+
 type errMockLoadBalancerNotFound struct{}
 
 func (e errMockLoadBalancerNotFound) Error() string {
@@ -74,6 +74,7 @@ func listGrafanaWorkspaces(ctx context.Context, client GrafanaAPI, scope resourc
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   workspace.Id,
 				Name: workspace.Name,
 				Time: workspace.Created,
 				Tags: workspace.Tags,
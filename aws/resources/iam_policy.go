@@ -68,6 +68,7 @@ func listIAMPolicies(ctx context.Context, client IAMPoliciesAPI, scope resource.
 			tags := tagsOut.Tags
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   policy.Arn,
 				Name: policy.PolicyName,
 				Time: policy.CreateDate,
 				Tags: util.ConvertIAMTagsToMap(tags),
@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// maxMetricDataQueries is the most queries GetMetricData accepts per call.
+const maxMetricDataQueries = 500
+
+// IdleMetricsAPI defines the CloudWatch operation used to evaluate idle rules.
+type IdleMetricsAPI interface {
+	GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// IdleMetrics describes the CloudWatch metrics a resource type publishes, so that its resources can
+// be filtered with an idle rule.
+type IdleMetrics struct {
+	// Source returns the namespace and dimension of the metrics of the resource with the given identifier.
+	Source func(id string) (namespace string, dimension types.Dimension)
+	// DefaultMetric is the metric used when the idle rule names none.
+	DefaultMetric string
+	// Statistics maps metrics to the statistic that suits them. Other metrics default to Average.
+	Statistics map[string]types.Statistic
+}
+
+// dimensionSource returns a Source for resources whose identifier is the value of a single dimension.
+func dimensionSource(namespace, dimension string) func(id string) (string, types.Dimension) {
+	return func(id string) (string, types.Dimension) {
+		return namespace, types.Dimension{Name: aws.String(dimension), Value: aws.String(id)}
+	}
+}
+
+// filterIdle returns the identifiers whose metric stayed at or below the rule's maximum, querying
+// CloudWatch in batches, and records the peak value that made each one idle.
+func filterIdle(ctx context.Context, client IdleMetricsAPI, scope resource.Scope, cfg config.ResourceType, metrics *IdleMetrics, rule config.IdleRule, ids []*string) ([]*string, error) {
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	metric := rule.Metric
+	if metric == "" {
+		metric = metrics.DefaultMetric
+	}
+	statistic := types.Statistic(rule.Statistic)
+	if statistic == "" {
+		statistic = types.StatisticAverage
+		if s, ok := metrics.Statistics[metric]; ok {
+			statistic = s
+		}
+	}
+
+	end := time.Now().UTC().Truncate(time.Hour)
+	start := end.AddDate(0, 0, -rule.Days)
+
+	var idle []*string
+	for batchStart := 0; batchStart < len(ids); batchStart += maxMetricDataQueries {
+		batch := ids[batchStart:min(batchStart+maxMetricDataQueries, len(ids))]
+
+		queries := make([]types.MetricDataQuery, 0, len(batch))
+		for i, id := range batch {
+			namespace, dimension := metrics.Source(aws.ToString(id))
+			queries = append(queries, types.MetricDataQuery{
+				Id: aws.String(fmt.Sprintf("m%d", i)),
+				MetricStat: &types.MetricStat{
+					Metric: &types.Metric{
+						Namespace:  aws.String(namespace),
+						MetricName: aws.String(metric),
+						Dimensions: []types.Dimension{dimension},
+					},
+					Period: aws.Int32(int32((24 * time.Hour).Seconds())),
+					Stat:   aws.String(string(statistic)),
+				},
+			})
+		}
+
+		values, err := getMetricValues(ctx, client, queries, start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, id := range batch {
+			peak, found := 0.0, false
+			for _, value := range values[fmt.Sprintf("m%d", i)] {
+				if !found || value > peak {
+					peak, found = value, true
+				}
+			}
+			if peak > rule.Max {
+				logging.Debugf("[Skip] %s is in use: %s %s peaked at %g", aws.ToString(id), metric, statistic, peak)
+				continue
+			}
+
+			detail := fmt.Sprintf("no %s datapoints in %dd", metric, rule.Days)
+			if found {
+				detail = fmt.Sprintf("%s %s peaked at %g over %dd (max %g)", metric, statistic, peak, rule.Days, rule.Max)
+			}
+			cfg.AddFinding(scope.Region, aws.ToString(id), detail)
+			idle = append(idle, id)
+		}
+	}
+
+	return idle, nil
+}
+
+// getMetricValues runs the queries of a single GetMetricData batch and returns the values of each
+// query by its ID.
+func getMetricValues(ctx context.Context, client IdleMetricsAPI, queries []types.MetricDataQuery, start, end time.Time) (map[string][]float64, error) {
+	values := make(map[string][]float64, len(queries))
+	input := &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: queries,
+		StartTime:         aws.Time(start),
+		EndTime:           aws.Time(end),
+	}
+	for {
+		output, err := client.GetMetricData(ctx, input)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, result := range output.MetricDataResults {
+			id := aws.ToString(result.Id)
+			values[id] = append(values[id], result.Values...)
+		}
+		if output.NextToken == nil {
+			return values, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// loadBalancerV2MetricSource returns the namespace and LoadBalancer dimension of an ELBv2 load
+// balancer ARN, whose dimension value is the "app/name/id" suffix of the ARN.
+func loadBalancerV2MetricSource(arn string) (string, types.Dimension) {
+	_, suffix, _ := strings.Cut(arn, ":loadbalancer/")
+	namespace := "AWS/ApplicationELB"
+	switch {
+	case strings.HasPrefix(suffix, "net/"):
+		namespace = "AWS/NetworkELB"
+	case strings.HasPrefix(suffix, "gwy/"):
+		namespace = "AWS/GatewayELB"
+	}
+	return namespace, types.Dimension{Name: aws.String("LoadBalancer"), Value: aws.String(suffix)}
+}
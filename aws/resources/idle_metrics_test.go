@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/require"
+)
+
+// mockIdleMetricsClient returns the values of each resource by its dimension value, one page per query.
+type mockIdleMetricsClient struct {
+	values map[string][]float64
+	calls  int
+	inputs []*cloudwatch.GetMetricDataInput
+}
+
+func (m *mockIdleMetricsClient) GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	m.calls++
+	m.inputs = append(m.inputs, params)
+
+	// Return the first query on the first page and the rest on the second, to exercise paging.
+	queries := params.MetricDataQueries
+	var next *string
+	if params.NextToken == nil {
+		queries, next = queries[:1], aws.String("page-2")
+	} else {
+		queries = queries[1:]
+	}
+
+	output := &cloudwatch.GetMetricDataOutput{NextToken: next}
+	for _, q := range queries {
+		id := aws.ToString(q.MetricStat.Metric.Dimensions[0].Value)
+		output.MetricDataResults = append(output.MetricDataResults, types.MetricDataResult{Id: q.Id, Values: m.values[id]})
+	}
+	return output, nil
+}
+
+func TestFilterIdle(t *testing.T) {
+	t.Parallel()
+
+	metrics := &IdleMetrics{
+		Source:        dimensionSource("AWS/EC2", "InstanceId"),
+		DefaultMetric: "CPUUtilization",
+		Statistics:    map[string]types.Statistic{"NetworkIn": types.StatisticSum},
+	}
+	client := &mockIdleMetricsClient{values: map[string][]float64{
+		"i-busy":  {1, 35.5},
+		"i-quiet": {1.2, 0.4},
+	}}
+	findings := config.NewFindings()
+	c := config.Config{}
+	c.RecordFindings(findings)
+
+	ids := aws.StringSlice([]string{"i-busy", "i-quiet", "i-stopped"})
+	idle, err := filterIdle(context.Background(), client, resource.Scope{Region: "us-east-1"}, c.EC2.ResourceType, metrics, config.IdleRule{Max: 2, Days: 14}, ids)
+	require.NoError(t, err)
+	require.Equal(t, []string{"i-quiet", "i-stopped"}, aws.ToStringSlice(idle))
+	require.Equal(t, "CPUUtilization Average peaked at 1.2 over 14d (max 2)", findings.Detail("us-east-1", "i-quiet"))
+	require.Equal(t, "no CPUUtilization datapoints in 14d", findings.Detail("us-east-1", "i-stopped"))
+	require.Empty(t, findings.Detail("us-east-1", "i-busy"))
+
+	stat := client.inputs[0].MetricDataQueries[0].MetricStat
+	require.Equal(t, "AWS/EC2", aws.ToString(stat.Metric.Namespace))
+	require.Equal(t, "Average", aws.ToString(stat.Stat))
+	require.Equal(t, int32(86400), aws.ToInt32(stat.Period))
+	require.Equal(t, 14*24.0, client.inputs[0].EndTime.Sub(*client.inputs[0].StartTime).Hours())
+
+	// A metric with a known statistic uses it unless the rule overrides it
+	client.inputs = nil
+	_, err = filterIdle(context.Background(), client, resource.Scope{Region: "us-east-1"}, c.EC2.ResourceType, metrics, config.IdleRule{Metric: "NetworkIn", Days: 7}, ids)
+	require.NoError(t, err)
+	require.Equal(t, "Sum", aws.ToString(client.inputs[0].MetricDataQueries[0].MetricStat.Stat))
+}
+
+func TestFilterIdle_Batches(t *testing.T) {
+	t.Parallel()
+
+	var ids []*string
+	for i := 0; i < maxMetricDataQueries+1; i++ {
+		ids = append(ids, aws.String(fmt.Sprintf("nat-%d", i)))
+	}
+	client := &mockIdleMetricsClient{}
+	metrics := &IdleMetrics{Source: dimensionSource("AWS/NATGateway", "NatGatewayId"), DefaultMetric: "BytesOutToDestination"}
+
+	idle, err := filterIdle(context.Background(), client, resource.Scope{}, config.ResourceType{}, metrics, config.IdleRule{Days: 14}, ids)
+	require.NoError(t, err)
+	require.Len(t, idle, len(ids))
+	// Two batches, each read over two pages
+	require.Equal(t, 4, client.calls)
+	require.Len(t, client.inputs[0].MetricDataQueries, maxMetricDataQueries)
+	require.Len(t, client.inputs[2].MetricDataQueries, 1)
+}
+
+func TestLoadBalancerV2MetricSource(t *testing.T) {
+	t.Parallel()
+
+	namespace, dimension := loadBalancerV2MetricSource("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/my-nlb/50dc6c495c0c9188")
+	require.Equal(t, "AWS/NetworkELB", namespace)
+	require.Equal(t, "LoadBalancer", aws.ToString(dimension.Name))
+	require.Equal(t, "net/my-nlb/50dc6c495c0c9188", aws.ToString(dimension.Value))
+
+	namespace, _ = loadBalancerV2MetricSource("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188")
+	require.Equal(t, "AWS/ApplicationELB", namespace)
+}
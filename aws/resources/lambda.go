@@ -21,23 +21,21 @@ type LambdaFunctionsAPI interface {
 
 // NewLambdaFunctions creates a new Lambda Functions resource using the generic resource pattern.
 func NewLambdaFunctions() AwsResource {
-	return NewAwsResource(&resource.Resource[LambdaFunctionsAPI]{
-		ResourceTypeName: "lambda",
-		BatchSize:        DefaultBatchSize,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[LambdaFunctionsAPI], cfg aws.Config) {
+	return NewEC2AwsResource[LambdaFunctionsAPI](
+		"lambda",
+		WrapAwsInitClient(func(r *resource.Resource[LambdaFunctionsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = lambda.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.LambdaFunction
-		},
-		Lister: listLambdaFunctions,
-		Nuker:  resource.SimpleBatchDeleter(deleteLambdaFunction),
-	})
+		func(c config.Config) config.EC2ResourceType { return c.LambdaFunction },
+		listLambdaFunctions,
+		resource.SimpleBatchDeleter(deleteLambdaFunction),
+		nil,
+	)
 }
 
 // listLambdaFunctions retrieves all Lambda functions that match the config filters.
-func listLambdaFunctions(ctx context.Context, client LambdaFunctionsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listLambdaFunctions(ctx context.Context, client LambdaFunctionsAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	var names []*string
 
 	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
@@ -48,7 +46,16 @@ func listLambdaFunctions(ctx context.Context, client LambdaFunctionsAPI, scope r
 		}
 
 		for _, fn := range page.Functions {
-			if shouldIncludeLambdaFunction(ctx, client, &fn, cfg) {
+			// Functions without VPC access have no VpcConfig, or one with an empty VpcId
+			var vpcID *string
+			if fn.VpcConfig != nil && aws.ToString(fn.VpcConfig.VpcId) != "" {
+				vpcID = fn.VpcConfig.VpcId
+			}
+			if !cfg.InVpc(vpcID) {
+				continue
+			}
+
+			if shouldIncludeLambdaFunction(ctx, client, &fn, cfg.ResourceType) {
 				names = append(names, fn.FunctionName)
 			}
 		}
@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	goerr "errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// LambdaLayerVersionsAPI defines the interface for Lambda layer version operations, and the function
+// operations used to find the layer versions in use.
+type LambdaLayerVersionsAPI interface {
+	DeleteLayerVersion(ctx context.Context, params *lambda.DeleteLayerVersionInput, optFns ...func(*lambda.Options)) (*lambda.DeleteLayerVersionOutput, error)
+	GetLayerVersion(ctx context.Context, params *lambda.GetLayerVersionInput, optFns ...func(*lambda.Options)) (*lambda.GetLayerVersionOutput, error)
+	ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error)
+	ListLayers(ctx context.Context, params *lambda.ListLayersInput, optFns ...func(*lambda.Options)) (*lambda.ListLayersOutput, error)
+	ListLayerVersions(ctx context.Context, params *lambda.ListLayerVersionsInput, optFns ...func(*lambda.Options)) (*lambda.ListLayerVersionsOutput, error)
+	ListTags(ctx context.Context, params *lambda.ListTagsInput, optFns ...func(*lambda.Options)) (*lambda.ListTagsOutput, error)
+}
+
+// NewLambdaLayerVersions creates a new Lambda layer version resource using the generic resource
+// pattern. It deletes layer versions that no function's current configuration uses, keeping the
+// versions functions depend on.
+func NewLambdaLayerVersions() AwsResource {
+	var versionCfg config.RetentionResourceType
+	codeSizes := map[string]int64{}
+	return NewAwsResource(&resource.Resource[LambdaLayerVersionsAPI]{
+		ResourceTypeName: "lambda-layer-version",
+		BatchSize:        DefaultBatchSize,
+		InitClient: WrapAwsInitClient(func(r *resource.Resource[LambdaLayerVersionsAPI], cfg aws.Config) {
+			r.Scope.Region = cfg.Region
+			r.Client = lambda.NewFromConfig(cfg)
+		}),
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			versionCfg = c.LambdaLayerVersion
+			return c.LambdaLayerVersion.ResourceType
+		},
+		Lister: func(ctx context.Context, client LambdaLayerVersionsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			versionCfg.ResourceType = cfg
+			return listLambdaLayerVersions(ctx, client, scope, versionCfg, codeSizes)
+		},
+		Nuker: resource.SequentialDetailDeleter(func(ctx context.Context, client LambdaLayerVersionsAPI, id *string) (string, error) {
+			return deleteUnusedLambdaLayerVersion(ctx, client, id, codeSizes[aws.ToString(id)])
+		}),
+	})
+}
+
+// listLambdaLayerVersions retrieves the unused versions of all layers that match the config filters,
+// beyond those the retain rule keeps, as "layerName:versionNumber" identifiers. It records the code
+// size of each in codeSizes.
+func listLambdaLayerVersions(ctx context.Context, client LambdaLayerVersionsAPI, scope resource.Scope, cfg config.RetentionResourceType, codeSizes map[string]int64) ([]*string, error) {
+	inUse, err := lambdaLayerVersionsInUse(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifiers []*string
+	paginator := lambda.NewListLayersPaginator(client, &lambda.ListLayersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, layer := range page.Layers {
+			ids, err := listLayerVersions(ctx, client, scope, cfg, layer, inUse, codeSizes)
+			if err != nil {
+				return nil, err
+			}
+			identifiers = append(identifiers, ids...)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// listLayerVersions retrieves the unused versions of a single layer.
+func listLayerVersions(ctx context.Context, client LambdaLayerVersionsAPI, scope resource.Scope, cfg config.RetentionResourceType, layer types.LayersListItem, inUse map[string]string, codeSizes map[string]int64) ([]*string, error) {
+	// Skip rather than risk pruning a layer whose tags we couldn't read (it may carry an exclude tag)
+	tagsOutput, err := client.ListTags(ctx, &lambda.ListTagsInput{Resource: layer.LayerArn})
+	if err != nil {
+		logging.Debugf("[Failed] Unable to fetch tags for Lambda layer %s: %s", aws.ToString(layer.LayerName), err)
+		return nil, nil
+	}
+
+	var values []config.ResourceValue
+	versions := map[string]types.LayerVersionsListItem{}
+	paginator := lambda.NewListLayerVersionsPaginator(client, &lambda.ListLayerVersionsInput{LayerName: layer.LayerName})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, version := range page.LayerVersions {
+			id := fmt.Sprintf("%s:%d", aws.ToString(layer.LayerName), version.Version)
+			createdDate, err := time.Parse(awsLambdaTimeFormat, aws.ToString(version.CreatedDate))
+			if err != nil {
+				logging.Debugf("Could not parse created timestamp (%s) of Lambda layer version %s. Excluding from delete.", aws.ToString(version.CreatedDate), id)
+				continue
+			}
+
+			versions[id] = version
+			values = append(values, config.ResourceValue{ID: aws.String(id), Name: layer.LayerName, Time: &createdDate, Tags: tagsOutput.Tags})
+		}
+	}
+
+	var identifiers []*string
+	for _, value := range cfg.Retain.Surplus(cfg.ResourceType, values) {
+		version := versions[aws.ToString(value.ID)]
+		if user, ok := inUse[aws.ToString(version.LayerVersionArn)]; ok {
+			logging.Debugf("[Skip] Lambda layer version %s is used by function %s", aws.ToString(value.ID), user)
+			continue
+		}
+		if !cfg.ShouldInclude(value) {
+			continue
+		}
+
+		output, err := client.GetLayerVersion(ctx, &lambda.GetLayerVersionInput{
+			LayerName:     layer.LayerName,
+			VersionNumber: aws.Int64(version.Version),
+		})
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if output.Content != nil {
+			codeSizes[aws.ToString(value.ID)] = output.Content.CodeSize
+		}
+		cfg.AddFinding(scope.Region, aws.ToString(value.ID), fmt.Sprintf("unused, %s of code", formatBytes(codeSizes[aws.ToString(value.ID)])))
+		identifiers = append(identifiers, value.ID)
+	}
+
+	return identifiers, nil
+}
+
+// lambdaLayerVersionsInUse maps the ARNs of the layer versions that the current configuration of a
+// function uses to the name of one such function.
+func lambdaLayerVersionsInUse(ctx context.Context, client LambdaLayerVersionsAPI) (map[string]string, error) {
+	inUse := map[string]string{}
+
+	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, fn := range page.Functions {
+			for _, layer := range fn.Layers {
+				inUse[aws.ToString(layer.Arn)] = aws.ToString(fn.FunctionName)
+			}
+		}
+	}
+
+	return inUse, nil
+}
+
+// deleteUnusedLambdaLayerVersion deletes a single layer version, identified as "layerName:versionNumber",
+// and reports the code storage reclaimed. A version that no longer exists, e.g. because its layer was
+// deleted, counts as deleted.
+func deleteUnusedLambdaLayerVersion(ctx context.Context, client LambdaLayerVersionsAPI, id *string, codeSize int64) (string, error) {
+	err := deleteLambdaLayerVersion(ctx, client, id)
+	var notFound *types.ResourceNotFoundException
+	if goerr.As(err, &notFound) {
+		return "already deleted", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("reclaimed %s", formatBytes(codeSize)), nil
+}
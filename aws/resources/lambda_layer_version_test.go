@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLambdaLayerVersionsClient struct {
+	Functions     []types.FunctionConfiguration
+	Layers        []types.LayersListItem
+	LayerVersions map[string][]types.LayerVersionsListItem
+	CodeSizes     map[string]int64
+	DeleteError   error
+}
+
+func (m *mockLambdaLayerVersionsClient) DeleteLayerVersion(ctx context.Context, params *lambda.DeleteLayerVersionInput, optFns ...func(*lambda.Options)) (*lambda.DeleteLayerVersionOutput, error) {
+	if m.DeleteError != nil {
+		return nil, m.DeleteError
+	}
+	return &lambda.DeleteLayerVersionOutput{}, nil
+}
+
+func (m *mockLambdaLayerVersionsClient) GetLayerVersion(ctx context.Context, params *lambda.GetLayerVersionInput, optFns ...func(*lambda.Options)) (*lambda.GetLayerVersionOutput, error) {
+	id := fmt.Sprintf("%s:%d", aws.ToString(params.LayerName), aws.ToInt64(params.VersionNumber))
+	return &lambda.GetLayerVersionOutput{Content: &types.LayerVersionContentOutput{CodeSize: m.CodeSizes[id]}}, nil
+}
+
+func (m *mockLambdaLayerVersionsClient) ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
+	return &lambda.ListFunctionsOutput{Functions: m.Functions}, nil
+}
+
+func (m *mockLambdaLayerVersionsClient) ListLayers(ctx context.Context, params *lambda.ListLayersInput, optFns ...func(*lambda.Options)) (*lambda.ListLayersOutput, error) {
+	return &lambda.ListLayersOutput{Layers: m.Layers}, nil
+}
+
+func (m *mockLambdaLayerVersionsClient) ListLayerVersions(ctx context.Context, params *lambda.ListLayerVersionsInput, optFns ...func(*lambda.Options)) (*lambda.ListLayerVersionsOutput, error) {
+	return &lambda.ListLayerVersionsOutput{LayerVersions: m.LayerVersions[aws.ToString(params.LayerName)]}, nil
+}
+
+func (m *mockLambdaLayerVersionsClient) ListTags(ctx context.Context, params *lambda.ListTagsInput, optFns ...func(*lambda.Options)) (*lambda.ListTagsOutput, error) {
+	return &lambda.ListTagsOutput{}, nil
+}
+
+func lambdaLayerVersion(layerName string, version int64, createdDate string) types.LayerVersionsListItem {
+	return types.LayerVersionsListItem{
+		Version:         version,
+		CreatedDate:     aws.String(createdDate),
+		LayerVersionArn: aws.String(fmt.Sprintf("arn:aws:lambda:us-east-1:123456789012:layer:%s:%d", layerName, version)),
+	}
+}
+
+func TestListLambdaLayerVersions(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockLambdaLayerVersionsClient{
+		Functions: []types.FunctionConfiguration{{
+			FunctionName: aws.String("api"),
+			Layers:       []types.Layer{{Arn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:deps:2")}},
+		}},
+		Layers: []types.LayersListItem{{LayerName: aws.String("deps"), LayerArn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:deps")}},
+		LayerVersions: map[string][]types.LayerVersionsListItem{
+			"deps": {
+				lambdaLayerVersion("deps", 3, "2024-01-03T00:00:00.000+0000"),
+				lambdaLayerVersion("deps", 2, "2024-01-02T00:00:00.000+0000"),
+				lambdaLayerVersion("deps", 1, "2024-01-01T00:00:00.000+0000"),
+			},
+		},
+		CodeSizes: map[string]int64{"deps:1": 2048, "deps:3": 4096},
+	}
+
+	tests := map[string]struct {
+		configObj config.RetentionResourceType
+		expected  []string
+	}{
+		"emptyFilter": {
+			configObj: config.RetentionResourceType{},
+			expected:  []string{"deps:3", "deps:1"},
+		},
+		"retainNewest": {
+			configObj: config.RetentionResourceType{Retain: &config.RetainRule{Keep: 1}},
+			expected:  []string{"deps:1"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			codeSizes := map[string]int64{}
+			ids, err := listLambdaLayerVersions(context.Background(), mock, resource.Scope{}, tc.configObj, codeSizes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			for _, id := range tc.expected {
+				require.Equal(t, mock.CodeSizes[id], codeSizes[id])
+			}
+		})
+	}
+}
+
+func TestDeleteUnusedLambdaLayerVersion(t *testing.T) {
+	t.Parallel()
+
+	detail, err := deleteUnusedLambdaLayerVersion(context.Background(), &mockLambdaLayerVersionsClient{}, aws.String("deps:2"), 2048)
+	require.NoError(t, err)
+	require.Equal(t, "reclaimed 2.0 KiB", detail)
+
+	mock := &mockLambdaLayerVersionsClient{DeleteError: &types.ResourceNotFoundException{}}
+	detail, err = deleteUnusedLambdaLayerVersion(context.Background(), mock, aws.String("deps:2"), 2048)
+	require.NoError(t, err)
+	require.Equal(t, "already deleted", detail)
+
+	mock = &mockLambdaLayerVersionsClient{DeleteError: fmt.Errorf("throttled")}
+	_, err = deleteUnusedLambdaLayerVersion(context.Background(), mock, aws.String("deps:2"), 2048)
+	require.ErrorContains(t, err, "throttled")
+}
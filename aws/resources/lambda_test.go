@@ -122,7 +122,7 @@ func TestListLambdaFunctions(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listLambdaFunctions(context.Background(), tc.mock, resource.Scope{}, tc.configObj)
+			names, err := listLambdaFunctions(context.Background(), tc.mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -0,0 +1,201 @@
+package resources
+
+import (
+	"context"
+	goerr "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// LambdaVersionsAPI defines the interface for Lambda function version operations.
+type LambdaVersionsAPI interface {
+	DeleteFunction(ctx context.Context, params *lambda.DeleteFunctionInput, optFns ...func(*lambda.Options)) (*lambda.DeleteFunctionOutput, error)
+	ListAliases(ctx context.Context, params *lambda.ListAliasesInput, optFns ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error)
+	ListEventSourceMappings(ctx context.Context, params *lambda.ListEventSourceMappingsInput, optFns ...func(*lambda.Options)) (*lambda.ListEventSourceMappingsOutput, error)
+	ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error)
+	ListTags(ctx context.Context, params *lambda.ListTagsInput, optFns ...func(*lambda.Options)) (*lambda.ListTagsOutput, error)
+	ListVersionsByFunction(ctx context.Context, params *lambda.ListVersionsByFunctionInput, optFns ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error)
+}
+
+// NewLambdaVersions creates a new Lambda function version resource using the generic resource pattern.
+// It deletes published versions that no alias or event source mapping references, keeping the
+// functions themselves and their unpublished $LATEST code.
+func NewLambdaVersions() AwsResource {
+	var versionCfg config.RetentionResourceType
+	codeSizes := map[string]int64{}
+	return NewAwsResource(&resource.Resource[LambdaVersionsAPI]{
+		ResourceTypeName: "lambda-version",
+		BatchSize:        DefaultBatchSize,
+		InitClient: WrapAwsInitClient(func(r *resource.Resource[LambdaVersionsAPI], cfg aws.Config) {
+			r.Scope.Region = cfg.Region
+			r.Client = lambda.NewFromConfig(cfg)
+		}),
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			versionCfg = c.LambdaVersion
+			return c.LambdaVersion.ResourceType
+		},
+		Lister: func(ctx context.Context, client LambdaVersionsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			versionCfg.ResourceType = cfg
+			return listLambdaVersions(ctx, client, scope, versionCfg, codeSizes)
+		},
+		Nuker: resource.SequentialDetailDeleter(func(ctx context.Context, client LambdaVersionsAPI, id *string) (string, error) {
+			return deleteLambdaVersion(ctx, client, id, codeSizes[aws.ToString(id)])
+		}),
+	})
+}
+
+// listLambdaVersions retrieves the unreferenced published versions of all functions that match the
+// config filters, beyond those the retain rule keeps, as "function:version" identifiers. It records
+// the code size of each in codeSizes.
+func listLambdaVersions(ctx context.Context, client LambdaVersionsAPI, scope resource.Scope, cfg config.RetentionResourceType, codeSizes map[string]int64) ([]*string, error) {
+	var identifiers []*string
+
+	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, fn := range page.Functions {
+			ids, err := listFunctionVersions(ctx, client, scope, cfg, fn, codeSizes)
+			if err != nil {
+				return nil, err
+			}
+			identifiers = append(identifiers, ids...)
+		}
+	}
+
+	return identifiers, nil
+}
+
+// listFunctionVersions retrieves the unreferenced published versions of a single function.
+func listFunctionVersions(ctx context.Context, client LambdaVersionsAPI, scope resource.Scope, cfg config.RetentionResourceType, fn types.FunctionConfiguration, codeSizes map[string]int64) ([]*string, error) {
+	// Skip rather than risk pruning a function whose tags we couldn't read (it may carry an exclude tag)
+	tagsOutput, err := client.ListTags(ctx, &lambda.ListTagsInput{Resource: fn.FunctionArn})
+	if err != nil {
+		logging.Debugf("[Failed] Unable to fetch tags for Lambda function %s: %s", aws.ToString(fn.FunctionName), err)
+		return nil, nil
+	}
+
+	referenced, err := referencedLambdaVersions(ctx, client, fn.FunctionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []config.ResourceValue
+	versions := map[string]types.FunctionConfiguration{}
+	paginator := lambda.NewListVersionsByFunctionPaginator(client, &lambda.ListVersionsByFunctionInput{FunctionName: fn.FunctionName})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+
+		for _, version := range page.Versions {
+			number := aws.ToString(version.Version)
+			if number == "$LATEST" {
+				continue
+			}
+
+			lastModified, err := time.Parse(awsLambdaTimeFormat, aws.ToString(version.LastModified))
+			if err != nil {
+				logging.Debugf("Could not parse last modified timestamp (%s) of Lambda version %s:%s. Excluding from delete.", aws.ToString(version.LastModified), aws.ToString(fn.FunctionName), number)
+				continue
+			}
+
+			id := fmt.Sprintf("%s:%s", aws.ToString(fn.FunctionName), number)
+			versions[id] = version
+			values = append(values, config.ResourceValue{ID: aws.String(id), Name: fn.FunctionName, Time: &lastModified, Tags: tagsOutput.Tags})
+		}
+	}
+
+	var identifiers []*string
+	for _, value := range cfg.Retain.Surplus(cfg.ResourceType, values) {
+		version := versions[aws.ToString(value.ID)]
+		if referenced[aws.ToString(version.Version)] {
+			logging.Debugf("[Skip] %s is referenced by an alias or event source mapping", aws.ToString(value.ID))
+			continue
+		}
+		if !cfg.ShouldInclude(value) {
+			continue
+		}
+
+		codeSizes[aws.ToString(value.ID)] = version.CodeSize
+		cfg.AddFinding(scope.Region, aws.ToString(value.ID), fmt.Sprintf("unreferenced, %s of code", formatBytes(version.CodeSize)))
+		identifiers = append(identifiers, value.ID)
+	}
+
+	return identifiers, nil
+}
+
+// referencedLambdaVersions returns the versions of a function that an alias routes to or an event
+// source mapping invokes.
+func referencedLambdaVersions(ctx context.Context, client LambdaVersionsAPI, functionName *string) (map[string]bool, error) {
+	referenced := map[string]bool{}
+
+	aliases := lambda.NewListAliasesPaginator(client, &lambda.ListAliasesInput{FunctionName: functionName})
+	for aliases.HasMorePages() {
+		page, err := aliases.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, alias := range page.Aliases {
+			referenced[aws.ToString(alias.FunctionVersion)] = true
+			if alias.RoutingConfig != nil {
+				for version := range alias.RoutingConfig.AdditionalVersionWeights {
+					referenced[version] = true
+				}
+			}
+		}
+	}
+
+	mappings := lambda.NewListEventSourceMappingsPaginator(client, &lambda.ListEventSourceMappingsInput{FunctionName: functionName})
+	for mappings.HasMorePages() {
+		page, err := mappings.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, mapping := range page.EventSourceMappings {
+			// A qualified function ARN ends with the version or alias the mapping invokes
+			// (arn:aws:lambda:region:account:function:name:qualifier)
+			if parts := strings.Split(aws.ToString(mapping.FunctionArn), ":"); len(parts) == 8 {
+				referenced[parts[7]] = true
+			}
+		}
+	}
+
+	return referenced, nil
+}
+
+// deleteLambdaVersion deletes a single published version, identified as "function:version", and
+// reports the code storage reclaimed. A version that no longer exists, e.g. because its function was
+// deleted, counts as deleted.
+func deleteLambdaVersion(ctx context.Context, client LambdaVersionsAPI, id *string, codeSize int64) (string, error) {
+	name, version, ok := strings.Cut(aws.ToString(id), ":")
+	if !ok {
+		return "", fmt.Errorf("invalid function version identifier: %s", aws.ToString(id))
+	}
+
+	_, err := client.DeleteFunction(ctx, &lambda.DeleteFunctionInput{
+		FunctionName: aws.String(name),
+		Qualifier:    aws.String(version),
+	})
+	var notFound *types.ResourceNotFoundException
+	if goerr.As(err, &notFound) {
+		return "already deleted", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("reclaimed %s", formatBytes(codeSize)), nil
+}
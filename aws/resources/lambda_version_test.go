@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLambdaVersionsClient struct {
+	Functions     []types.FunctionConfiguration
+	Versions      map[string][]types.FunctionConfiguration
+	Aliases       map[string][]types.AliasConfiguration
+	Mappings      map[string][]types.EventSourceMappingConfiguration
+	DeleteInputs  []*lambda.DeleteFunctionInput
+	DeleteError   error
+	TagsByARN     map[string]map[string]string
+	ListTagsError error
+}
+
+func (m *mockLambdaVersionsClient) DeleteFunction(ctx context.Context, params *lambda.DeleteFunctionInput, optFns ...func(*lambda.Options)) (*lambda.DeleteFunctionOutput, error) {
+	m.DeleteInputs = append(m.DeleteInputs, params)
+	if m.DeleteError != nil {
+		return nil, m.DeleteError
+	}
+	return &lambda.DeleteFunctionOutput{}, nil
+}
+
+func (m *mockLambdaVersionsClient) ListAliases(ctx context.Context, params *lambda.ListAliasesInput, optFns ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error) {
+	return &lambda.ListAliasesOutput{Aliases: m.Aliases[aws.ToString(params.FunctionName)]}, nil
+}
+
+func (m *mockLambdaVersionsClient) ListEventSourceMappings(ctx context.Context, params *lambda.ListEventSourceMappingsInput, optFns ...func(*lambda.Options)) (*lambda.ListEventSourceMappingsOutput, error) {
+	return &lambda.ListEventSourceMappingsOutput{EventSourceMappings: m.Mappings[aws.ToString(params.FunctionName)]}, nil
+}
+
+func (m *mockLambdaVersionsClient) ListFunctions(ctx context.Context, params *lambda.ListFunctionsInput, optFns ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
+	return &lambda.ListFunctionsOutput{Functions: m.Functions}, nil
+}
+
+func (m *mockLambdaVersionsClient) ListTags(ctx context.Context, params *lambda.ListTagsInput, optFns ...func(*lambda.Options)) (*lambda.ListTagsOutput, error) {
+	if m.ListTagsError != nil {
+		return nil, m.ListTagsError
+	}
+	return &lambda.ListTagsOutput{Tags: m.TagsByARN[aws.ToString(params.Resource)]}, nil
+}
+
+func (m *mockLambdaVersionsClient) ListVersionsByFunction(ctx context.Context, params *lambda.ListVersionsByFunctionInput, optFns ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error) {
+	return &lambda.ListVersionsByFunctionOutput{Versions: m.Versions[aws.ToString(params.FunctionName)]}, nil
+}
+
+func lambdaVersion(number, lastModified string, codeSize int64) types.FunctionConfiguration {
+	return types.FunctionConfiguration{Version: aws.String(number), LastModified: aws.String(lastModified), CodeSize: codeSize}
+}
+
+func TestListLambdaVersions(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockLambdaVersionsClient{
+		Functions: []types.FunctionConfiguration{
+			{FunctionName: aws.String("api"), FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:api")},
+			{FunctionName: aws.String("worker"), FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:worker")},
+		},
+		Versions: map[string][]types.FunctionConfiguration{
+			"api": {
+				lambdaVersion("$LATEST", "2024-01-05T00:00:00.000+0000", 100),
+				lambdaVersion("1", "2024-01-01T00:00:00.000+0000", 100),
+				lambdaVersion("2", "2024-01-02T00:00:00.000+0000", 200),
+				lambdaVersion("3", "2024-01-03T00:00:00.000+0000", 300),
+				lambdaVersion("4", "2024-01-04T00:00:00.000+0000", 400),
+			},
+			"worker": {
+				lambdaVersion("1", "2024-01-01T00:00:00.000+0000", 100),
+				lambdaVersion("2", "2024-01-02T00:00:00.000+0000", 200),
+			},
+		},
+		Aliases: map[string][]types.AliasConfiguration{
+			"api": {{
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("2"),
+				RoutingConfig:   &types.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]float64{"3": 0.1}},
+			}},
+		},
+		Mappings: map[string][]types.EventSourceMappingConfiguration{
+			"worker": {{FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:worker:1")}},
+		},
+	}
+
+	tests := map[string]struct {
+		configObj config.RetentionResourceType
+		expected  []string
+	}{
+		"emptyFilter": {
+			configObj: config.RetentionResourceType{},
+			expected:  []string{"api:1", "api:4", "worker:2"},
+		},
+		"nameExclusionFilter": {
+			configObj: config.RetentionResourceType{
+				ResourceType: config.ResourceType{
+					ExcludeRule: config.FilterRule{
+						NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^worker$")}},
+					},
+				},
+			},
+			expected: []string{"api:1", "api:4"},
+		},
+		"retainNewest": {
+			// The newest version of each function is retained, and referenced versions are still skipped
+			configObj: config.RetentionResourceType{Retain: &config.RetainRule{Keep: 1}},
+			expected:  []string{"api:1"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			codeSizes := map[string]int64{}
+			ids, err := listLambdaVersions(context.Background(), mock, resource.Scope{}, tc.configObj, codeSizes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			for _, id := range tc.expected {
+				require.Contains(t, codeSizes, id)
+			}
+		})
+	}
+}
+
+func TestListLambdaVersions_SkipsFunctionsWithUnreadableTags(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockLambdaVersionsClient{
+		Functions: []types.FunctionConfiguration{{FunctionName: aws.String("api")}},
+		Versions: map[string][]types.FunctionConfiguration{
+			"api": {lambdaVersion("1", "2024-01-01T00:00:00.000+0000", 100)},
+		},
+		ListTagsError: context.DeadlineExceeded,
+	}
+
+	ids, err := listLambdaVersions(context.Background(), mock, resource.Scope{}, config.RetentionResourceType{}, map[string]int64{})
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestDeleteLambdaVersion(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockLambdaVersionsClient{}
+	detail, err := deleteLambdaVersion(context.Background(), mock, aws.String("api:3"), 1536)
+	require.NoError(t, err)
+	require.Equal(t, "reclaimed 1.5 KiB", detail)
+	require.Len(t, mock.DeleteInputs, 1)
+	require.Equal(t, "api", aws.ToString(mock.DeleteInputs[0].FunctionName))
+	require.Equal(t, "3", aws.ToString(mock.DeleteInputs[0].Qualifier))
+
+	_, err = deleteLambdaVersion(context.Background(), mock, aws.String("api"), 0)
+	require.ErrorContains(t, err, "invalid function version identifier")
+
+	// The function was deleted, with all its versions, before the version
+	mock = &mockLambdaVersionsClient{DeleteError: &types.ResourceNotFoundException{}}
+	detail, err = deleteLambdaVersion(context.Background(), mock, aws.String("api:3"), 1536)
+	require.NoError(t, err)
+	require.Equal(t, "already deleted", detail)
+}
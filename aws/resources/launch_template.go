@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -65,3 +66,74 @@ func deleteLaunchTemplate(ctx context.Context, client LaunchTemplatesAPI, name *
 	})
 	return err
 }
+
+// LaunchTemplateReferencesAPI defines the Launch Template operations needed to find the images,
+// snapshots and security groups that launch templates refer to.
+type LaunchTemplateReferencesAPI interface {
+	DescribeLaunchTemplates(ctx context.Context, params *ec2.DescribeLaunchTemplatesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error)
+	DescribeLaunchTemplateVersions(ctx context.Context, params *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error)
+}
+
+// launchTemplateRefs maps the IDs of the images, snapshots and security groups that launch
+// templates refer to, to the launch template version that refers to them.
+type launchTemplateRefs struct {
+	images         map[string]string
+	snapshots      map[string]string
+	securityGroups map[string]string
+}
+
+// launchTemplateReferences returns what every version of every launch template refers to. All
+// versions are checked, because an Auto Scaling group or fleet can be pinned to any of them.
+func launchTemplateReferences(ctx context.Context, client LaunchTemplateReferencesAPI) (launchTemplateRefs, error) {
+	refs := launchTemplateRefs{
+		images:         make(map[string]string),
+		snapshots:      make(map[string]string),
+		securityGroups: make(map[string]string),
+	}
+
+	templates := ec2.NewDescribeLaunchTemplatesPaginator(client, &ec2.DescribeLaunchTemplatesInput{})
+	for templates.HasMorePages() {
+		page, err := templates.NextPage(ctx)
+		if err != nil {
+			return refs, err
+		}
+
+		for _, template := range page.LaunchTemplates {
+			versions := ec2.NewDescribeLaunchTemplateVersionsPaginator(client, &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: template.LaunchTemplateId,
+			})
+			for versions.HasMorePages() {
+				versionsPage, err := versions.NextPage(ctx)
+				if err != nil {
+					return refs, err
+				}
+
+				for _, version := range versionsPage.LaunchTemplateVersions {
+					data := version.LaunchTemplateData
+					if data == nil {
+						continue
+					}
+					user := fmt.Sprintf("launch template %s version %d", aws.ToString(template.LaunchTemplateName), aws.ToInt64(version.VersionNumber))
+					if data.ImageId != nil {
+						refs.images[aws.ToString(data.ImageId)] = user
+					}
+					for _, mapping := range data.BlockDeviceMappings {
+						if mapping.Ebs != nil && mapping.Ebs.SnapshotId != nil {
+							refs.snapshots[aws.ToString(mapping.Ebs.SnapshotId)] = user
+						}
+					}
+					for _, groupID := range data.SecurityGroupIds {
+						refs.securityGroups[groupID] = user
+					}
+					for _, networkInterface := range data.NetworkInterfaces {
+						for _, groupID := range networkInterface.Groups {
+							refs.securityGroups[groupID] = user
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return refs, nil
+}
@@ -2,7 +2,9 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
@@ -103,3 +105,68 @@ func TestDeleteLaunchTemplate(t *testing.T) {
 	err := deleteLaunchTemplate(context.Background(), mock, aws.String("test-template"))
 	require.NoError(t, err)
 }
+
+// mockLaunchTemplateReferencesClient returns one page of versions per call, like a template
+// with more versions than fit in a single response.
+type mockLaunchTemplateReferencesClient struct {
+	VersionPages [][]types.LaunchTemplateVersion
+}
+
+func (m *mockLaunchTemplateReferencesClient) DescribeLaunchTemplates(ctx context.Context, params *ec2.DescribeLaunchTemplatesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+	return &ec2.DescribeLaunchTemplatesOutput{
+		LaunchTemplates: []types.LaunchTemplate{{LaunchTemplateId: aws.String("lt-1"), LaunchTemplateName: aws.String("web")}},
+	}, nil
+}
+
+func (m *mockLaunchTemplateReferencesClient) DescribeLaunchTemplateVersions(ctx context.Context, params *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	if len(params.Versions) > 0 {
+		return nil, fmt.Errorf("unexpected version filter %v", params.Versions)
+	}
+
+	page := 0
+	if params.NextToken != nil {
+		page, _ = strconv.Atoi(aws.ToString(params.NextToken))
+	}
+	output := &ec2.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: m.VersionPages[page]}
+	if page+1 < len(m.VersionPages) {
+		output.NextToken = aws.String(strconv.Itoa(page + 1))
+	}
+	return output, nil
+}
+
+func TestLaunchTemplateReferences(t *testing.T) {
+	t.Parallel()
+
+	version := func(number int64, image, snapshot, group string) types.LaunchTemplateVersion {
+		return types.LaunchTemplateVersion{
+			VersionNumber: aws.Int64(number),
+			LaunchTemplateData: &types.ResponseLaunchTemplateData{
+				ImageId: aws.String(image),
+				BlockDeviceMappings: []types.LaunchTemplateBlockDeviceMapping{
+					{Ebs: &types.LaunchTemplateEbsBlockDevice{SnapshotId: aws.String(snapshot)}},
+				},
+				SecurityGroupIds: []string{group},
+			},
+		}
+	}
+	mock := &mockLaunchTemplateReferencesClient{VersionPages: [][]types.LaunchTemplateVersion{
+		{version(3, "ami-latest", "snap-latest", "sg-latest")},
+		// An older version that an Auto Scaling group may be pinned to
+		{version(1, "ami-pinned", "snap-pinned", "sg-pinned")},
+	}}
+
+	refs, err := launchTemplateReferences(context.Background(), mock)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"ami-latest": "launch template web version 3",
+		"ami-pinned": "launch template web version 1",
+	}, refs.images)
+	require.Equal(t, map[string]string{
+		"snap-latest": "launch template web version 3",
+		"snap-pinned": "launch template web version 1",
+	}, refs.snapshots)
+	require.Equal(t, map[string]string{
+		"sg-latest": "launch template web version 3",
+		"sg-pinned": "launch template web version 1",
+	}, refs.securityGroups)
+}
@@ -79,7 +79,7 @@ func listMacieSessions(ctx context.Context, client MacieMemberAPI, scope resourc
 	}
 
 	// Use status as identifier since Macie doesn't have a unique resource ID
-	if cfg.ShouldInclude(config.ResourceValue{Time: output.CreatedAt, Tags: tags}) {
+	if cfg.ShouldInclude(config.ResourceValue{ID: aws.String(string(output.Status)), Time: output.CreatedAt, Tags: tags}) {
 		return []*string{aws.String(string(output.Status))}, nil
 	}
 
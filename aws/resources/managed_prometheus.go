@@ -50,6 +50,7 @@ func listManagedPrometheusWorkspaces(ctx context.Context, client ManagedPromethe
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   workspace.WorkspaceId,
 				Name: workspace.Alias,
 				Time: workspace.CreatedAt,
 				Tags: workspace.Tags,
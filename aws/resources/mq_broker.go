@@ -66,6 +66,7 @@ func listMQBrokers(ctx context.Context, client MQBrokerAPI, scope resource.Scope
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   broker.BrokerId,
 				Name: broker.BrokerName,
 				Time: broker.Created,
 				Tags: tags,
@@ -63,6 +63,7 @@ func shouldIncludeMSKCluster(cluster types.Cluster, cfg config.ResourceType) boo
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   cluster.ClusterArn,
 		Name: cluster.ClusterName,
 		Time: cluster.CreationTime,
 		Tags: cluster.Tags,
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
@@ -21,6 +22,7 @@ type NatGatewaysAPI interface {
 	DeleteNatGateway(ctx context.Context, params *ec2.DeleteNatGatewayInput, optFns ...func(*ec2.Options)) (*ec2.DeleteNatGatewayOutput, error)
 	DescribeNatGateways(ctx context.Context, params *ec2.DescribeNatGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error)
 	DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	DescribeRouteTables(ctx context.Context, params *ec2.DescribeRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
 }
 
 // NewNatGateways creates a new NatGateways resource using the generic resource pattern.
@@ -34,15 +36,26 @@ func NewNatGateways() AwsResource {
 		func(c config.Config) config.EC2ResourceType { return c.NATGateway },
 		listNatGateways,
 		resource.ConcurrentDeleteThenWaitAll(deleteNatGateway, waitForNatGatewaysDeleted),
-		nil,
+		&EC2ResourceOptions[NatGatewaysAPI]{
+			Idle: &IdleMetrics{
+				Source:        dimensionSource("AWS/NATGateway", "NatGatewayId"),
+				DefaultMetric: "BytesOutToDestination",
+				Statistics: map[string]cloudwatchtypes.Statistic{
+					"BytesInFromDestination": cloudwatchtypes.StatisticSum,
+					"BytesInFromSource":      cloudwatchtypes.StatisticSum,
+					"BytesOutToDestination":  cloudwatchtypes.StatisticSum,
+					"BytesOutToSource":       cloudwatchtypes.StatisticSum,
+				},
+			},
+		},
 	)
 }
 
 // listNatGateways retrieves all NAT Gateways that match the config filters.
-func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	// When defaultOnly is true, get the list of default VPC IDs to filter by
+func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
+	// When DefaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		defaultVpcIds = make(map[string]bool)
 		vpcs, err := client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
 			Filters: []types.Filter{
@@ -60,6 +73,14 @@ func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.
 		}
 	}
 
+	var routed map[string]bool
+	if cfg.OrphansOnly() {
+		var err error
+		if routed, err = routedNatGateways(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
 	var allNatGateways []*string
 
 	paginator := ec2.NewDescribeNatGatewaysPaginator(client, &ec2.DescribeNatGatewaysInput{})
@@ -71,12 +92,16 @@ func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.
 		}
 
 		for _, gateway := range page.NatGateways {
-			// When defaultOnly is true, skip NAT gateways not in default VPCs
-			if defaultOnly && !defaultVpcIds[aws.ToString(gateway.VpcId)] {
+			// When DefaultOnly is true, skip NAT gateways not in default VPCs
+			if cfg.DefaultOnly && !defaultVpcIds[aws.ToString(gateway.VpcId)] {
+				continue
+			}
+			if !cfg.InVpc(gateway.VpcId) {
 				continue
 			}
 
-			if shouldIncludeNatGateway(gateway, cfg) {
+			if shouldIncludeNatGateway(gateway, cfg.ResourceType) &&
+				cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(gateway.NatGatewayId), natGatewayOrphanReason(gateway, routed)) {
 				allNatGateways = append(allNatGateways, gateway.NatGatewayId)
 			}
 		}
@@ -91,12 +116,42 @@ func shouldIncludeNatGateway(ngw types.NatGateway, cfg config.ResourceType) bool
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   ngw.NatGatewayId,
 		Time: ngw.CreateTime,
 		Name: getNatGatewayName(ngw),
 		Tags: util.ConvertTypesTagsToMap(ngw.Tags),
 	})
 }
 
+// routedNatGateways returns the IDs of the NAT Gateways that a route table sends traffic to.
+func routedNatGateways(ctx context.Context, client NatGatewaysAPI) (map[string]bool, error) {
+	routed := make(map[string]bool)
+
+	paginator := ec2.NewDescribeRouteTablesPaginator(client, &ec2.DescribeRouteTablesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, routeTable := range page.RouteTables {
+			for _, route := range routeTable.Routes {
+				if route.NatGatewayId != nil {
+					routed[aws.ToString(route.NatGatewayId)] = true
+				}
+			}
+		}
+	}
+	return routed, nil
+}
+
+// natGatewayOrphanReason returns why a NAT Gateway is orphaned, or "" if traffic may be routed to it.
+func natGatewayOrphanReason(ngw types.NatGateway, routed map[string]bool) string {
+	if ngw.State != types.NatGatewayStateAvailable || routed[aws.ToString(ngw.NatGatewayId)] {
+		return ""
+	}
+	return "no route table routes traffic to the NAT gateway"
+}
+
 func getNatGatewayName(ngw types.NatGateway) *string {
 	for _, tag := range ngw.Tags {
 		if aws.ToString(tag.Key) == "Name" {
@@ -20,6 +20,11 @@ type mockNatGatewayClient struct {
 	describeCalls              int
 	DeleteNatGatewayOutput     ec2.DeleteNatGatewayOutput
 	DescribeVpcsOutput         ec2.DescribeVpcsOutput
+	DescribeRouteTablesOutput  ec2.DescribeRouteTablesOutput
+}
+
+func (m *mockNatGatewayClient) DescribeRouteTables(ctx context.Context, params *ec2.DescribeRouteTablesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error) {
+	return &m.DescribeRouteTablesOutput, nil
 }
 
 func (m *mockNatGatewayClient) DescribeNatGateways(ctx context.Context, params *ec2.DescribeNatGatewaysInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error) {
@@ -91,13 +96,41 @@ func TestListNatGateways(t *testing.T) {
 				},
 			}
 
-			result, err := listNatGateways(context.Background(), mock, resource.Scope{}, tc.config, false)
+			result, err := listNatGateways(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.config})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(result))
 		})
 	}
 }
 
+func TestListNatGatewaysOrphansOnly(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockNatGatewayClient{
+		DescribeNatGatewaysOutput: ec2.DescribeNatGatewaysOutput{
+			NatGateways: []types.NatGateway{
+				{NatGatewayId: aws.String("nat-routed"), State: types.NatGatewayStateAvailable},
+				{NatGatewayId: aws.String("nat-unrouted"), State: types.NatGatewayStateAvailable},
+			},
+		},
+		DescribeRouteTablesOutput: ec2.DescribeRouteTablesOutput{
+			RouteTables: []types.RouteTable{{
+				Routes: []types.Route{{NatGatewayId: aws.String("nat-routed")}},
+			}},
+		},
+	}
+
+	findings := config.NewFindings()
+	configObj := config.Config{}
+	configObj.RecordFindings(findings)
+	configObj.OnlyOrphans()
+
+	ids, err := listNatGateways(context.Background(), mock, resource.Scope{Region: "us-east-1"}, configObj.NATGateway)
+	require.NoError(t, err)
+	require.Equal(t, []string{"nat-unrouted"}, aws.ToStringSlice(ids))
+	require.Equal(t, "no route table routes traffic to the NAT gateway", findings.Detail("us-east-1", "nat-unrouted"))
+}
+
 func TestDeleteNatGateway(t *testing.T) {
 	t.Parallel()
 
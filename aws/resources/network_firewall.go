@@ -89,6 +89,7 @@ func shouldIncludeNetworkFirewall(firewall *types.Firewall, firstSeenTime *time.
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   firewall.FirewallName,
 		Name: &identifierName,
 		Tags: tags,
 		Time: firstSeenTime,
@@ -89,6 +89,7 @@ func shouldIncludeNetworkFirewallPolicy(ctx context.Context, client NetworkFirew
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   policy.FirewallPolicyName,
 		Name: getNetworkFirewallPolicyName(tags),
 		Tags: tags,
 		Time: firstSeenTime,
@@ -83,6 +83,7 @@ func listNetworkFirewallRuleGroups(ctx context.Context, client NetworkFirewallRu
 			}
 
 			if !cfg.ShouldInclude(config.ResourceValue{
+				ID:   group.Arn,
 				Name: &identifierName,
 				Tags: tags,
 				Time: firstSeenTime,
@@ -75,6 +75,7 @@ func listNetworkFirewallTLSConfigs(ctx context.Context, client NetworkFirewallTL
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   tlsConfig.Name,
 				Name: &identifierName,
 				Tags: tags,
 				Time: firstSeenTime,
@@ -73,6 +73,7 @@ func listOIDCProviders(ctx context.Context, client OIDCProvidersAPI, _ resource.
 	var result []*string
 	for _, provider := range providers {
 		if cfg.ShouldInclude(config.ResourceValue{
+			ID:   provider.ARN,
 			Name: provider.ProviderURL,
 			Time: provider.CreateTime,
 			Tags: provider.Tags,
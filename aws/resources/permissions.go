@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/resource"
+)
+
+// sdkServicePackagePrefix is the import path prefix of the AWS SDK service packages.
+const sdkServicePackagePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+// iamServicePrefixes maps SDK service package names to IAM service prefixes where the two differ.
+var iamServicePrefixes = map[string]string{
+	"accessanalyzer":         "access-analyzer",
+	"acmpca":                 "acm-pca",
+	"amp":                    "aps",
+	"apigatewayv2":           "apigateway",
+	"cloudwatchlogs":         "logs",
+	"configservice":          "config",
+	"efs":                    "elasticfilesystem",
+	"elasticloadbalancingv2": "elasticloadbalancing",
+	"eventbridge":            "events",
+	"networkfirewall":        "network-firewall",
+	"opensearch":             "es",
+	"s3control":              "s3",
+	"vpclattice":             "vpc-lattice",
+}
+
+// iamActionOverrides maps SDK operations to the IAM actions that authorize them where the names
+// differ. A nil entry means the operation needs no permission.
+var iamActionOverrides = map[string][]string{
+	"s3:DeleteBucketLifecycle": {"s3:PutLifecycleConfiguration"},
+	"s3:DeleteObjects":         {"s3:DeleteObject", "s3:DeleteObjectVersion"},
+	"s3:HeadBucket":            {"s3:ListBucket"},
+	"s3:ListBuckets":           {"s3:ListAllMyBuckets"},
+	"s3:ListObjectVersions":    {"s3:ListBucketVersions"},
+	"s3:ListObjectsV2":         {"s3:ListBucket"},
+	"sts:GetCallerIdentity":    nil,
+}
+
+// listOperationPrefixes identify read-only operations. Together with listTaggingOperations, which
+// listers use to stamp first-seen tags, they make up the permissions needed by inspect.
+var (
+	listOperationPrefixes = []string{"BatchGet", "Describe", "Get", "Head", "List"}
+	listTaggingOperations = []string{"AddTags", "CreateTags", "TagResource"}
+)
+
+// IAMPermissions returns the IAM actions the resource needs: its declared Permissions, or if none are
+// declared, the actions derived from the methods of its client interface. Resource types that support
+// idle rules also need to read CloudWatch metrics.
+func (a *AwsResourceAdapter[C]) IAMPermissions() resource.Permissions {
+	perms := a.Permissions
+	if len(perms.List) == 0 && len(perms.Nuke) == 0 {
+		perms = derivePermissions(reflect.TypeFor[C]())
+	}
+	if a.idleMetrics {
+		perms.List = slices.Concat(perms.List, []string{"cloudwatch:GetMetricData"})
+		slices.Sort(perms.List)
+	}
+	return perms
+}
+
+// derivePermissions maps every AWS SDK operation of a client interface to its IAM actions. Clients
+// that are not interfaces, such as plugin clients, yield no permissions.
+func derivePermissions(client reflect.Type) resource.Permissions {
+	var perms resource.Permissions
+	if client.Kind() != reflect.Interface {
+		return perms
+	}
+
+	for i := 0; i < client.NumMethod(); i++ {
+		method := client.Method(i)
+		if method.Type.NumIn() < 2 {
+			continue
+		}
+		input := method.Type.In(1)
+		if input.Kind() == reflect.Pointer {
+			input = input.Elem()
+		}
+		if !strings.HasPrefix(input.PkgPath(), sdkServicePackagePrefix) {
+			continue
+		}
+
+		actions := iamActions(path.Base(input.PkgPath()), method.Name)
+		if isListOperation(method.Name) {
+			perms.List = append(perms.List, actions...)
+		} else {
+			perms.Nuke = append(perms.Nuke, actions...)
+		}
+	}
+
+	slices.Sort(perms.List)
+	slices.Sort(perms.Nuke)
+	perms.List = slices.Compact(perms.List)
+	perms.Nuke = slices.Compact(perms.Nuke)
+	return perms
+}
+
+// iamActions returns the IAM actions that authorize an operation of an SDK service package.
+func iamActions(service, operation string) []string {
+	prefix := service
+	if p, ok := iamServicePrefixes[service]; ok {
+		prefix = p
+	}
+
+	// API Gateway authorizes by HTTP method on the resource path rather than by operation name.
+	if prefix == "apigateway" {
+		return []string{"apigateway:" + apiGatewayMethod(operation)}
+	}
+
+	action := prefix + ":" + operation
+	if actions, ok := iamActionOverrides[action]; ok {
+		return actions
+	}
+	return []string{action}
+}
+
+// apiGatewayMethod returns the HTTP method behind an API Gateway operation.
+func apiGatewayMethod(operation string) string {
+	for prefix, method := range map[string]string{"Get": "GET", "Delete": "DELETE", "Update": "PATCH", "Put": "PUT"} {
+		if strings.HasPrefix(operation, prefix) {
+			return method
+		}
+	}
+	return "POST"
+}
+
+func isListOperation(operation string) bool {
+	if slices.Contains(listTaggingOperations, operation) {
+		return true
+	}
+	for _, prefix := range listOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
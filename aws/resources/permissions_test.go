@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/plugin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivePermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		resource AwsResource
+		list     []string
+		nuke     []string
+	}{
+		{
+			name:     "operations map to actions",
+			resource: NewDynamoDB(),
+			list:     []string{"dynamodb:DescribeTable", "dynamodb:ListTables", "dynamodb:ListTagsOfResource"},
+			nuke:     []string{"dynamodb:DeleteTable", "dynamodb:UpdateTable"},
+		},
+		{
+			name:     "service prefix and action overrides",
+			resource: NewS3Buckets(),
+			list:     []string{"s3:GetBucketLocation", "s3:GetBucketTagging", "s3:ListAllMyBuckets", "s3:ListBucket", "s3:ListBucketVersions"},
+			nuke:     []string{"s3:DeleteBucket", "s3:DeleteBucketPolicy", "s3:DeleteObject", "s3:DeleteObjectVersion", "s3:PutLifecycleConfiguration"},
+		},
+		{
+			name:     "first-seen tagging is needed to list",
+			resource: NewEC2Subnet(),
+			list:     []string{"ec2:CreateTags", "ec2:DescribeSubnets"},
+			nuke:     []string{"ec2:DeleteSubnet"},
+		},
+		{
+			name:     "idle rules read CloudWatch metrics",
+			resource: NewNatGateways(),
+			list:     []string{"cloudwatch:GetMetricData", "ec2:DescribeNatGateways", "ec2:DescribeRouteTables", "ec2:DescribeVpcs"},
+			nuke:     []string{"ec2:DeleteNatGateway"},
+		},
+		{
+			name:     "API Gateway authorizes by HTTP method",
+			resource: NewApiGateway(),
+			list:     []string{"apigateway:GET"},
+			nuke:     []string{"apigateway:DELETE"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			perms := tc.resource.IAMPermissions()
+			require.Equal(t, tc.list, perms.List)
+			require.Equal(t, tc.nuke, perms.Nuke)
+		})
+	}
+}
+
+func TestIAMPermissions_Declared(t *testing.T) {
+	t.Parallel()
+
+	cc := NewCloudControlResource(config.CloudControlResourceType{Type: "AWS::Glue::Job"}).IAMPermissions()
+	require.Equal(t, []string{"cloudformation:GetResource", "cloudformation:ListResources"}, cc.List)
+
+	declared := newPluginResource(plugin.Info{Name: "p", ListActions: []string{"glue:ListJobs"}, NukeActions: []string{"glue:DeleteJob"}}, nil)
+	require.Equal(t, []string{"glue:ListJobs"}, declared.IAMPermissions().List)
+	require.Equal(t, []string{"glue:DeleteJob"}, declared.IAMPermissions().Nuke)
+
+	undeclared := newPluginResource(plugin.Info{Name: "p"}, nil).IAMPermissions()
+	require.Empty(t, undeclared.List)
+	require.Empty(t, undeclared.Nuke)
+}
+
+func TestDerivePermissions_NotAnInterface(t *testing.T) {
+	t.Parallel()
+	require.Empty(t, derivePermissions(reflect.TypeFor[*pluginClient]()).List)
+}
@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/plugin"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+)
+
+// PluginAPI is the subset of *plugin.Plugin used by plugin resources.
+type PluginAPI interface {
+	List(ctx context.Context, env []string, region string, accountID string, cfg config.ResourceType) ([]plugin.Item, error)
+	Nuke(ctx context.Context, env []string, region string, accountID string, identifiers []string) ([]plugin.Result, error)
+}
+
+// pluginClient pairs a plugin with the AWS config of the region it is running in.
+// region is "global" for global plugins, while cfg.Region is the region used for API calls.
+type pluginClient struct {
+	plugin PluginAPI
+	cfg    aws.Config
+	region string
+}
+
+// NewPluginResource adapts an out-of-process plugin to the AwsResource interface, so that it is
+// filtered, reported and ordered like any built-in resource type.
+func NewPluginResource(p *plugin.Plugin) AwsResource {
+	return newPluginResource(p.Info, p)
+}
+
+func newPluginResource(info plugin.Info, api PluginAPI) AwsResource {
+	global := info.Scope == plugin.ScopeGlobal
+	return NewAwsResource(&resource.Resource[*pluginClient]{
+		ResourceTypeName: info.Name,
+		BatchSize:        info.BatchSize,
+		InitClient: WrapAwsInitClient(func(r *resource.Resource[*pluginClient], cfg aws.Config) {
+			r.Scope.Region = cfg.Region
+			if global {
+				r.Scope.Region = "global"
+			}
+			r.Client = &pluginClient{plugin: api, cfg: cfg, region: r.Scope.Region}
+		}),
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			return c.Plugin(info.Name)
+		},
+		Lister:      listPluginResources,
+		Nuker:       resource.BulkResultDeleter(nukePluginResources),
+		Permissions: resource.Permissions{List: info.ListActions, Nuke: info.NukeActions},
+	})
+}
+
+// env passes the region's resolved credentials to the plugin through the standard AWS
+// environment variables, so plugins work with any credential source cloud-nuke supports.
+func (c *pluginClient) env(ctx context.Context) ([]string, error) {
+	env := []string{
+		"AWS_REGION=" + c.cfg.Region,
+		"AWS_DEFAULT_REGION=" + c.cfg.Region,
+	}
+	if c.cfg.Credentials == nil {
+		return env, nil
+	}
+	creds, err := c.cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving credentials for plugin: %w", err)
+	}
+	env = append(env,
+		"AWS_ACCESS_KEY_ID="+creds.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY="+creds.SecretAccessKey,
+		"AWS_SESSION_TOKEN="+creds.SessionToken,
+	)
+	return env, nil
+}
+
+func listPluginResources(ctx context.Context, client *pluginClient, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
+	env, err := client.env(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, _ := ctx.Value(util.AccountIdKey).(string)
+
+	items, err := client.plugin.List(ctx, env, client.region, accountID, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []*string
+	for _, item := range items {
+		value := config.ResourceValue{ID: aws.String(item.Identifier), Time: item.CreatedAt, Tags: item.Tags}
+		if item.Name != "" {
+			value.Name = aws.String(item.Name)
+		}
+		if cfg.ShouldInclude(value) {
+			ids = append(ids, aws.String(item.Identifier))
+		}
+	}
+	return ids, nil
+}
+
+func nukePluginResources(ctx context.Context, client *pluginClient, ids []string) []resource.NukeResult {
+	results := make([]resource.NukeResult, 0, len(ids))
+	fail := func(err error) []resource.NukeResult {
+		for _, id := range ids {
+			results = append(results, resource.NukeResult{Identifier: id, Error: err})
+		}
+		return results
+	}
+
+	env, err := client.env(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	accountID, _ := ctx.Value(util.AccountIdKey).(string)
+
+	pluginResults, err := client.plugin.Nuke(ctx, env, client.region, accountID, ids)
+	if err != nil {
+		return fail(err)
+	}
+
+	byID := make(map[string]plugin.Result, len(pluginResults))
+	for _, r := range pluginResults {
+		byID[r.Identifier] = r
+	}
+	for _, id := range ids {
+		r, ok := byID[id]
+		switch {
+		case !ok:
+			results = append(results, resource.NukeResult{Identifier: id, Error: fmt.Errorf("plugin returned no result for %s", id)})
+		case r.Error != "":
+			results = append(results, resource.NukeResult{Identifier: id, Error: errors.New(r.Error)})
+		default:
+			results = append(results, resource.NukeResult{Identifier: id})
+		}
+	}
+	return results
+}
@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/plugin"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPlugin struct {
+	items   []plugin.Item
+	results []plugin.Result
+	err     error
+
+	env       []string
+	region    string
+	accountID string
+	deleted   []string
+}
+
+func (m *mockPlugin) List(_ context.Context, env []string, region string, accountID string, _ config.ResourceType) ([]plugin.Item, error) {
+	m.env, m.region, m.accountID = env, region, accountID
+	return m.items, m.err
+}
+
+func (m *mockPlugin) Nuke(_ context.Context, env []string, region string, _ string, identifiers []string) ([]plugin.Result, error) {
+	m.env, m.region = env, region
+	m.deleted = identifiers
+	return m.results, m.err
+}
+
+func TestListPluginResources(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	old := now.Add(-48 * time.Hour)
+	mock := &mockPlugin{items: []plugin.Item{
+		{Identifier: "a", Name: "keep-me", CreatedAt: &old},
+		{Identifier: "b", Name: "skip-me", CreatedAt: &old},
+		{Identifier: "c", Name: "too-new", CreatedAt: &now},
+		{Identifier: "d", Name: "excluded", CreatedAt: &old, Tags: map[string]string{"cloud-nuke-excluded": "true"}},
+	}}
+	client := &pluginClient{
+		plugin: mock,
+		region: "us-east-1",
+		cfg: aws.Config{
+			Region:      "us-east-1",
+			Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		},
+	}
+	excludeAfter := now.Add(-time.Hour)
+	cfg := config.ResourceType{
+		ExcludeRule: config.FilterRule{
+			NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^skip-")}},
+			TimeAfter:   &excludeAfter,
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), util.AccountIdKey, "123456789012")
+	ids, err := listPluginResources(ctx, client, resource.Scope{Region: "us-east-1"}, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, aws.ToStringSlice(ids))
+	require.Equal(t, "us-east-1", mock.region)
+	require.Equal(t, "123456789012", mock.accountID)
+	require.Contains(t, mock.env, "AWS_ACCESS_KEY_ID=AKID")
+	require.Contains(t, mock.env, "AWS_SECRET_ACCESS_KEY=SECRET")
+	require.Contains(t, mock.env, "AWS_REGION=us-east-1")
+}
+
+func TestNukePluginResources(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockPlugin{results: []plugin.Result{
+		{Identifier: "ok"},
+		{Identifier: "bad", Error: "still in use"},
+	}}
+	client := &pluginClient{plugin: mock, region: "global", cfg: aws.Config{Region: "us-east-1"}}
+
+	results := nukePluginResources(context.Background(), client, []string{"ok", "bad", "missing"})
+	require.Equal(t, []string{"ok", "bad", "missing"}, mock.deleted)
+	require.Equal(t, "global", mock.region)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Error)
+	require.EqualError(t, results[1].Error, "still in use")
+	require.ErrorContains(t, results[2].Error, "no result")
+
+	mock.err = errors.New("plugin crashed")
+	results = nukePluginResources(context.Background(), client, []string{"x", "y"})
+	require.Len(t, results, 2)
+	for _, r := range results {
+		require.EqualError(t, r.Error, "plugin crashed")
+	}
+}
+
+func TestNewPluginResource(t *testing.T) {
+	t.Parallel()
+
+	r := newPluginResource(plugin.Info{Name: "test-widget", Scope: plugin.ScopeGlobal, BatchSize: 3}, &mockPlugin{})
+	r.Init(aws.Config{Region: "us-east-1"})
+	require.Equal(t, "test-widget", r.ResourceName())
+	require.Equal(t, 3, r.MaxBatchSize())
+
+	timeout := config.ResourceType{Timeout: "1m"}
+	cfg := config.Config{Plugins: map[string]*config.ResourceType{"test-widget": &timeout}}
+	require.Equal(t, "1m", r.GetAndSetResourceConfig(cfg).Timeout)
+}
@@ -2,10 +2,13 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/resource"
@@ -18,27 +21,41 @@ type DBInstancesAPI interface {
 	DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
 	ModifyDBInstance(ctx context.Context, params *rds.ModifyDBInstanceInput, optFns ...func(*rds.Options)) (*rds.ModifyDBInstanceOutput, error)
 	DeleteDBInstance(ctx context.Context, params *rds.DeleteDBInstanceInput, optFns ...func(*rds.Options)) (*rds.DeleteDBInstanceOutput, error)
+	StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error)
+	StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error)
+	AddTagsToResource(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(ctx context.Context, params *rds.RemoveTagsFromResourceInput, optFns ...func(*rds.Options)) (*rds.RemoveTagsFromResourceOutput, error)
 }
 
 // NewDBInstances creates a new DBInstances resource using the generic resource pattern.
 func NewDBInstances() AwsResource {
-	return NewAwsResource(&resource.Resource[DBInstancesAPI]{
-		ResourceTypeName: "rds-instance",
-		BatchSize:        DefaultBatchSize,
-		InitClient: WrapAwsInitClient(func(r *resource.Resource[DBInstancesAPI], cfg aws.Config) {
+	return NewEC2AwsResource[DBInstancesAPI](
+		"rds-instance",
+		WrapAwsInitClient(func(r *resource.Resource[DBInstancesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DBInstances.ResourceType
+		func(c config.Config) config.EC2ResourceType {
+			return config.EC2ResourceType{VpcResourceFilters: c.DBInstances.VpcResourceFilters, ResourceType: c.DBInstances.ResourceType}
 		},
-		Lister: listDBInstances,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteDBInstance, waitForDBInstancesDeleted),
-	})
+		listDBInstances,
+		resource.SequentialDeleteThenWaitAll(deleteDBInstance, waitForDBInstancesDeleted),
+		&EC2ResourceOptions[DBInstancesAPI]{
+			Idle: &IdleMetrics{
+				Source:        dimensionSource("AWS/RDS", "DBInstanceIdentifier"),
+				DefaultMetric: "CPUUtilization",
+				Statistics: map[string]cloudwatchtypes.Statistic{
+					"DatabaseConnections": cloudwatchtypes.StatisticMaximum,
+				},
+			},
+			Suspender: resource.SequentialActor("Stopping", stopDBInstance),
+			Resumer:   resource.SequentialActor("Starting", startDBInstance),
+		},
+	)
 }
 
 // listDBInstances retrieves all RDS DB instances that match the config filters.
-func listDBInstances(ctx context.Context, client DBInstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listDBInstances(ctx context.Context, client DBInstancesAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
 	var names []*string
 
 	paginator := rds.NewDescribeDBInstancesPaginator(client, &rds.DescribeDBInstancesInput{})
@@ -49,6 +66,14 @@ func listDBInstances(ctx context.Context, client DBInstancesAPI, scope resource.
 		}
 
 		for _, db := range page.DBInstances {
+			var vpcID *string
+			if db.DBSubnetGroup != nil {
+				vpcID = db.DBSubnetGroup.VpcId
+			}
+			if !cfg.InVpc(vpcID) {
+				continue
+			}
+
 			if cfg.ShouldInclude(config.ResourceValue{
 				Time: db.InstanceCreateTime,
 				Name: db.DBInstanceIdentifier,
@@ -109,3 +134,64 @@ func waitForDBInstancesDeleted(ctx context.Context, client DBInstancesAPI, ids [
 	}
 	return nil
 }
+
+// describeDBInstance returns a single RDS DB instance.
+func describeDBInstance(ctx context.Context, client DBInstancesAPI, name *string) (types.DBInstance, error) {
+	output, err := client.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{DBInstanceIdentifier: name})
+	if err != nil {
+		return types.DBInstance{}, errors.WithStackTrace(err)
+	}
+	if len(output.DBInstances) == 0 {
+		return types.DBInstance{}, fmt.Errorf("DB instance %s not found", aws.ToString(name))
+	}
+	return output.DBInstances[0], nil
+}
+
+// stopDBInstance stops an available RDS DB instance and tags it so that it can be started again.
+// AWS starts stopped instances again automatically after seven days. Aurora cluster members cannot
+// be stopped on their own; the db-cluster resource type stops their cluster.
+func stopDBInstance(ctx context.Context, client DBInstancesAPI, name *string) (string, error) {
+	db, err := describeDBInstance(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+	if db.DBClusterIdentifier != nil {
+		return "", util.SkippedResourceError{Reason: fmt.Sprintf("managed by cluster %s", aws.ToString(db.DBClusterIdentifier))}
+	}
+	if status := aws.ToString(db.DBInstanceStatus); status != "available" {
+		return "", util.SkippedResourceError{Reason: fmt.Sprintf("instance is not available (%s)", status)}
+	}
+
+	if _, err := client.StopDBInstance(ctx, &rds.StopDBInstanceInput{DBInstanceIdentifier: name}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.AddTagsToResource(ctx, &rds.AddTagsToResourceInput{
+		ResourceName: db.DBInstanceArn,
+		Tags:         []types.Tag{{Key: aws.String(suspendedTagKey), Value: aws.String("available")}},
+	}); err != nil {
+		return "", untaggedSuspendError("stopped", err)
+	}
+	return "stopped", nil
+}
+
+// startDBInstance starts an RDS DB instance stopped by stopDBInstance and removes its tag.
+func startDBInstance(ctx context.Context, client DBInstancesAPI, name *string) (string, error) {
+	db, err := describeDBInstance(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := util.ConvertRDSTypeTagsToMap(db.TagList)[suspendedTagKey]; !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+
+	if _, err := client.StartDBInstance(ctx, &rds.StartDBInstanceInput{DBInstanceIdentifier: name}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.RemoveTagsFromResource(ctx, &rds.RemoveTagsFromResourceInput{
+		ResourceName: db.DBInstanceArn,
+		TagKeys:      []string{suspendedTagKey},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return "started", nil
+}
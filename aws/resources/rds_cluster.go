@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/resource"
@@ -18,6 +20,10 @@ type DBClustersAPI interface {
 	DeleteDBCluster(ctx context.Context, params *rds.DeleteDBClusterInput, optFns ...func(*rds.Options)) (*rds.DeleteDBClusterOutput, error)
 	DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
 	ModifyDBCluster(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error)
+	StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error)
+	StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error)
+	AddTagsToResource(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(ctx context.Context, params *rds.RemoveTagsFromResourceInput, optFns ...func(*rds.Options)) (*rds.RemoveTagsFromResourceOutput, error)
 }
 
 // NewDBClusters creates a new RDS DB Clusters resource using the generic resource pattern.
@@ -32,8 +38,10 @@ func NewDBClusters() AwsResource {
 		ConfigGetter: func(c config.Config) config.ResourceType {
 			return c.DBClusters.ResourceType
 		},
-		Lister: listDBClusters,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteDBCluster, waitForDBClustersDeleted),
+		Lister:    listDBClusters,
+		Nuker:     resource.SequentialDeleteThenWaitAll(deleteDBCluster, waitForDBClustersDeleted),
+		Suspender: resource.SequentialActor("Stopping", stopDBCluster),
+		Resumer:   resource.SequentialActor("Starting", startDBCluster),
 	})
 }
 
@@ -91,3 +99,60 @@ func waitForDBClustersDeleted(ctx context.Context, client DBClustersAPI, ids []s
 	}
 	return nil
 }
+
+// describeDBCluster returns a single RDS DB cluster.
+func describeDBCluster(ctx context.Context, client DBClustersAPI, name *string) (types.DBCluster, error) {
+	output, err := client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{DBClusterIdentifier: name})
+	if err != nil {
+		return types.DBCluster{}, errors.WithStackTrace(err)
+	}
+	if len(output.DBClusters) == 0 {
+		return types.DBCluster{}, fmt.Errorf("DB cluster %s not found", aws.ToString(name))
+	}
+	return output.DBClusters[0], nil
+}
+
+// stopDBCluster stops an available RDS DB cluster and tags it so that it can be started again.
+// AWS starts stopped clusters again automatically after seven days.
+func stopDBCluster(ctx context.Context, client DBClustersAPI, name *string) (string, error) {
+	cluster, err := describeDBCluster(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+	if status := aws.ToString(cluster.Status); status != "available" {
+		return "", util.SkippedResourceError{Reason: fmt.Sprintf("cluster is not available (%s)", status)}
+	}
+
+	if _, err := client.StopDBCluster(ctx, &rds.StopDBClusterInput{DBClusterIdentifier: name}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.AddTagsToResource(ctx, &rds.AddTagsToResourceInput{
+		ResourceName: cluster.DBClusterArn,
+		Tags:         []types.Tag{{Key: aws.String(suspendedTagKey), Value: aws.String("available")}},
+	}); err != nil {
+		return "", untaggedSuspendError("stopped", err)
+	}
+	return "stopped", nil
+}
+
+// startDBCluster starts an RDS DB cluster stopped by stopDBCluster and removes its tag.
+func startDBCluster(ctx context.Context, client DBClustersAPI, name *string) (string, error) {
+	cluster, err := describeDBCluster(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := util.ConvertRDSTypeTagsToMap(cluster.TagList)[suspendedTagKey]; !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+
+	if _, err := client.StartDBCluster(ctx, &rds.StartDBClusterInput{DBClusterIdentifier: name}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.RemoveTagsFromResource(ctx, &rds.RemoveTagsFromResourceInput{
+		ResourceName: cluster.DBClusterArn,
+		TagKeys:      []string{suspendedTagKey},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return "started", nil
+}
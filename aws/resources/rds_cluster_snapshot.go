@@ -19,6 +19,7 @@ type RdsClusterSnapshotAPI interface {
 
 // NewRdsClusterSnapshot creates a new RDS Cluster Snapshot resource using the generic resource pattern.
 func NewRdsClusterSnapshot() AwsResource {
+	var retain *config.RetainRule
 	return NewAwsResource(&resource.Resource[RdsClusterSnapshotAPI]{
 		ResourceTypeName: "rds-cluster-snapshot",
 		BatchSize:        DefaultBatchSize,
@@ -27,16 +28,20 @@ func NewRdsClusterSnapshot() AwsResource {
 			r.Client = rds.NewFromConfig(cfg)
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RDSClusterSnapshot
+			retain = c.RDSClusterSnapshot.Retain
+			return c.RDSClusterSnapshot.ResourceType
 		},
-		Lister: listRdsClusterSnapshots,
-		Nuker:  resource.SimpleBatchDeleter(deleteRdsClusterSnapshot),
+		Lister: func(ctx context.Context, client RdsClusterSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			return listRdsClusterSnapshots(ctx, client, scope, cfg, retain)
+		},
+		Nuker: resource.SimpleBatchDeleter(deleteRdsClusterSnapshot),
 	})
 }
 
-// listRdsClusterSnapshots retrieves all RDS cluster snapshots that match the config filters.
-func listRdsClusterSnapshots(ctx context.Context, client RdsClusterSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+// listRdsClusterSnapshots retrieves all RDS cluster snapshots that match the config filters, except those the
+// retain rule keeps.
+func listRdsClusterSnapshots(ctx context.Context, client RdsClusterSnapshotAPI, scope resource.Scope, cfg config.ResourceType, retain *config.RetainRule) ([]*string, error) {
+	var values []config.ResourceValue
 
 	paginator := rds.NewDescribeDBClusterSnapshotsPaginator(client, &rds.DescribeDBClusterSnapshotsInput{})
 
@@ -56,13 +61,18 @@ func listRdsClusterSnapshots(ctx context.Context, client RdsClusterSnapshotAPI,
 			if status != "available" && status != "failed" {
 				continue
 			}
-			if cfg.ShouldInclude(config.ResourceValue{
+			values = append(values, config.ResourceValue{
 				Name: s.DBClusterSnapshotIdentifier,
 				Time: s.SnapshotCreateTime,
 				Tags: util.ConvertRDSTypeTagsToMap(s.TagList),
-			}) {
-				identifiers = append(identifiers, s.DBClusterSnapshotIdentifier)
-			}
+			})
+		}
+	}
+
+	var identifiers []*string
+	for _, value := range retain.Surplus(cfg, values) {
+		if cfg.ShouldInclude(value) {
+			identifiers = append(identifiers, value.Name)
 		}
 	}
 
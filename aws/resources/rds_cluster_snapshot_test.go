@@ -81,7 +81,7 @@ func TestListRdsClusterSnapshots(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listRdsClusterSnapshots(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listRdsClusterSnapshots(context.Background(), mock, resource.Scope{}, tc.configObj, nil)
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -117,7 +117,7 @@ func TestListRdsClusterSnapshots_SkipsAutomated(t *testing.T) {
 		},
 	}
 
-	names, err := listRdsClusterSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listRdsClusterSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, []string{"manual-snap", "backup-snap"}, aws.ToStringSlice(names))
 }
@@ -136,7 +136,7 @@ func TestListRdsClusterSnapshots_SkipsNonDeletableStates(t *testing.T) {
 		},
 	}
 
-	names, err := listRdsClusterSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listRdsClusterSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, []string{"available-snap", "failed-snap"}, aws.ToStringSlice(names))
 }
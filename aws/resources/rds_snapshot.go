@@ -19,6 +19,7 @@ type RdsSnapshotAPI interface {
 
 // NewRdsSnapshot creates a new RDS Snapshot resource using the generic resource pattern.
 func NewRdsSnapshot() AwsResource {
+	var retain *config.RetainRule
 	return NewAwsResource(&resource.Resource[RdsSnapshotAPI]{
 		ResourceTypeName: "rds-snapshot",
 		BatchSize:        DefaultBatchSize,
@@ -27,16 +28,20 @@ func NewRdsSnapshot() AwsResource {
 			r.Client = rds.NewFromConfig(cfg)
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RDSSnapshot
+			retain = c.RDSSnapshot.Retain
+			return c.RDSSnapshot.ResourceType
 		},
-		Lister: listRdsSnapshots,
-		Nuker:  resource.SimpleBatchDeleter(deleteRdsSnapshot),
+		Lister: func(ctx context.Context, client RdsSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			return listRdsSnapshots(ctx, client, scope, cfg, retain)
+		},
+		Nuker: resource.SimpleBatchDeleter(deleteRdsSnapshot),
 	})
 }
 
-// listRdsSnapshots retrieves all RDS snapshots that match the config filters.
-func listRdsSnapshots(ctx context.Context, client RdsSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+// listRdsSnapshots retrieves all RDS snapshots that match the config filters, except those the
+// retain rule keeps.
+func listRdsSnapshots(ctx context.Context, client RdsSnapshotAPI, scope resource.Scope, cfg config.ResourceType, retain *config.RetainRule) ([]*string, error) {
+	var values []config.ResourceValue
 
 	paginator := rds.NewDescribeDBSnapshotsPaginator(client, &rds.DescribeDBSnapshotsInput{})
 
@@ -56,13 +61,18 @@ func listRdsSnapshots(ctx context.Context, client RdsSnapshotAPI, scope resource
 			if status != "available" && status != "failed" {
 				continue
 			}
-			if cfg.ShouldInclude(config.ResourceValue{
+			values = append(values, config.ResourceValue{
 				Name: s.DBSnapshotIdentifier,
 				Time: s.SnapshotCreateTime,
 				Tags: util.ConvertRDSTypeTagsToMap(s.TagList),
-			}) {
-				identifiers = append(identifiers, s.DBSnapshotIdentifier)
-			}
+			})
+		}
+	}
+
+	var identifiers []*string
+	for _, value := range retain.Surplus(cfg, values) {
+		if cfg.ShouldInclude(value) {
+			identifiers = append(identifiers, value.Name)
 		}
 	}
 
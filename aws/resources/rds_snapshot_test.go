@@ -81,7 +81,7 @@ func TestListRdsSnapshots(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, tc.configObj, nil)
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -117,7 +117,7 @@ func TestListRdsSnapshots_SkipsAutomated(t *testing.T) {
 		},
 	}
 
-	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, []string{"manual-snap", "backup-snap"}, aws.ToStringSlice(names))
 }
@@ -136,7 +136,7 @@ func TestListRdsSnapshots_SkipsNonDeletableStates(t *testing.T) {
 		},
 	}
 
-	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{})
+	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, []string{"available-snap", "failed-snap"}, aws.ToStringSlice(names))
 }
@@ -148,3 +148,40 @@ func TestDeleteRdsSnapshot(t *testing.T) {
 	err := deleteRdsSnapshot(context.Background(), mock, aws.String("test-snapshot"))
 	require.NoError(t, err)
 }
+
+func TestListRdsSnapshots_Retain(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	snapshot := func(name string, age time.Duration) types.DBSnapshot {
+		return types.DBSnapshot{
+			DBSnapshotIdentifier: aws.String(name),
+			SnapshotCreateTime:   aws.Time(now.Add(-age)),
+			SnapshotType:         aws.String("manual"),
+			Status:               aws.String("available"),
+		}
+	}
+	mock := &mockRdsSnapshotClient{
+		DescribeDBSnapshotsOutput: rds.DescribeDBSnapshotsOutput{
+			DBSnapshots: []types.DBSnapshot{
+				snapshot("orders-1", 3*time.Hour),
+				snapshot("orders-3", 1*time.Hour),
+				snapshot("orders-2", 2*time.Hour),
+				snapshot("users-1", 2*time.Hour),
+				snapshot("adhoc", 5*time.Hour),
+			},
+		},
+	}
+
+	retain := &config.RetainRule{Keep: 2, NameRegex: &config.Expression{RE: *regexp.MustCompile(`^(\w+)-\d+$`)}}
+	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{}, retain)
+	require.NoError(t, err)
+	require.Equal(t, []string{"orders-1"}, aws.ToStringSlice(names))
+
+	// Retention ranks all snapshots before the other filters apply
+	names, err = listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{
+		ExcludeRule: config.FilterRule{NamesRegExp: []config.Expression{{RE: *regexp.MustCompile(`^orders-1$`)}}},
+	}, retain)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,7 +103,7 @@ func TestDBInstances_GetAll(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listDBInstances(context.Background(), mock, resource.Scope{}, tc.configObj)
+			names, err := listDBInstances(context.Background(), mock, resource.Scope{}, config.EC2ResourceType{ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(names))
 		})
@@ -149,3 +151,57 @@ func TestDBInstances_Delete_ClusterMember(t *testing.T) {
 	require.Equal(t, 0, mock.ModifyCallCount, "ModifyDBInstance should NOT be called for cluster members")
 	require.Equal(t, 1, mock.DeleteCallCount, "DeleteDBInstance should be called")
 }
+
+// mockedStoppableDBInstances records the stop and tag calls of the suspend action.
+type mockedStoppableDBInstances struct {
+	mockedDBInstances
+	StopError error
+	StopCalls int
+	TagCalls  int
+}
+
+func (m *mockedStoppableDBInstances) StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	m.StopCalls++
+	if m.StopError != nil {
+		return nil, m.StopError
+	}
+	return &rds.StopDBInstanceOutput{}, nil
+}
+
+func (m *mockedStoppableDBInstances) AddTagsToResource(ctx context.Context, params *rds.AddTagsToResourceInput, optFns ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error) {
+	m.TagCalls++
+	return &rds.AddTagsToResourceOutput{}, nil
+}
+
+func TestStopDBInstance(t *testing.T) {
+	t.Parallel()
+
+	instance := func(clusterID *string) mockedDBInstances {
+		return mockedDBInstances{DescribeDBInstancesOutput: rds.DescribeDBInstancesOutput{
+			DBInstances: []types.DBInstance{{
+				DBInstanceIdentifier: aws.String("db-1"),
+				DBInstanceStatus:     aws.String("available"),
+				DBClusterIdentifier:  clusterID,
+			}},
+		}}
+	}
+
+	mock := &mockedStoppableDBInstances{mockedDBInstances: instance(nil)}
+	detail, err := stopDBInstance(context.Background(), mock, aws.String("db-1"))
+	require.NoError(t, err)
+	require.Equal(t, "stopped", detail)
+	require.Equal(t, 1, mock.StopCalls)
+	require.Equal(t, 1, mock.TagCalls)
+
+	// Aurora cluster members are stopped with their cluster
+	mock = &mockedStoppableDBInstances{mockedDBInstances: instance(aws.String("aurora"))}
+	_, err = stopDBInstance(context.Background(), mock, aws.String("db-1"))
+	require.Equal(t, util.SkippedResourceError{Reason: "managed by cluster aurora"}, err)
+	require.Zero(t, mock.StopCalls)
+
+	// A failed stop leaves no tag behind for resume to act on
+	mock = &mockedStoppableDBInstances{mockedDBInstances: instance(nil), StopError: errors.New("InvalidDBInstanceState")}
+	_, err = stopDBInstance(context.Background(), mock, aws.String("db-1"))
+	require.Error(t, err)
+	require.Zero(t, mock.TagCalls)
+}
@@ -2,10 +2,13 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/cloud-nuke/util"
@@ -16,6 +19,10 @@ import (
 type RedshiftClustersAPI interface {
 	DescribeClusters(ctx context.Context, params *redshift.DescribeClustersInput, optFns ...func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
 	DeleteCluster(ctx context.Context, params *redshift.DeleteClusterInput, optFns ...func(*redshift.Options)) (*redshift.DeleteClusterOutput, error)
+	PauseCluster(ctx context.Context, params *redshift.PauseClusterInput, optFns ...func(*redshift.Options)) (*redshift.PauseClusterOutput, error)
+	ResumeCluster(ctx context.Context, params *redshift.ResumeClusterInput, optFns ...func(*redshift.Options)) (*redshift.ResumeClusterOutput, error)
+	CreateTags(ctx context.Context, params *redshift.CreateTagsInput, optFns ...func(*redshift.Options)) (*redshift.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, params *redshift.DeleteTagsInput, optFns ...func(*redshift.Options)) (*redshift.DeleteTagsOutput, error)
 }
 
 // NewRedshiftClusters creates a new RedshiftClusters resource using the generic resource pattern.
@@ -30,8 +37,10 @@ func NewRedshiftClusters() AwsResource {
 		ConfigGetter: func(c config.Config) config.ResourceType {
 			return c.Redshift
 		},
-		Lister: listRedshiftClusters,
-		Nuker:  resource.SequentialDeleter(resource.DeleteThenWait(deleteRedshiftCluster, waitForRedshiftClusterDeleted)),
+		Lister:    listRedshiftClusters,
+		Nuker:     resource.SequentialDeleter(resource.DeleteThenWait(deleteRedshiftCluster, waitForRedshiftClusterDeleted)),
+		Suspender: resource.SequentialActor("Pausing", pauseRedshiftCluster),
+		Resumer:   resource.SequentialActor("Resuming", resumeRedshiftCluster),
 	})
 }
 
@@ -76,3 +85,66 @@ func waitForRedshiftClusterDeleted(ctx context.Context, client RedshiftClustersA
 		ClusterIdentifier: id,
 	}, 5*time.Minute)
 }
+
+// describeRedshiftCluster returns a single Redshift cluster.
+func describeRedshiftCluster(ctx context.Context, client RedshiftClustersAPI, id *string) (types.Cluster, error) {
+	output, err := client.DescribeClusters(ctx, &redshift.DescribeClustersInput{ClusterIdentifier: id})
+	if err != nil {
+		return types.Cluster{}, errors.WithStackTrace(err)
+	}
+	if len(output.Clusters) == 0 {
+		return types.Cluster{}, fmt.Errorf("redshift cluster %s not found", aws.ToString(id))
+	}
+	return output.Clusters[0], nil
+}
+
+// redshiftClusterArn returns the ARN of a cluster, which DescribeClusters does not return, from the
+// partition, region and account of its namespace ARN.
+func redshiftClusterArn(cluster types.Cluster) *string {
+	prefix, _, _ := strings.Cut(aws.ToString(cluster.ClusterNamespaceArn), ":namespace:")
+	return aws.String(fmt.Sprintf("%s:cluster:%s", prefix, aws.ToString(cluster.ClusterIdentifier)))
+}
+
+// pauseRedshiftCluster pauses an available Redshift cluster and tags it so that it can be resumed.
+func pauseRedshiftCluster(ctx context.Context, client RedshiftClustersAPI, id *string) (string, error) {
+	cluster, err := describeRedshiftCluster(ctx, client, id)
+	if err != nil {
+		return "", err
+	}
+	if status := aws.ToString(cluster.ClusterStatus); status != "available" {
+		return "", util.SkippedResourceError{Reason: fmt.Sprintf("cluster is not available (%s)", status)}
+	}
+
+	if _, err := client.PauseCluster(ctx, &redshift.PauseClusterInput{ClusterIdentifier: id}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.CreateTags(ctx, &redshift.CreateTagsInput{
+		ResourceName: redshiftClusterArn(cluster),
+		Tags:         []types.Tag{{Key: aws.String(suspendedTagKey), Value: aws.String("available")}},
+	}); err != nil {
+		return "", untaggedSuspendError("paused", err)
+	}
+	return "paused", nil
+}
+
+// resumeRedshiftCluster resumes a Redshift cluster paused by pauseRedshiftCluster and removes its tag.
+func resumeRedshiftCluster(ctx context.Context, client RedshiftClustersAPI, id *string) (string, error) {
+	cluster, err := describeRedshiftCluster(ctx, client, id)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := util.ConvertRedshiftTagsToMap(cluster.Tags)[suspendedTagKey]; !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+
+	if _, err := client.ResumeCluster(ctx, &redshift.ResumeClusterInput{ClusterIdentifier: id}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.DeleteTags(ctx, &redshift.DeleteTagsInput{
+		ResourceName: redshiftClusterArn(cluster),
+		TagKeys:      []string{suspendedTagKey},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return "resumed", nil
+}
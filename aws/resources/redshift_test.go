@@ -16,6 +16,7 @@ import (
 )
 
 type mockedRedshiftClient struct {
+	RedshiftClustersAPI
 	DescribeClustersOutput redshift.DescribeClustersOutput
 	DescribeClustersError  error
 	DeleteClusterOutput    redshift.DeleteClusterOutput
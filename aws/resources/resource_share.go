@@ -48,6 +48,7 @@ func listResourceShares(ctx context.Context, client RAMResourceShareAPI, _ resou
 				continue
 			}
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   resourceShare.ResourceShareArn,
 				Name: resourceShare.Name,
 				Time: resourceShare.CreationTime,
 				Tags: convertRAMTagsToMap(resourceShare.Tags),
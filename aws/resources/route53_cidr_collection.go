@@ -50,6 +50,7 @@ func listRoute53CidrCollections(ctx context.Context, client Route53CidrCollectio
 
 		for _, collection := range page.CidrCollections {
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   collection.Id,
 				Name: collection.Name,
 			}) {
 				identifiers = append(identifiers, collection.Id)
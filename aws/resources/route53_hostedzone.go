@@ -88,13 +88,14 @@ func listRoute53HostedZones(ctx context.Context, client Route53HostedZoneAPI, sc
 			zoneId := strings.TrimPrefix(aws.ToString(zone.Id), "/hostedzone/")
 			tags := util.ConvertRoute53TagsToMap(tagsByZoneId[zoneId])
 
+			// Encode both zone ID and domain name in the identifier
+			identifier := aws.String(fmt.Sprintf("%s|%s", aws.ToString(zone.Id), aws.ToString(zone.Name)))
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   identifier,
 				Name: zone.Name,
 				Tags: tags,
 			}) {
-				// Encode both zone ID and domain name in the identifier
-				identifier := fmt.Sprintf("%s|%s", aws.ToString(zone.Id), aws.ToString(zone.Name))
-				identifiers = append(identifiers, aws.String(identifier))
+				identifiers = append(identifiers, identifier)
 			}
 		}
 	}
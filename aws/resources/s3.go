@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/cloud-nuke/util"
@@ -31,6 +32,12 @@ const (
 	s3BucketWaitDuration = 100 * time.Second
 )
 
+// s3BucketMetadataAPI defines the S3 operations that read a bucket's region and tags.
+type s3BucketMetadataAPI interface {
+	GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+	GetBucketTagging(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
+}
+
 // S3API defines the interface for S3 operations.
 type S3API interface {
 	GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
@@ -62,7 +69,9 @@ func NewS3Buckets() AwsResource {
 		BatchSize:        500,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[S3API], cfg aws.Config) {
 			r.Scope.Region = "global"
-			r.Client = s3.NewFromConfig(cfg)
+			r.Client = s3.NewFromConfig(cfg, func(o *s3.Options) {
+				o.UsePathStyle = externalcreds.UsePathStyle()
+			})
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
 			return c.S3
@@ -190,7 +199,7 @@ func getBucketInfo(ctx context.Context, client S3API, scope resource.Scope, buck
 }
 
 // getBucketRegion returns the region for an S3 bucket.
-func getBucketRegion(ctx context.Context, client S3API, bucketName string) (string, error) {
+func getBucketRegion(ctx context.Context, client s3BucketMetadataAPI, bucketName string) (string, error) {
 	result, err := client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
 		Bucket: aws.String(bucketName),
 	})
@@ -207,7 +216,7 @@ func getBucketRegion(ctx context.Context, client S3API, bucketName string) (stri
 }
 
 // getBucketTags returns the tags for an S3 bucket.
-func getBucketTags(ctx context.Context, client S3API, bucketName string, opts ...func(*s3.Options)) (map[string]string, error) {
+func getBucketTags(ctx context.Context, client s3BucketMetadataAPI, bucketName string, opts ...func(*s3.Options)) (map[string]string, error) {
 	result, err := client.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{
 		Bucket: aws.String(bucketName),
 	}, opts...)
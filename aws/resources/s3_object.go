@@ -0,0 +1,294 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxDeleteObjects is the most keys DeleteObjects accepts per call.
+	maxDeleteObjects = 1000
+
+	// s3ObjectDeleteConcurrency is the most DeleteObjects calls in flight per bucket.
+	s3ObjectDeleteConcurrency = 8
+)
+
+// S3ObjectsAPI defines the interface for S3 object operations.
+type S3ObjectsAPI interface {
+	GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error)
+	GetBucketTagging(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
+	ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+	ListObjectVersions(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+}
+
+// NewS3Objects creates a new S3 object resource using the generic resource pattern. Its identifiers
+// are the buckets holding matching objects; the buckets themselves are never deleted.
+func NewS3Objects() AwsResource {
+	var objectCfg config.S3ObjectResourceType
+	return NewAwsResource(&resource.Resource[S3ObjectsAPI]{
+		ResourceTypeName: "s3-object",
+		BatchSize:        50,
+		InitClient: WrapAwsInitClient(func(r *resource.Resource[S3ObjectsAPI], cfg aws.Config) {
+			r.Scope.Region = "global"
+			r.Client = s3.NewFromConfig(cfg, func(o *s3.Options) {
+				o.UsePathStyle = externalcreds.UsePathStyle()
+			})
+		}),
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			objectCfg = c.S3Object
+			return c.S3Object.ResourceType
+		},
+		Lister: func(ctx context.Context, client S3ObjectsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			objectCfg.ResourceType = cfg
+			return listS3Objects(ctx, client, scope, objectCfg)
+		},
+		Nuker: resource.BulkResultDeleter(func(ctx context.Context, client S3ObjectsAPI, ids []string) []resource.NukeResult {
+			return deleteS3Objects(ctx, client, objectCfg, ids)
+		}),
+	})
+}
+
+// s3ObjectMatches are the object versions and delete markers of a bucket that match the config.
+type s3ObjectMatches struct {
+	objects []types.ObjectIdentifier
+	sizes   []int64
+	bytes   int64
+}
+
+// listS3Objects retrieves the buckets that match the config filters and hold objects matching its
+// prefixes, age and size, recording the number and size of those objects as findings.
+func listS3Objects(ctx context.Context, client S3ObjectsAPI, scope resource.Scope, cfg config.S3ObjectResourceType) ([]*string, error) {
+	output, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	bucketFilter := cfg.BucketFilter()
+	var names []*string
+	for _, bucket := range output.Buckets {
+		name := aws.ToString(bucket.Name)
+
+		region, err := getBucketRegion(ctx, client, name)
+		if err != nil {
+			logging.Debugf("Skipping bucket %s: %v", name, err)
+			continue
+		}
+		tags, err := getBucketTags(ctx, client, name, s3RegionOption(region))
+		if err != nil {
+			logging.Debugf("Skipping bucket %s: %v", name, err)
+			continue
+		}
+		if !bucketFilter.ShouldInclude(config.ResourceValue{Name: &name, Tags: tags}) {
+			continue
+		}
+
+		matches, err := matchS3Objects(ctx, client, cfg, name, region)
+		if err != nil {
+			logging.Debugf("Skipping bucket %s: %v", name, err)
+			continue
+		}
+		if len(matches.objects) == 0 {
+			continue
+		}
+
+		cfg.AddFinding(scope.Region, name, fmt.Sprintf("%d objects, %s%s", len(matches.objects), formatBytes(matches.bytes), describePrefixes(cfg.Prefixes)))
+		names = append(names, aws.String(name))
+	}
+
+	return names, nil
+}
+
+// matchS3Objects scans the object versions and delete markers under the configured prefixes of a
+// bucket and returns those that match the config.
+func matchS3Objects(ctx context.Context, client S3ObjectsAPI, cfg config.S3ObjectResourceType, bucketName string, region string) (*s3ObjectMatches, error) {
+	prefixes := cfg.Prefixes
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+
+	matches := &s3ObjectMatches{}
+	// Overlapping prefixes list the same versions more than once
+	seen := map[string]bool{}
+	add := func(key, versionId *string, size int64) {
+		id := aws.ToString(key) + "\x00" + aws.ToString(versionId)
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		matches.objects = append(matches.objects, types.ObjectIdentifier{Key: key, VersionId: versionId})
+		matches.sizes = append(matches.sizes, size)
+		matches.bytes += size
+	}
+
+	// Delete markers are matched once every version of their key is known, since the versions of a
+	// key may span pages
+	versions := map[string][]s3ObjectVersion{}
+	var markers []types.DeleteMarkerEntry
+	for _, prefix := range prefixes {
+		paginator := s3.NewListObjectVersionsPaginator(client, &s3.ListObjectVersionsInput{
+			Bucket: aws.String(bucketName),
+			Prefix: aws.String(prefix),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx, s3RegionOption(region))
+			if err != nil {
+				return nil, errors.WithStackTrace(err)
+			}
+
+			for _, version := range page.Versions {
+				size := aws.ToInt64(version.Size)
+				selected := cfg.ShouldIncludeObject(version.LastModified, &size)
+				if selected {
+					add(version.Key, version.VersionId, size)
+				}
+				key := aws.ToString(version.Key)
+				versions[key] = append(versions[key], s3ObjectVersion{lastModified: version.LastModified, selected: selected})
+			}
+			markers = append(markers, page.DeleteMarkers...)
+		}
+	}
+
+	for _, marker := range markers {
+		if marker.LastModified == nil || !cfg.ShouldIncludeObject(marker.LastModified, nil) {
+			continue
+		}
+		if !hiddenVersionSelected(versions[aws.ToString(marker.Key)], *marker.LastModified) {
+			logging.Debugf("[Skip] Delete marker %s of %s hides a version that is not selected", aws.ToString(marker.VersionId), aws.ToString(marker.Key))
+			continue
+		}
+		add(marker.Key, marker.VersionId, 0)
+	}
+
+	return matches, nil
+}
+
+// s3ObjectVersion is an object version listed by matchS3Objects, and whether it was selected.
+type s3ObjectVersion struct {
+	lastModified *time.Time
+	selected     bool
+}
+
+// hiddenVersionSelected reports whether the version that a delete marker created at markedAt hides,
+// the newest version of its key not newer than the marker, is selected too, or whether it hides none.
+// Deleting a marker whose version is kept would restore an object that was deleted.
+func hiddenVersionSelected(versions []s3ObjectVersion, markedAt time.Time) bool {
+	var hidden *s3ObjectVersion
+	for i, version := range versions {
+		if version.lastModified == nil || version.lastModified.After(markedAt) {
+			continue
+		}
+		if hidden == nil || version.lastModified.After(*hidden.lastModified) {
+			hidden = &versions[i]
+		}
+	}
+	return hidden == nil || hidden.selected
+}
+
+// deleteS3Objects deletes the matching object versions and delete markers of each bucket, issuing
+// concurrent DeleteObjects calls of up to 1,000 keys, and reports the objects deleted and bytes freed.
+func deleteS3Objects(ctx context.Context, client S3ObjectsAPI, cfg config.S3ObjectResourceType, identifiers []string) []resource.NukeResult {
+	results := make([]resource.NukeResult, 0, len(identifiers))
+	for _, name := range identifiers {
+		results = append(results, deleteBucketObjects(ctx, client, cfg, name))
+	}
+	return results
+}
+
+// deleteBucketObjects deletes the matching objects of a single bucket.
+func deleteBucketObjects(ctx context.Context, client S3ObjectsAPI, cfg config.S3ObjectResourceType, bucketName string) resource.NukeResult {
+	result := resource.NukeResult{Identifier: bucketName}
+
+	region, err := getBucketRegion(ctx, client, bucketName)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+	matches, err := matchS3Objects(ctx, client, cfg, bucketName, region)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	var (
+		mu       sync.Mutex
+		deleted  int
+		freed    int64
+		failures []string
+	)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(s3ObjectDeleteConcurrency)
+	for start := 0; start < len(matches.objects); start += maxDeleteObjects {
+		end := min(start+maxDeleteObjects, len(matches.objects))
+		objects, sizes := matches.objects[start:end], matches.sizes[start:end]
+
+		group.Go(func() error {
+			output, err := client.DeleteObjects(groupCtx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(bucketName),
+				Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+			}, s3RegionOption(region))
+			if err != nil {
+				return errors.WithStackTrace(err)
+			}
+
+			// Quiet mode only reports the keys that failed
+			failed := map[string]bool{}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, e := range output.Errors {
+				failed[aws.ToString(e.Key)+"\x00"+aws.ToString(e.VersionId)] = true
+				failures = append(failures, fmt.Sprintf("%s: %s", aws.ToString(e.Key), aws.ToString(e.Message)))
+			}
+			for i, object := range objects {
+				if !failed[aws.ToString(object.Key)+"\x00"+aws.ToString(object.VersionId)] {
+					deleted++
+					freed += sizes[i]
+				}
+			}
+			return nil
+		})
+	}
+	result.Error = group.Wait()
+
+	result.Detail = fmt.Sprintf("deleted %d objects, freed %s", deleted, formatBytes(freed))
+	if result.Error == nil && len(failures) > 0 {
+		result.Error = fmt.Errorf("failed to delete %d of %d objects, first: %s", len(failures), len(matches.objects), failures[0])
+	}
+	logging.Debugf("Bucket %s: %s", bucketName, result.Detail)
+	return result
+}
+
+// describePrefixes describes where matching objects were found, for findings.
+func describePrefixes(prefixes []string) string {
+	if len(prefixes) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" under %s", strings.Join(prefixes, ", "))
+}
+
+// formatBytes formats a byte count with a binary unit, e.g. "1.5 GiB".
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
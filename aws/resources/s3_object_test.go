@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/require"
+)
+
+type mockS3ObjectsClient struct {
+	S3ObjectsAPI
+	Buckets       []string
+	Versions      map[string][]types.ObjectVersion
+	DeleteMarkers map[string][]types.DeleteMarkerEntry
+	FailKey       string
+
+	mu            sync.Mutex
+	DeleteBatches [][]types.ObjectIdentifier
+}
+
+func (m *mockS3ObjectsClient) ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	output := &s3.ListBucketsOutput{}
+	for _, name := range m.Buckets {
+		output.Buckets = append(output.Buckets, types.Bucket{Name: aws.String(name)})
+	}
+	return output, nil
+}
+
+func (m *mockS3ObjectsClient) GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	return &s3.GetBucketLocationOutput{}, nil
+}
+
+func (m *mockS3ObjectsClient) GetBucketTagging(ctx context.Context, params *s3.GetBucketTaggingInput, optFns ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	return &s3.GetBucketTaggingOutput{}, nil
+}
+
+func (m *mockS3ObjectsClient) ListObjectVersions(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	bucket, prefix := aws.ToString(params.Bucket), aws.ToString(params.Prefix)
+	output := &s3.ListObjectVersionsOutput{}
+	for _, version := range m.Versions[bucket] {
+		if strings.HasPrefix(aws.ToString(version.Key), prefix) {
+			output.Versions = append(output.Versions, version)
+		}
+	}
+	for _, marker := range m.DeleteMarkers[bucket] {
+		if strings.HasPrefix(aws.ToString(marker.Key), prefix) {
+			output.DeleteMarkers = append(output.DeleteMarkers, marker)
+		}
+	}
+	return output, nil
+}
+
+func (m *mockS3ObjectsClient) DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.DeleteBatches = append(m.DeleteBatches, params.Delete.Objects)
+
+	output := &s3.DeleteObjectsOutput{}
+	for _, object := range params.Delete.Objects {
+		if aws.ToString(object.Key) == m.FailKey {
+			output.Errors = append(output.Errors, types.Error{Key: object.Key, VersionId: object.VersionId, Message: aws.String("Access Denied")})
+		}
+	}
+	return output, nil
+}
+
+func TestListS3Objects(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	version := func(key string, age time.Duration, size int64) types.ObjectVersion {
+		return types.ObjectVersion{Key: aws.String(key), VersionId: aws.String(key + "-v1"), LastModified: aws.Time(now.Add(-age)), Size: aws.Int64(size)}
+	}
+	mock := &mockS3ObjectsClient{
+		Buckets: []string{"logs", "assets"},
+		Versions: map[string][]types.ObjectVersion{
+			"logs": {
+				version("tmp/a.log", 48*time.Hour, 100),
+				version("tmp/b.log", 1*time.Hour, 2048),
+				version("keep/c.log", 48*time.Hour, 100),
+			},
+			"assets": {version("img/logo.png", 48*time.Hour, 4096)},
+		},
+		DeleteMarkers: map[string][]types.DeleteMarkerEntry{
+			"logs": {{Key: aws.String("tmp/d.log"), VersionId: aws.String("m1"), LastModified: aws.Time(now.Add(-48 * time.Hour))}},
+		},
+	}
+
+	tests := map[string]struct {
+		cfg      config.S3ObjectResourceType
+		expected []string
+	}{
+		"emptyFilter": {
+			expected: []string{"logs", "assets"},
+		},
+		"bucketNameFilter": {
+			cfg: config.S3ObjectResourceType{ResourceType: config.ResourceType{
+				IncludeRule: config.FilterRule{NamesRegExp: []config.Expression{{RE: *regexp.MustCompile("^logs$")}}},
+			}},
+			expected: []string{"logs"},
+		},
+		"prefix": {
+			cfg:      config.S3ObjectResourceType{Prefixes: []string{"tmp/"}},
+			expected: []string{"logs"},
+		},
+		"sizeRange": {
+			cfg:      config.S3ObjectResourceType{MinSize: 1024, MaxSize: 2048},
+			expected: []string{"logs"},
+		},
+		"noMatches": {
+			cfg:      config.S3ObjectResourceType{Prefixes: []string{"missing/"}},
+			expected: []string{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			names, err := listS3Objects(context.Background(), mock, resource.Scope{Region: "global"}, tc.cfg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+		})
+	}
+}
+
+func TestMatchS3Objects(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	mock := &mockS3ObjectsClient{
+		Versions: map[string][]types.ObjectVersion{"logs": {
+			{Key: aws.String("tmp/a.log"), VersionId: aws.String("v1"), LastModified: aws.Time(now.Add(-48 * time.Hour)), Size: aws.Int64(100)},
+			{Key: aws.String("tmp/a.log"), VersionId: aws.String("v2"), LastModified: aws.Time(now.Add(-1 * time.Hour)), Size: aws.Int64(200)},
+			{Key: aws.String("tmp/sub/b.log"), VersionId: aws.String("v1"), LastModified: aws.Time(now.Add(-48 * time.Hour)), Size: aws.Int64(300)},
+		}},
+		DeleteMarkers: map[string][]types.DeleteMarkerEntry{"logs": {
+			{Key: aws.String("tmp/c.log"), VersionId: aws.String("m1"), LastModified: aws.Time(now.Add(-48 * time.Hour))},
+		}},
+	}
+
+	// The overlapping prefixes must not match tmp/sub/b.log twice
+	cfg := config.S3ObjectResourceType{Prefixes: []string{"tmp/", "tmp/sub/"}, ModifiedBefore: 24 * time.Hour}
+	matches, err := matchS3Objects(context.Background(), mock, cfg, "logs", "us-east-1")
+	require.NoError(t, err)
+	require.Equal(t, []types.ObjectIdentifier{
+		{Key: aws.String("tmp/a.log"), VersionId: aws.String("v1")},
+		{Key: aws.String("tmp/sub/b.log"), VersionId: aws.String("v1")},
+		{Key: aws.String("tmp/c.log"), VersionId: aws.String("m1")},
+	}, matches.objects)
+	require.Equal(t, int64(400), matches.bytes)
+}
+
+func TestMatchS3ObjectsDeleteMarkers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	mock := &mockS3ObjectsClient{
+		Versions: map[string][]types.ObjectVersion{"logs": {
+			{Key: aws.String("big.log"), VersionId: aws.String("v1"), LastModified: aws.Time(now.Add(-72 * time.Hour)), Size: aws.Int64(4096)},
+			{Key: aws.String("small.log"), VersionId: aws.String("v1"), LastModified: aws.Time(now.Add(-72 * time.Hour)), Size: aws.Int64(10)},
+		}},
+		DeleteMarkers: map[string][]types.DeleteMarkerEntry{"logs": {
+			{Key: aws.String("big.log"), VersionId: aws.String("m1"), LastModified: aws.Time(now.Add(-48 * time.Hour))},
+			{Key: aws.String("small.log"), VersionId: aws.String("m1"), LastModified: aws.Time(now.Add(-48 * time.Hour))},
+			{Key: aws.String("gone.log"), VersionId: aws.String("m1"), LastModified: aws.Time(now.Add(-48 * time.Hour))},
+		}},
+	}
+
+	// Deleting the marker on big.log would restore a version the size rule keeps
+	cfg := config.S3ObjectResourceType{MaxSize: 1024}
+	matches, err := matchS3Objects(context.Background(), mock, cfg, "logs", "us-east-1")
+	require.NoError(t, err)
+	require.Equal(t, []types.ObjectIdentifier{
+		{Key: aws.String("small.log"), VersionId: aws.String("v1")},
+		{Key: aws.String("small.log"), VersionId: aws.String("m1")},
+		{Key: aws.String("gone.log"), VersionId: aws.String("m1")},
+	}, matches.objects)
+}
+
+func TestDeleteS3Objects(t *testing.T) {
+	t.Parallel()
+
+	var versions []types.ObjectVersion
+	for i := 0; i < maxDeleteObjects*2+1; i++ {
+		versions = append(versions, types.ObjectVersion{
+			Key:          aws.String(fmt.Sprintf("tmp/%d", i)),
+			VersionId:    aws.String("v1"),
+			LastModified: aws.Time(time.Now().Add(-48 * time.Hour)),
+			Size:         aws.Int64(1024),
+		})
+	}
+	mock := &mockS3ObjectsClient{
+		Versions: map[string][]types.ObjectVersion{"logs": versions},
+		FailKey:  "tmp/7",
+	}
+
+	results := deleteS3Objects(context.Background(), mock, config.S3ObjectResourceType{}, []string{"logs"})
+	require.Len(t, results, 1)
+	require.Len(t, mock.DeleteBatches, 3)
+	for _, batch := range mock.DeleteBatches {
+		require.LessOrEqual(t, len(batch), maxDeleteObjects)
+	}
+	require.ErrorContains(t, results[0].Error, "failed to delete 1 of 2001 objects, first: tmp/7: Access Denied")
+	require.Equal(t, "deleted 2000 objects, freed 2.0 MiB", results[0].Detail)
+}
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "512 B", formatBytes(512))
+	require.Equal(t, "1.5 KiB", formatBytes(1536))
+	require.Equal(t, "3.0 GiB", formatBytes(3<<30))
+}
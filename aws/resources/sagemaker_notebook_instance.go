@@ -2,14 +2,17 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/errors"
 )
 
 const (
@@ -26,6 +29,9 @@ type SageMakerNotebookInstancesAPI interface {
 	StopNotebookInstance(ctx context.Context, params *sagemaker.StopNotebookInstanceInput, optFns ...func(*sagemaker.Options)) (*sagemaker.StopNotebookInstanceOutput, error)
 	DeleteNotebookInstance(ctx context.Context, params *sagemaker.DeleteNotebookInstanceInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DeleteNotebookInstanceOutput, error)
 	DescribeNotebookInstance(ctx context.Context, params *sagemaker.DescribeNotebookInstanceInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DescribeNotebookInstanceOutput, error)
+	StartNotebookInstance(ctx context.Context, params *sagemaker.StartNotebookInstanceInput, optFns ...func(*sagemaker.Options)) (*sagemaker.StartNotebookInstanceOutput, error)
+	AddTags(ctx context.Context, params *sagemaker.AddTagsInput, optFns ...func(*sagemaker.Options)) (*sagemaker.AddTagsOutput, error)
+	DeleteTags(ctx context.Context, params *sagemaker.DeleteTagsInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DeleteTagsOutput, error)
 }
 
 // NewSageMakerNotebookInstances creates a new SageMaker Notebook Instances resource
@@ -48,6 +54,8 @@ func NewSageMakerNotebookInstances() AwsResource {
 			deleteNotebookInstance,
 			waitNotebookInstanceDeleted,
 		),
+		Suspender: resource.SequentialActor("Stopping", suspendNotebookInstance),
+		Resumer:   resource.SequentialActor("Starting", resumeNotebookInstance),
 	})
 }
 
@@ -121,3 +129,59 @@ func waitNotebookInstanceDeleted(ctx context.Context, client SageMakerNotebookIn
 		NotebookInstanceName: name,
 	}, notebookDeleteWaitDuration)
 }
+
+// suspendNotebookInstance stops an in-service SageMaker notebook instance and tags it so that it can
+// be started again.
+func suspendNotebookInstance(ctx context.Context, client SageMakerNotebookInstancesAPI, name *string) (string, error) {
+	notebook, err := client.DescribeNotebookInstance(ctx, &sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: name,
+	})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if notebook.NotebookInstanceStatus != types.NotebookInstanceStatusInService {
+		return "", util.SkippedResourceError{Reason: fmt.Sprintf("notebook is not in service (%s)", notebook.NotebookInstanceStatus)}
+	}
+
+	if _, err := client.AddTags(ctx, &sagemaker.AddTagsInput{
+		ResourceArn: notebook.NotebookInstanceArn,
+		Tags:        []types.Tag{{Key: aws.String(suspendedTagKey), Value: aws.String(string(types.NotebookInstanceStatusInService))}},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if err := stopNotebookInstance(ctx, client, name); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return "stopped", nil
+}
+
+// resumeNotebookInstance starts a SageMaker notebook instance stopped by suspendNotebookInstance and
+// removes its tag.
+func resumeNotebookInstance(ctx context.Context, client SageMakerNotebookInstancesAPI, name *string) (string, error) {
+	notebook, err := client.DescribeNotebookInstance(ctx, &sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: name,
+	})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	tags, err := client.ListTags(ctx, &sagemaker.ListTagsInput{ResourceArn: notebook.NotebookInstanceArn})
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, ok := util.ConvertSageMakerTagsToMap(tags.Tags)[suspendedTagKey]; !ok {
+		return "", util.SkippedResourceError{Reason: notSuspendedReason}
+	}
+
+	if _, err := client.StartNotebookInstance(ctx, &sagemaker.StartNotebookInstanceInput{
+		NotebookInstanceName: name,
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	if _, err := client.DeleteTags(ctx, &sagemaker.DeleteTagsInput{
+		ResourceArn: notebook.NotebookInstanceArn,
+		TagKeys:     []string{suspendedTagKey},
+	}); err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return "started", nil
+}
@@ -15,6 +15,7 @@ import (
 )
 
 type mockSageMakerNotebookClient struct {
+	SageMakerNotebookInstancesAPI
 	ListNotebookInstancesOutput    sagemaker.ListNotebookInstancesOutput
 	StopNotebookInstanceOutput     sagemaker.StopNotebookInstanceOutput
 	DeleteNotebookInstanceOutput   sagemaker.DeleteNotebookInstanceOutput
@@ -96,6 +96,7 @@ func listSageMakerDomains(ctx context.Context, client SageMakerStudioAPI, scope
 			}
 
 			if !cfg.ShouldInclude(config.ResourceValue{
+				ID:   domain.DomainId,
 				Name: domain.DomainName,
 				Time: domain.CreationTime,
 				Tags: tagMap,
@@ -78,6 +78,7 @@ func shouldIncludeSecret(secret *types.SecretListEntry, cfg config.ResourceType)
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   secret.ARN,
 		Time: &referenceTime,
 		Name: secret.Name,
 		Tags: util.ConvertSecretsManagerTagsToMap(secret.Tags),
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,12 +26,15 @@ type SecurityGroupAPI interface {
 	TerminateInstances(ctx context.Context, input *ec2.TerminateInstancesInput, optFns ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
 	DescribeAddresses(ctx context.Context, input *ec2.DescribeAddressesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAddressesOutput, error)
 	ReleaseAddress(ctx context.Context, params *ec2.ReleaseAddressInput, optFns ...func(*ec2.Options)) (*ec2.ReleaseAddressOutput, error)
+	DescribeNetworkInterfaces(ctx context.Context, params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	DescribeLaunchTemplates(ctx context.Context, params *ec2.DescribeLaunchTemplatesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error)
+	DescribeLaunchTemplateVersions(ctx context.Context, params *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error)
 }
 
 // securityGroupResource holds extra state needed for Security Group operations.
 type securityGroupResource struct {
 	*resource.Resource[SecurityGroupAPI]
-	nukeOnlyDefault bool
+	ec2Cfg config.EC2ResourceType
 }
 
 // NewSecurityGroup creates a new SecurityGroup resource using the generic resource pattern.
@@ -48,17 +52,18 @@ func NewSecurityGroup() AwsResource {
 	})
 
 	r.ConfigGetter = func(c config.Config) config.ResourceType {
-		// Store the DefaultOnly flag for use in lister and nuker
-		r.nukeOnlyDefault = c.SecurityGroup.DefaultOnly
+		// Store the DefaultOnly flag and VPC IDs for use in lister and nuker
+		r.ec2Cfg = c.SecurityGroup
 		return c.SecurityGroup.ResourceType
 	}
 
 	r.Lister = func(ctx context.Context, client SecurityGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-		return listSecurityGroups(ctx, client, cfg, r.nukeOnlyDefault)
+		r.ec2Cfg.ResourceType = cfg
+		return listSecurityGroups(ctx, client, scope, r.ec2Cfg)
 	}
 
 	r.Nuker = func(ctx context.Context, client SecurityGroupAPI, scope resource.Scope, resourceType string, identifiers []*string) []resource.NukeResult {
-		return nukeSecurityGroups(ctx, client, scope, resourceType, identifiers, r.nukeOnlyDefault)
+		return nukeSecurityGroups(ctx, client, scope, resourceType, identifiers, r.ec2Cfg.DefaultOnly)
 	}
 
 	r.PermissionVerifier = verifySecurityGroupNukePermission
@@ -68,12 +73,20 @@ func NewSecurityGroup() AwsResource {
 
 // listSecurityGroups returns security group IDs that match the filter criteria.
 // Uses pagination to handle large numbers of security groups.
-func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, scope resource.Scope, cfg config.EC2ResourceType) ([]*string, error) {
+	var inUse map[string]string
+	if cfg.OrphansOnly() {
+		var err error
+		if inUse, err = securityGroupsInUse(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
 	var identifiers []*string
 
 	// Build filters - for default-only mode, filter to just default security groups
 	var filters []types.Filter
-	if defaultOnly {
+	if cfg.DefaultOnly {
 		logging.Debugf("[default only] Retrieving the default security-groups")
 		filters = []types.Filter{
 			{
@@ -95,13 +108,18 @@ func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, cfg config
 		}
 
 		for _, group := range page.SecurityGroups {
+			if !cfg.InVpc(group.VpcId) {
+				continue
+			}
+
 			firstSeenTime, err := util.GetOrCreateFirstSeen(ctx, client, group.GroupId, util.ConvertTypesTagsToMap(group.Tags))
 			if err != nil {
 				logging.Error("unable to retrieve first seen tag")
 				return nil, cerrors.WithStackTrace(err)
 			}
 
-			if shouldIncludeSecurityGroup(group, firstSeenTime, cfg, defaultOnly) {
+			if shouldIncludeSecurityGroup(group, firstSeenTime, cfg.ResourceType, cfg.DefaultOnly) &&
+				cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(group.GroupId), securityGroupOrphanReason(group, inUse)) {
 				identifiers = append(identifiers, group.GroupId)
 			}
 		}
@@ -121,12 +139,68 @@ func shouldIncludeSecurityGroup(sg types.SecurityGroup, firstSeenTime *time.Time
 	}
 
 	return cfg.ShouldInclude(config.ResourceValue{
+		ID:   sg.GroupId,
 		Name: groupName,
 		Tags: util.ConvertTypesTagsToMap(sg.Tags),
 		Time: firstSeenTime,
 	})
 }
 
+// securityGroupsInUse maps the IDs of the security groups that a network interface or launch template
+// uses, or that another security group's rules refer to, to what uses them.
+func securityGroupsInUse(ctx context.Context, client SecurityGroupAPI) (map[string]string, error) {
+	inUse := make(map[string]string)
+
+	interfaces := ec2.NewDescribeNetworkInterfacesPaginator(client, &ec2.DescribeNetworkInterfacesInput{})
+	for interfaces.HasMorePages() {
+		page, err := interfaces.NextPage(ctx)
+		if err != nil {
+			return nil, cerrors.WithStackTrace(err)
+		}
+		for _, networkInterface := range page.NetworkInterfaces {
+			for _, group := range networkInterface.Groups {
+				inUse[aws.ToString(group.GroupId)] = "network interface " + aws.ToString(networkInterface.NetworkInterfaceId)
+			}
+		}
+	}
+
+	groups := ec2.NewDescribeSecurityGroupsPaginator(client, &ec2.DescribeSecurityGroupsInput{})
+	for groups.HasMorePages() {
+		page, err := groups.NextPage(ctx)
+		if err != nil {
+			return nil, cerrors.WithStackTrace(err)
+		}
+		for _, group := range page.SecurityGroups {
+			for _, permission := range slices.Concat(group.IpPermissions, group.IpPermissionsEgress) {
+				for _, pair := range permission.UserIdGroupPairs {
+					// A group referring to itself does not keep it in use
+					if referenced := aws.ToString(pair.GroupId); referenced != aws.ToString(group.GroupId) {
+						inUse[referenced] = "security group " + aws.ToString(group.GroupId)
+					}
+				}
+			}
+		}
+	}
+
+	refs, err := launchTemplateReferences(ctx, client)
+	if err != nil {
+		return nil, cerrors.WithStackTrace(err)
+	}
+	for groupID, user := range refs.securityGroups {
+		inUse[groupID] = user
+	}
+	return inUse, nil
+}
+
+// securityGroupOrphanReason returns why a security group is orphaned, or "" if it is in use.
+func securityGroupOrphanReason(group types.SecurityGroup, inUse map[string]string) string {
+	if user, ok := inUse[aws.ToString(group.GroupId)]; ok {
+		logging.Debugf("Security group %s is used by %s", aws.ToString(group.GroupId), user)
+		return ""
+	}
+	return "security group is not used by any network interface, launch template or security group rule"
+}
+
 // verifySecurityGroupNukePermission performs a dry-run delete to check permissions.
 func verifySecurityGroupNukePermission(ctx context.Context, client SecurityGroupAPI, id *string) error {
 	_, err := client.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
@@ -26,6 +26,24 @@ type mockedSecurityGroup struct {
 	RevokeSecurityGroupEgressOutput  ec2.RevokeSecurityGroupEgressOutput
 	RevokeSecurityGroupIngressOutput ec2.RevokeSecurityGroupIngressOutput
 	TerminateInstancesOutput         ec2.TerminateInstancesOutput
+	DescribeNetworkInterfacesOutput  ec2.DescribeNetworkInterfacesOutput
+	LaunchTemplateVersions           []types.LaunchTemplateVersion
+}
+
+func (m mockedSecurityGroup) DescribeLaunchTemplates(ctx context.Context, params *ec2.DescribeLaunchTemplatesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+	output := &ec2.DescribeLaunchTemplatesOutput{}
+	if len(m.LaunchTemplateVersions) > 0 {
+		output.LaunchTemplates = []types.LaunchTemplate{{LaunchTemplateId: aws.String("lt-1"), LaunchTemplateName: aws.String("web")}}
+	}
+	return output, nil
+}
+
+func (m mockedSecurityGroup) DescribeLaunchTemplateVersions(ctx context.Context, params *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	return &ec2.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: m.LaunchTemplateVersions}, nil
+}
+
+func (m mockedSecurityGroup) DescribeNetworkInterfaces(ctx context.Context, params *ec2.DescribeNetworkInterfacesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	return &m.DescribeNetworkInterfacesOutput, nil
 }
 
 func (m mockedSecurityGroup) DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
@@ -124,7 +142,7 @@ func TestSecurityGroup_List(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ids, err := listSecurityGroups(ctx, mockClient, tc.configObj, tc.defaultOnly)
+			ids, err := listSecurityGroups(ctx, mockClient, resource.Scope{Region: "us-east-1"}, config.EC2ResourceType{DefaultOnly: tc.defaultOnly, ResourceType: tc.configObj})
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
 		})
@@ -159,11 +177,70 @@ func TestSecurityGroup_List_SkipsDefault(t *testing.T) {
 	ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
 
 	// Without defaultOnly, should skip "default" security group
-	ids, err := listSecurityGroups(ctx, mockClient, config.ResourceType{}, false)
+	ids, err := listSecurityGroups(ctx, mockClient, resource.Scope{Region: "us-east-1"}, config.EC2ResourceType{})
 	require.NoError(t, err)
 	require.Equal(t, []string{"sg-custom"}, aws.ToStringSlice(ids))
 }
 
+func TestSecurityGroup_List_OrphansOnly(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	group := func(id string, referenced ...string) types.SecurityGroup {
+		sg := types.SecurityGroup{
+			GroupId:   aws.String(id),
+			GroupName: aws.String(id),
+			Tags: []types.Tag{
+				{Key: aws.String(util.FirstSeenTagKey), Value: aws.String(util.FormatTimestamp(now))},
+			},
+		}
+		for _, ref := range referenced {
+			sg.IpPermissions = append(sg.IpPermissions, types.IpPermission{
+				UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String(ref)}},
+			})
+		}
+		return sg
+	}
+	mockClient := mockedSecurityGroup{
+		DescribeSecurityGroupsOutput: ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []types.SecurityGroup{
+				group("sg-attached", "sg-referenced"),
+				group("sg-referenced"),
+				group("sg-self", "sg-self"),
+				group("sg-launch-template"),
+				group("sg-launch-template-eni"),
+			},
+		},
+		// An older version that is neither default nor latest still keeps its groups in use
+		LaunchTemplateVersions: []types.LaunchTemplateVersion{{
+			VersionNumber: aws.Int64(1),
+			LaunchTemplateData: &types.ResponseLaunchTemplateData{
+				SecurityGroupIds: []string{"sg-launch-template"},
+				NetworkInterfaces: []types.LaunchTemplateInstanceNetworkInterfaceSpecification{
+					{Groups: []string{"sg-launch-template-eni"}},
+				},
+			},
+		}},
+		DescribeNetworkInterfacesOutput: ec2.DescribeNetworkInterfacesOutput{
+			NetworkInterfaces: []types.NetworkInterface{{
+				NetworkInterfaceId: aws.String("eni-1"),
+				Groups:             []types.GroupIdentifier{{GroupId: aws.String("sg-attached")}},
+			}},
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
+	findings := config.NewFindings()
+	configObj := config.Config{}
+	configObj.RecordFindings(findings)
+	configObj.OnlyOrphans()
+
+	ids, err := listSecurityGroups(ctx, mockClient, resource.Scope{Region: "us-east-1"}, configObj.SecurityGroup)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sg-self"}, aws.ToStringSlice(ids))
+	require.Equal(t, "security group is not used by any network interface, launch template or security group rule", findings.Detail("us-east-1", "sg-self"))
+}
+
 func TestSecurityGroup_Nuke(t *testing.T) {
 	t.Parallel()
 
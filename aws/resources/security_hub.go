@@ -87,7 +87,7 @@ func shouldIncludeSecurityHub(hub *securityhub.DescribeHubOutput, tags map[strin
 		return false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{Time: subscribedAt, Tags: tags})
+	return cfg.ShouldInclude(config.ResourceValue{ID: hub.HubArn, Time: subscribedAt, Tags: tags})
 }
 
 // removeSecurityHubMembers removes all member accounts from Security Hub.
@@ -20,10 +20,12 @@ type SnapshotsAPI interface {
 	DeregisterImage(ctx context.Context, params *ec2.DeregisterImageInput, optFns ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
 	DescribeImages(ctx context.Context, params *ec2.DescribeImagesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
 	DescribeSnapshots(ctx context.Context, params *ec2.DescribeSnapshotsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error)
+	LaunchTemplateReferencesAPI
 }
 
 // NewSnapshots creates a new Snapshots resource using the generic resource pattern.
 func NewSnapshots() AwsResource {
+	var retain *config.RetainRule
 	return NewAwsResource(&resource.Resource[SnapshotsAPI]{
 		ResourceTypeName: "ebs-snapshot",
 		BatchSize:        DefaultBatchSize,
@@ -32,10 +34,13 @@ func NewSnapshots() AwsResource {
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
 		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Snapshots
+			retain = c.Snapshots.Retain
+			return c.Snapshots.ResourceType
 		},
-		Lister: listSnapshots,
-		Nuker:  resource.MultiStepDeleter(deregisterSnapshotAMIs, deleteSnapshot),
+		Lister: func(ctx context.Context, client SnapshotsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+			return listSnapshots(ctx, client, scope, cfg, retain)
+		},
+		Nuker: resource.MultiStepDeleter(deregisterSnapshotAMIs, deleteSnapshot),
 		PermissionVerifier: func(ctx context.Context, client SnapshotsAPI, id *string) error {
 			_, err := client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
 				SnapshotId: id,
@@ -46,14 +51,24 @@ func NewSnapshots() AwsResource {
 	})
 }
 
-// listSnapshots retrieves all EBS Snapshots that match the config filters.
-func listSnapshots(ctx context.Context, client SnapshotsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+// listSnapshots retrieves all EBS Snapshots that match the config filters, except those the retain
+// rule keeps.
+func listSnapshots(ctx context.Context, client SnapshotsAPI, scope resource.Scope, cfg config.ResourceType, retain *config.RetainRule) ([]*string, error) {
 	// status - The status of the snapshot (pending | completed | error).
 	// We only want to list EBS Snapshots with a status of "completed" or "error"
 	// since those are the only statuses eligible for deletion.
 	statusFilter := types.Filter{Name: aws.String("status"), Values: []string{"completed", "error"}}
 
-	var snapshotIds []*string
+	var inUse map[string]bool
+	if cfg.OrphansOnly() {
+		var err error
+		if inUse, err = snapshotsInUse(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshots := map[string]types.Snapshot{}
+	var values []config.ResourceValue
 	paginator := ec2.NewDescribeSnapshotsPaginator(client, &ec2.DescribeSnapshotsInput{
 		OwnerIds: []string{"self"},
 		Filters:  []types.Filter{statusFilter},
@@ -66,18 +81,68 @@ func listSnapshots(ctx context.Context, client SnapshotsAPI, scope resource.Scop
 		}
 
 		for _, snapshot := range page.Snapshots {
-			if cfg.ShouldInclude(config.ResourceValue{
+			if snapshotHasAWSBackupTag(snapshot.Tags) {
+				continue
+			}
+			snapshots[aws.ToString(snapshot.SnapshotId)] = snapshot
+			values = append(values, config.ResourceValue{
+				ID:   snapshot.SnapshotId,
 				Time: snapshot.StartTime,
 				Tags: util.ConvertTypesTagsToMap(snapshot.Tags),
-			}) && !snapshotHasAWSBackupTag(snapshot.Tags) {
-				snapshotIds = append(snapshotIds, snapshot.SnapshotId)
-			}
+			})
+		}
+	}
+
+	var snapshotIds []*string
+	for _, value := range retain.Surplus(cfg, values) {
+		snapshot := snapshots[aws.ToString(value.ID)]
+		if cfg.ShouldInclude(value) &&
+			cfg.ShouldIncludeOrphan(scope.Region, aws.ToString(snapshot.SnapshotId), snapshotOrphanReason(snapshot, inUse)) {
+			snapshotIds = append(snapshotIds, snapshot.SnapshotId)
 		}
 	}
 
 	return snapshotIds, nil
 }
 
+// snapshotsInUse returns the IDs of the snapshots that back an image or that launch templates use.
+func snapshotsInUse(ctx context.Context, client SnapshotsAPI) (map[string]bool, error) {
+	refs, err := launchTemplateReferences(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	inUse := make(map[string]bool)
+	for snapshotID := range refs.snapshots {
+		inUse[snapshotID] = true
+	}
+
+	paginator := ec2.NewDescribeImagesPaginator(client, &ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		for _, image := range page.Images {
+			for _, mapping := range image.BlockDeviceMappings {
+				if mapping.Ebs != nil && mapping.Ebs.SnapshotId != nil {
+					inUse[aws.ToString(mapping.Ebs.SnapshotId)] = true
+				}
+			}
+		}
+	}
+	return inUse, nil
+}
+
+// snapshotOrphanReason returns why a snapshot is orphaned, or "" if it is in use.
+func snapshotOrphanReason(snapshot types.Snapshot, inUse map[string]bool) string {
+	if inUse[aws.ToString(snapshot.SnapshotId)] {
+		return ""
+	}
+	return "snapshot does not back any image and is not used by any launch template"
+}
+
 // snapshotHasAWSBackupTag checks if the snapshot has an AWS Backup tag.
 // Resources created by AWS Backup are listed as owned by self, but are actually
 // AWS managed resources and cannot be deleted here.
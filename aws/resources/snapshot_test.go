@@ -21,6 +21,17 @@ type mockSnapshotsClient struct {
 	DeleteSnapshotErr  error
 	DeregisterImageErr error
 	currentPage        int
+
+	DescribeLaunchTemplatesOutput        ec2.DescribeLaunchTemplatesOutput
+	DescribeLaunchTemplateVersionsOutput ec2.DescribeLaunchTemplateVersionsOutput
+}
+
+func (m *mockSnapshotsClient) DescribeLaunchTemplates(ctx context.Context, params *ec2.DescribeLaunchTemplatesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplatesOutput, error) {
+	return &m.DescribeLaunchTemplatesOutput, nil
+}
+
+func (m *mockSnapshotsClient) DescribeLaunchTemplateVersions(ctx context.Context, params *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	return &m.DescribeLaunchTemplateVersionsOutput, nil
 }
 
 func (m *mockSnapshotsClient) DescribeSnapshots(ctx context.Context, params *ec2.DescribeSnapshotsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error) {
@@ -98,7 +109,7 @@ func TestListSnapshots(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			mock := &mockSnapshotsClient{Pages: tc.pages}
-			ids, err := listSnapshots(context.Background(), mock, resource.Scope{}, tc.cfg)
+			ids, err := listSnapshots(context.Background(), mock, resource.Scope{}, tc.cfg, nil)
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
 		})
@@ -77,6 +77,7 @@ func listSNSTopics(ctx context.Context, client SNSTopicAPI, scope resource.Scope
 			topicName := (*topic.TopicArn)[strings.LastIndex(*topic.TopicArn, ":")+1:]
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   topic.TopicArn,
 				Time: firstSeenTime,
 				Name: &topicName,
 				Tags: util.ConvertSNSTagsToMap(tagsOutput.Tags),
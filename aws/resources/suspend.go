@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// suspendedTagKey is the tag that records the state of a suspended resource, so that resume can
+	// restore it and skip resources cloud-nuke did not suspend.
+	suspendedTagKey = "cloud-nuke-suspended"
+	// notSuspendedReason is why resume skips resources without the suspended tag.
+	notSuspendedReason = "not suspended by cloud-nuke"
+)
+
+// formatSuspendedState encodes named counts, such as the capacity of an Auto Scaling Group, as a tag
+// value like "desired=2,min=1".
+func formatSuspendedState(state map[string]int32) string {
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%d", key, state[key]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseSuspendedState decodes a tag value written by formatSuspendedState.
+func parseSuspendedState(value string) (map[string]int32, error) {
+	state := map[string]int32{}
+	for _, part := range strings.Split(value, ",") {
+		key, count, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s tag value %q", suspendedTagKey, value)
+		}
+		n, err := strconv.ParseInt(count, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s tag value %q", suspendedTagKey, value)
+		}
+		state[key] = int32(n)
+	}
+	return state, nil
+}
+
+// untaggedSuspendError reports a resource that was suspended, as described by done, but could not be
+// tagged, so that resume will not restore it. Resources are tagged only once suspended, so that a
+// failed suspension never leaves a tag behind.
+func untaggedSuspendError(done string, err error) error {
+	return fmt.Errorf("%s, but could not add the %s tag, so resume will skip it: %w", done, suspendedTagKey, err)
+}
@@ -64,6 +64,7 @@ func listTransitGatewayRouteTables(ctx context.Context, client TransitGatewaysRo
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   rt.TransitGatewayRouteTableId,
 				Time: rt.CreationTime,
 				Tags: util.ConvertTypesTagsToMap(rt.Tags),
 			}) {
@@ -58,6 +58,7 @@ func listTransitGatewaysVpcAttachments(ctx context.Context, client TransitGatewa
 			}
 
 			if !cfg.ShouldInclude(config.ResourceValue{
+				ID:   attachment.TransitGatewayAttachmentId,
 				Time: attachment.CreationTime,
 				Tags: util.ConvertTypesTagsToMap(attachment.Tags),
 			}) {
@@ -71,6 +71,7 @@ func listTransitGateways(ctx context.Context, client TransitGatewaysAPI, scope r
 
 			hostNameTagValue := util.GetEC2ResourceNameTagValue(transitGateway.Tags)
 			if !cfg.ShouldInclude(config.ResourceValue{
+				ID:   transitGateway.TransitGatewayId,
 				Time: transitGateway.CreationTime,
 				Name: hostNameTagValue,
 				Tags: util.ConvertTypesTagsToMap(transitGateway.Tags),
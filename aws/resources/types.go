@@ -10,4 +10,5 @@ import (
 type AwsResource interface {
 	resource.NukeableResource
 	Init(cfg aws.Config)
+	IAMPermissions() resource.Permissions
 }
@@ -65,6 +65,7 @@ func listVPCLatticeServices(ctx context.Context, client VPCLatticeServiceAPI, _
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   service.Arn,
 				Name: service.Name,
 				Time: service.CreatedAt,
 				Tags: tagsOutput.Tags,
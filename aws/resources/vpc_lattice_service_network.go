@@ -75,6 +75,7 @@ func listVPCLatticeServiceNetworks(ctx context.Context, client VPCLatticeService
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   item.Arn,
 				Name: item.Name,
 				Time: item.CreatedAt,
 				Tags: tagsOutput.Tags,
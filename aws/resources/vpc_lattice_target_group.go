@@ -59,6 +59,7 @@ func listVPCLatticeTargetGroups(ctx context.Context, client VPCLatticeTargetGrou
 			}
 
 			if cfg.ShouldInclude(config.ResourceValue{
+				ID:   item.Arn,
 				Name: item.Name,
 				Time: item.CreatedAt,
 				Tags: tagsOutput.Tags,
@@ -0,0 +1,321 @@
+// Package cassette records the HTTP exchanges of a real cloud-nuke run into a cassette file and
+// replays them offline, so that listers and deleters can be exercised end to end without cloud access.
+//
+// Recording scrubs credentials and replaces AWS account IDs and GCP project IDs and numbers with
+// stable placeholders before the cassette is written. Replay serves each recorded response once,
+// matching requests by method, URL, API operation and, where possible, body.
+package cassette
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+// gcpScope is the OAuth scope requested for recorded GCP traffic.
+const gcpScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// Cassette is the on-disk format: every recorded exchange, in the order it happened.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded HTTP exchange.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the scrubbed form of a recorded HTTP request.
+type Request struct {
+	Method    string            `json:"method"`
+	URL       string            `json:"url"`
+	Operation string            `json:"operation,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Body      string            `json:"body,omitempty"`
+}
+
+// Response is the scrubbed form of a recorded HTTP response. Binary bodies are base64 encoded.
+type Response struct {
+	Status       int                 `json:"status"`
+	Headers      map[string][]string `json:"headers,omitempty"`
+	Body         string              `json:"body,omitempty"`
+	BodyEncoding string              `json:"body_encoding,omitempty"`
+}
+
+// recordedRequestHeaders are the only request headers kept in a cassette; the rest carry
+// signatures, tokens or per-call noise.
+var recordedRequestHeaders = []string{"Content-Type", "X-Amz-Target"}
+
+// Transport is an http.RoundTripper that records exchanges with the real cloud APIs, or replays
+// them from a cassette.
+type Transport struct {
+	path      string
+	base      http.RoundTripper
+	replaying bool
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+
+	awsInstalled bool
+}
+
+// NewRecorder returns a Transport that sends requests through base, or http.DefaultTransport if
+// base is nil, and records them. Call Stop to write the cassette to path.
+func NewRecorder(path string, base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{path: path, base: base}
+}
+
+// NewReplayer returns a Transport that serves the responses recorded in the cassette at path.
+func NewReplayer(path string) (*Transport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	return &Transport{path: path, replaying: true, cassette: c, used: make([]bool, len(c.Interactions))}, nil
+}
+
+// Replaying reports whether the transport serves recorded responses rather than calling the real APIs.
+func (t *Transport) Replaying() bool {
+	return t.replaying
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Operation: operation(req, body),
+		Headers:   make(map[string]string),
+		Body:      string(body),
+	}
+	for _, h := range recordedRequestHeaders {
+		if v := req.Header.Get(h); v != "" {
+			recorded.Headers[h] = v
+		}
+	}
+
+	if t.replaying {
+		return t.replay(req, recorded)
+	}
+	return t.record(req, recorded)
+}
+
+func (t *Transport) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	response := Response{Status: resp.StatusCode, Headers: make(map[string][]string)}
+	for k, v := range resp.Header {
+		if http.CanonicalHeaderKey(k) != "Set-Cookie" {
+			response.Headers[k] = v
+		}
+	}
+	if utf8.Valid(body) {
+		response.Body = string(body)
+	} else {
+		response.Body = base64.StdEncoding.EncodeToString(body)
+		response.BodyEncoding = "base64"
+	}
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, Interaction{Request: recorded, Response: response})
+	t.mu.Unlock()
+	return resp, nil
+}
+
+// replay serves the first unused interaction with the same method, URL and operation, preferring
+// one with an identical body so that repeated calls with different parameters are told apart.
+func (t *Transport) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	recorded.URL = scrubSecrets(recorded.URL)
+	recorded.Body = scrubSecrets(recorded.Body)
+
+	t.mu.Lock()
+	match := -1
+	for i, in := range t.cassette.Interactions {
+		if t.used[i] || in.Request.Method != recorded.Method || in.Request.URL != recorded.URL || in.Request.Operation != recorded.Operation {
+			continue
+		}
+		if in.Request.Body == recorded.Body {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+	if match >= 0 {
+		t.used[match] = true
+	}
+	t.mu.Unlock()
+
+	if match < 0 {
+		return nil, NoRecordedResponseError{Cassette: t.path, Method: recorded.Method, URL: recorded.URL, Operation: recorded.Operation}
+	}
+
+	response := t.cassette.Interactions[match].Response
+	body := []byte(response.Body)
+	if response.BodyEncoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("decoding recorded response body: %w", err)
+		}
+		body = decoded
+	}
+	header := http.Header{}
+	for k, v := range response.Headers {
+		header[k] = v
+	}
+	header.Set("Content-Length", fmt.Sprint(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.Status, http.StatusText(response.Status)),
+		StatusCode:    response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// UseForAWS routes every AWS client created through externalcreds over this transport until Stop is
+// called. When replaying, static credentials are used so that no AWS account or config is needed.
+func (t *Transport) UseForAWS() {
+	client := &http.Client{Transport: t}
+	if t.replaying {
+		externalcreds.SetConfigProvider(func(region string) (aws.Config, error) {
+			return aws.Config{
+				Region:      region,
+				Credentials: credentials.NewStaticCredentialsProvider(placeholderAccessKeyID, "replay", ""),
+			}, nil
+		})
+	}
+	externalcreds.SetHTTPClient(client)
+	t.awsInstalled = true
+}
+
+// GCPHTTPClient returns an HTTP client for GCP clients that uses this transport. When recording,
+// requests are authorized with opts, or Application Default Credentials if opts is empty.
+func (t *Transport) GCPHTTPClient(ctx context.Context, opts ...option.ClientOption) (*http.Client, error) {
+	if t.replaying {
+		return &http.Client{Transport: t}, nil
+	}
+	opts = append([]option.ClientOption{option.WithScopes(gcpScope)}, opts...)
+	rt, err := htransport.NewTransport(ctx, t, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: rt}, nil
+}
+
+// Stop undoes UseForAWS and, when recording, scrubs the recorded exchanges and writes the cassette.
+func (t *Transport) Stop() error {
+	if t.awsInstalled {
+		externalcreds.SetHTTPClient(nil)
+		if t.replaying {
+			externalcreds.SetConfigProvider(nil)
+		}
+		t.awsInstalled = false
+	}
+	if t.replaying {
+		return nil
+	}
+
+	t.mu.Lock()
+	c := scrub(t.cassette)
+	t.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(t.path, data, 0600)
+}
+
+// operation identifies the API operation of a request whose URL is shared by every operation:
+// the X-Amz-Target header of AWS JSON protocols, or the Action of AWS query protocols.
+func operation(req *http.Request, body []byte) string {
+	if target := req.Header.Get("X-Amz-Target"); target != "" {
+		return target
+	}
+	if req.Header.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return values.Get("Action")
+		}
+	}
+	return ""
+}
+
+// readRequestBody returns the request body and leaves an unread copy in its place.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// readResponseBody returns the decompressed response body and leaves an unread, uncompressed copy
+// in its place, so that cassettes store readable, scrubbable bodies.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	var reader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		reader = gz
+		resp.Header.Del("Content-Encoding")
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", fmt.Sprint(len(body)))
+	return body, nil
+}
@@ -0,0 +1,22 @@
+package cassette
+
+import "fmt"
+
+type NoRecordedResponseError struct {
+	Cassette  string
+	Method    string
+	URL       string
+	Operation string
+}
+
+func (err NoRecordedResponseError) Error() string {
+	if err.Operation != "" {
+		return fmt.Sprintf("Cassette %s has no unused recorded response for %s %s (%s)", err.Cassette, err.Method, err.URL, err.Operation)
+	}
+	return fmt.Sprintf("Cassette %s has no unused recorded response for %s %s", err.Cassette, err.Method, err.URL)
+}
+
+// RetryableError tells the AWS SDK not to retry: a missing exchange stays missing.
+func (err NoRecordedResponseError) RetryableError() bool {
+	return false
+}
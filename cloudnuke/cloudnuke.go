@@ -0,0 +1,199 @@
+// Package cloudnuke is the stable Go API for embedding cloud-nuke in other programs.
+//
+// It wraps the same scan and delete pipeline used by the CLI and returns typed
+// results instead of rendering tables or JSON:
+//
+//	result, err := cloudnuke.Inspect(ctx, cloudnuke.AWS,
+//	    cloudnuke.WithRegions("us-east-1"),
+//	    cloudnuke.WithResourceTypes("ec2", "ebs"),
+//	    cloudnuke.WithOlderThan(48*time.Hour),
+//	)
+//
+// Nuke performs the same scan and then deletes everything that was found, without
+// prompting, or suspends or resumes it when WithAction is given. Progress can be
+// observed with WithEventHook.
+package cloudnuke
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Provider selects the cloud to operate on.
+type Provider string
+
+const (
+	AWS Provider = "aws"
+	GCP Provider = "gcp"
+)
+
+// Inspect scans for resources matching the options without deleting anything.
+func Inspect(ctx context.Context, provider Provider, opts ...Option) (*Result, error) {
+	return run(ctx, provider, false, newOptions(opts))
+}
+
+// Nuke scans for resources matching the options and deletes all of them, or applies the action
+// set with WithAction.
+// There is no confirmation step: callers are responsible for any safeguards.
+// The returned Result is populated even when an error is returned, so callers
+// can see what was deleted before the failure.
+func Nuke(ctx context.Context, provider Provider, opts ...Option) (*Result, error) {
+	return run(ctx, provider, true, newOptions(opts))
+}
+
+func run(ctx context.Context, provider Provider, nuke bool, o *options) (*Result, error) {
+	configObj, err := o.resolveConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := newResultRecorder(provider, o.hooks)
+	collector := reporting.NewCollector()
+	collector.AddRenderer(recorder)
+	defer collector.Complete()
+
+	switch provider {
+	case AWS:
+		err = runAWS(ctx, o, configObj, collector, nuke)
+	case GCP:
+		err = runGCP(ctx, o, configObj, collector, nuke)
+	default:
+		return nil, UnsupportedProviderError{Provider: provider}
+	}
+
+	return recorder.result, err
+}
+
+// resolveConfig returns the config file contents if one was given, otherwise the in-memory config.
+func (o *options) resolveConfig() (config.Config, error) {
+	if o.configFile == "" {
+		return o.config, nil
+	}
+	configObj, err := config.GetConfig(o.configFile)
+	if err != nil {
+		return config.Config{}, errors.WithStackTrace(fmt.Errorf("reading config file %s: %w", o.configFile, err))
+	}
+	return *configObj, nil
+}
+
+func runAWS(ctx context.Context, o *options, configObj config.Config, collector *reporting.Collector, nuke bool) error {
+	if o.awsConfigProvider != nil {
+		externalcreds.SetConfigProvider(o.awsConfigProvider)
+	}
+	externalcreds.SetEndpoints(o.endpointURL, configObj.Endpoints)
+	if o.pluginDir != "" {
+		if err := aws.LoadPlugins(ctx, o.pluginDir); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
+	if err := aws.RegisterCloudControlTypes(configObj.CloudControl); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	excludeAfter, includeAfter := o.timeBounds(time.Now())
+	query := &aws.Query{
+		Regions:              o.regions,
+		ExcludeRegions:       o.excludeRegions,
+		ResourceTypes:        o.resourceTypes,
+		ExcludeResourceTypes: o.excludeResourceTypes,
+		ExcludeAfter:         excludeAfter,
+		IncludeAfter:         includeAfter,
+		ListUnaliasedKMSKeys: o.listUnaliasedKMSKeys,
+		Timeout:              o.timeoutPtr(),
+		VpcIDs:               o.vpcIDs,
+		OrphansOnly:          o.orphansOnly,
+		Action:               o.action,
+		ProtectedIdentifiers: o.protectedIdentifiers,
+		ExcludeFirstSeen:     o.excludeFirstSeen,
+		Parallelism:          o.parallelism,
+	}
+	if err := query.Validate(); err != nil {
+		return errors.WithStackTrace(aws.QueryCreationError{Underlying: err})
+	}
+
+	scanStarted := reporting.ScanStarted{
+		Regions:              query.Regions,
+		ResourceTypes:        query.ResourceTypes,
+		ListUnaliasedKMSKeys: query.ListUnaliasedKMSKeys,
+	}
+	if excludeAfter != nil {
+		scanStarted.ExcludeAfter = excludeAfter.Format(time.DateTime)
+	}
+	if includeAfter != nil {
+		scanStarted.IncludeAfter = includeAfter.Format(time.DateTime)
+	}
+	collector.Emit(scanStarted)
+
+	account, err := aws.GetAllResources(ctx, query, configObj, collector)
+	if err != nil {
+		return errors.WithStackTrace(aws.ResourceInspectionError{Underlying: err})
+	}
+	collector.Emit(reporting.ScanComplete{})
+
+	if !nuke || account.TotalResourceCount() == 0 {
+		return nil
+	}
+	return aws.ApplyAction(ctx, account, query.Regions, query.Parallelism, collector, query.Action)
+}
+
+func runGCP(ctx context.Context, o *options, configObj config.Config, collector *reporting.Collector, nuke bool) error {
+	if o.gcpProjectID == "" {
+		return errors.WithStackTrace(MissingGCPProjectError{})
+	}
+	if err := o.checkAWSOnly(); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	excludeAfter, includeAfter := o.timeBounds(time.Now())
+	configObj.ApplyTimeFilters(excludeAfter, includeAfter)
+	configObj.AddTimeout(o.timeoutPtr())
+
+	query := &gcp.Query{
+		ProjectID:            o.gcpProjectID,
+		Regions:              o.regions,
+		ExcludeRegions:       o.excludeRegions,
+		ResourceTypes:        o.resourceTypes,
+		ExcludeResourceTypes: o.excludeResourceTypes,
+		ExcludeFirstSeen:     o.excludeFirstSeen,
+		Parallelism:          o.parallelism,
+		ClientOptions:        o.gcpClientOptions,
+	}
+	if err := query.Validate(); err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	project, err := gcp.GetAllResources(ctx, query, configObj, collector)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	collector.Emit(reporting.ScanComplete{})
+
+	if !nuke || project.TotalResourceCount() == 0 {
+		return nil
+	}
+	return gcp.NukeAllResources(ctx, project, query.Regions, query.Parallelism, collector)
+}
+
+// checkAWSOnly returns an error for the options that only apply to AWS.
+func (o *options) checkAWSOnly() error {
+	switch {
+	case o.action != "" && o.action != aws.ActionDelete:
+		return UnsupportedGCPOptionError{Option: "WithAction(" + strconv.Quote(o.action) + ")"}
+	case len(o.vpcIDs) > 0:
+		return UnsupportedGCPOptionError{Option: "WithVpcIDs"}
+	case o.orphansOnly:
+		return UnsupportedGCPOptionError{Option: "WithOrphansOnly"}
+	case len(o.protectedIdentifiers) > 0:
+		return UnsupportedGCPOptionError{Option: "WithProtectedIdentifiers"}
+	}
+	return nil
+}
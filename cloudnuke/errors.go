@@ -0,0 +1,25 @@
+package cloudnuke
+
+import "fmt"
+
+type UnsupportedProviderError struct {
+	Provider Provider
+}
+
+func (err UnsupportedProviderError) Error() string {
+	return fmt.Sprintf("Unsupported provider %q: expected %q or %q", err.Provider, AWS, GCP)
+}
+
+type MissingGCPProjectError struct{}
+
+func (err MissingGCPProjectError) Error() string {
+	return "A GCP project ID is required: use cloudnuke.WithGCPProject"
+}
+
+type UnsupportedGCPOptionError struct {
+	Option string
+}
+
+func (err UnsupportedGCPOptionError) Error() string {
+	return fmt.Sprintf("%s is only supported for AWS", err.Option)
+}
@@ -0,0 +1,246 @@
+package cloudnuke
+
+import (
+	"maps"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"google.golang.org/api/option"
+)
+
+// Option configures an Inspect or Nuke call.
+type Option func(*options)
+
+// EventHook receives every reporting event emitted during a run, in order.
+// Calls are serialized, so the hook does not need to be safe for concurrent use,
+// but it should return quickly since it blocks the run while executing.
+type EventHook func(event reporting.Event)
+
+type options struct {
+	config               config.Config
+	configFile           string
+	regions              []string
+	excludeRegions       []string
+	resourceTypes        []string
+	excludeResourceTypes []string
+	olderThan            time.Duration
+	newerThan            time.Duration
+	timeout              time.Duration
+	parallelism          int
+	excludeFirstSeen     bool
+	listUnaliasedKMSKeys bool
+	action               string
+	vpcIDs               []string
+	orphansOnly          bool
+	protectedIdentifiers map[string]string
+	awsConfigProvider    func(region string) (aws.Config, error)
+	pluginDir            string
+	endpointURL          string
+	gcpProjectID         string
+	gcpClientOptions     []option.ClientOption
+	hooks                []EventHook
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithConfig sets the filtering rules, equivalent to the CLI --config file contents.
+func WithConfig(cfg config.Config) Option {
+	return func(o *options) {
+		o.config = cfg
+	}
+}
+
+// WithConfigFile loads filtering rules from a YAML config file, equivalent to the CLI --config flag.
+// It takes precedence over WithConfig.
+func WithConfigFile(path string) Option {
+	return func(o *options) {
+		o.configFile = path
+	}
+}
+
+// WithRegions restricts the run to the given regions. For AWS, "global" selects global
+// resources such as IAM and S3. For GCP, regions default to "global".
+func WithRegions(regions ...string) Option {
+	return func(o *options) {
+		o.regions = append(o.regions, regions...)
+	}
+}
+
+// WithExcludeRegions excludes the given regions. Mutually exclusive with WithRegions for AWS.
+func WithExcludeRegions(regions ...string) Option {
+	return func(o *options) {
+		o.excludeRegions = append(o.excludeRegions, regions...)
+	}
+}
+
+// WithResourceTypes restricts the run to the given resource types (as listed by --list-resource-types).
+func WithResourceTypes(resourceTypes ...string) Option {
+	return func(o *options) {
+		o.resourceTypes = append(o.resourceTypes, resourceTypes...)
+	}
+}
+
+// WithExcludeResourceTypes excludes the given resource types.
+func WithExcludeResourceTypes(resourceTypes ...string) Option {
+	return func(o *options) {
+		o.excludeResourceTypes = append(o.excludeResourceTypes, resourceTypes...)
+	}
+}
+
+// WithOlderThan only targets resources created more than d ago, equivalent to --older-than.
+func WithOlderThan(d time.Duration) Option {
+	return func(o *options) {
+		o.olderThan = d
+	}
+}
+
+// WithNewerThan only targets resources created less than d ago, equivalent to --newer-than.
+func WithNewerThan(d time.Duration) Option {
+	return func(o *options) {
+		o.newerThan = d
+	}
+}
+
+// WithTimeout sets the per-resource-type execution timeout, equivalent to --timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithParallelism sets how many regions/resource types are scanned and deleted concurrently.
+// Zero uses the default.
+func WithParallelism(n int) Option {
+	return func(o *options) {
+		o.parallelism = n
+	}
+}
+
+// WithExcludeFirstSeen disables the cloud-nuke-first-seen tag, equivalent to --exclude-first-seen.
+func WithExcludeFirstSeen(exclude bool) Option {
+	return func(o *options) {
+		o.excludeFirstSeen = exclude
+	}
+}
+
+// WithUnaliasedKMSKeys includes KMS customer keys that have no alias.
+func WithUnaliasedKMSKeys(include bool) Option {
+	return func(o *options) {
+		o.listUnaliasedKMSKeys = include
+	}
+}
+
+// WithAction sets what Nuke does with the resources it finds: "delete" (the default), "suspend" or
+// "resume", equivalent to --action. Suspend and resume are only supported for AWS and, unless resource
+// types are given, restrict the run to the resource types that support them.
+func WithAction(action string) Option {
+	return func(o *options) {
+		o.action = action
+	}
+}
+
+// WithVpcIDs restricts VPC-scoped resource types to resources inside the given VPCs, equivalent to
+// --vpc-id. AWS only.
+func WithVpcIDs(vpcIDs ...string) Option {
+	return func(o *options) {
+		o.vpcIDs = append(o.vpcIDs, vpcIDs...)
+	}
+}
+
+// WithOrphansOnly only targets resources that nothing else uses, equivalent to --orphans-only. AWS only.
+func WithOrphansOnly(orphansOnly bool) Option {
+	return func(o *options) {
+		o.orphansOnly = orphansOnly
+	}
+}
+
+// WithProtectedIdentifiers marks resources that must never be nuked, mapping each identifier to the
+// reason it is reported as not nukable. It is the library equivalent of --protect-tfstate, whose
+// identifiers can be loaded with tfstate.Load. It may be given multiple times. AWS only.
+func WithProtectedIdentifiers(protected map[string]string) Option {
+	return func(o *options) {
+		if o.protectedIdentifiers == nil {
+			o.protectedIdentifiers = make(map[string]string, len(protected))
+		}
+		maps.Copy(o.protectedIdentifiers, protected)
+	}
+}
+
+// WithAWSConfigProvider supplies AWS credentials and settings for each region.
+// The provider is installed with externalcreds.SetConfigProvider and therefore
+// applies process-wide, not just to this call.
+func WithAWSConfigProvider(fn func(region string) (aws.Config, error)) Option {
+	return func(o *options) {
+		o.awsConfigProvider = fn
+	}
+}
+
+// WithEndpointURL sends all AWS API calls to a custom endpoint such as LocalStack, equivalent to
+// --endpoint-url. Like WithAWSConfigProvider, it applies process-wide.
+func WithEndpointURL(url string) Option {
+	return func(o *options) {
+		o.endpointURL = url
+	}
+}
+
+// WithPluginDir loads out-of-process plugin resource types from dir, equivalent to --plugin-dir.
+// Plugins are registered process-wide and currently apply to AWS only.
+func WithPluginDir(dir string) Option {
+	return func(o *options) {
+		o.pluginDir = dir
+	}
+}
+
+// WithGCPProject sets the GCP project to operate on. Required for GCP.
+func WithGCPProject(projectID string) Option {
+	return func(o *options) {
+		o.gcpProjectID = projectID
+	}
+}
+
+// WithGCPClientOptions passes options (e.g. option.WithCredentialsFile) to every GCP client.
+func WithGCPClientOptions(opts ...option.ClientOption) Option {
+	return func(o *options) {
+		o.gcpClientOptions = append(o.gcpClientOptions, opts...)
+	}
+}
+
+// WithEventHook registers a callback that receives progress and result events as they happen.
+// It may be given multiple times.
+func WithEventHook(hook EventHook) Option {
+	return func(o *options) {
+		if hook != nil {
+			o.hooks = append(o.hooks, hook)
+		}
+	}
+}
+
+// timeBounds converts the relative age options into the absolute times used by queries and config.
+func (o *options) timeBounds(now time.Time) (excludeAfter *time.Time, includeAfter *time.Time) {
+	if o.olderThan > 0 {
+		t := now.Add(-o.olderThan)
+		excludeAfter = &t
+	}
+	if o.newerThan > 0 {
+		t := now.Add(-o.newerThan)
+		includeAfter = &t
+	}
+	return excludeAfter, includeAfter
+}
+
+// timeoutPtr returns the timeout as a pointer, or nil if unset.
+func (o *options) timeoutPtr() *time.Duration {
+	if o.timeout <= 0 {
+		return nil
+	}
+	timeout := o.timeout
+	return &timeout
+}
@@ -0,0 +1,152 @@
+package cloudnuke
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+)
+
+// Resource identifies a single cloud resource.
+type Resource struct {
+	ResourceType string
+	Region       string
+	Identifier   string
+}
+
+// FoundResource is a resource discovered during the scan.
+type FoundResource struct {
+	Resource
+	// Nukable is false when the resource matched the filters but cannot be deleted,
+	// for example because a permission dry-run failed. Reason explains why.
+	Nukable bool
+	Reason  string
+}
+
+// FailedResource is a resource whose deletion was attempted and did not succeed.
+type FailedResource struct {
+	Resource
+	Error string
+	// Warning is true for transient or ordering failures (e.g. DependencyViolation)
+	// that are expected to succeed on a later run.
+	Warning bool
+}
+
+// SkippedResource is a resource that a suspend or resume left untouched, e.g. because it was
+// already stopped. Reason explains why.
+type SkippedResource struct {
+	Resource
+	Reason string
+}
+
+// GeneralError is an error not tied to a single resource, such as a failure to list a resource type.
+type GeneralError struct {
+	ResourceType string
+	Description  string
+	Error        string
+}
+
+// Result is the outcome of an Inspect or Nuke call.
+// For Inspect, only Found and Errors are populated.
+type Result struct {
+	Provider Provider
+	Found    []FoundResource
+	Deleted  []Resource
+	// Suspended and Resumed hold the resources that a Nuke call with WithAction("suspend") or
+	// WithAction("resume") acted on. Deleted stays empty for those actions.
+	Suspended []Resource
+	Resumed   []Resource
+	// Modified holds the resources changed in place instead of deleted, e.g. log groups whose
+	// retention was bounded.
+	Modified []Resource
+	// Skipped holds the resources that a suspend or resume left untouched.
+	Skipped []SkippedResource
+	Failed  []FailedResource
+	Errors  []GeneralError
+	// Interrupted is the reason a Nuke call stopped early, e.g. because its context was canceled,
+	// or empty if it ran to completion. NotAttempted then lists the resources it did not get to.
+	Interrupted  string
+	NotAttempted []Resource
+}
+
+// HasFailures reports whether any deletion failed (excluding warnings) or any general error occurred.
+func (r *Result) HasFailures() bool {
+	if len(r.Errors) > 0 {
+		return true
+	}
+	for _, f := range r.Failed {
+		if !f.Warning {
+			return true
+		}
+	}
+	return false
+}
+
+// resultRecorder is a reporting.Renderer that builds a Result from the event stream
+// and forwards every event to the registered hooks.
+type resultRecorder struct {
+	result *Result
+	hooks  []EventHook
+	action string // action of the nuke operation, from NukeStarted
+}
+
+func newResultRecorder(provider Provider, hooks []EventHook) *resultRecorder {
+	return &resultRecorder{
+		result: &Result{Provider: provider},
+		hooks:  hooks,
+	}
+}
+
+// OnEvent implements reporting.Renderer.
+func (r *resultRecorder) OnEvent(event reporting.Event) {
+	switch e := event.(type) {
+	case reporting.ResourceFound:
+		r.result.Found = append(r.result.Found, FoundResource{
+			Resource: Resource{ResourceType: e.ResourceType, Region: e.Region, Identifier: e.Identifier},
+			Nukable:  e.Nukable,
+			Reason:   e.Reason,
+		})
+	case reporting.NukeStarted:
+		r.action = e.Action
+	case reporting.ResourceDeleted:
+		res := Resource{ResourceType: e.ResourceType, Region: e.Region, Identifier: e.Identifier}
+		if e.Success && e.Modified {
+			r.result.Modified = append(r.result.Modified, res)
+		} else if e.Success && e.Skipped {
+			r.result.Skipped = append(r.result.Skipped, SkippedResource{Resource: res, Reason: e.Detail})
+		} else if e.Success {
+			r.recordSucceeded(res)
+		} else {
+			r.result.Failed = append(r.result.Failed, FailedResource{
+				Resource: res,
+				Error:    e.Error,
+				Warning:  e.Warning,
+			})
+		}
+	case reporting.NukeInterrupted:
+		r.result.Interrupted = e.Reason
+		for _, u := range e.NotAttempted {
+			r.result.NotAttempted = append(r.result.NotAttempted, Resource{ResourceType: u.ResourceType, Region: u.Region, Identifier: u.Identifier})
+		}
+	case reporting.GeneralError:
+		r.result.Errors = append(r.result.Errors, GeneralError{
+			ResourceType: e.ResourceType,
+			Description:  e.Description,
+			Error:        e.Error,
+		})
+	}
+
+	for _, hook := range r.hooks {
+		hook(event)
+	}
+}
+
+// recordSucceeded adds a resource the action succeeded on to the list for that action.
+func (r *resultRecorder) recordSucceeded(res Resource) {
+	switch r.action {
+	case aws.ActionSuspend:
+		r.result.Suspended = append(r.result.Suspended, res)
+	case aws.ActionResume:
+		r.result.Resumed = append(r.result.Resumed, res)
+	default:
+		r.result.Deleted = append(r.result.Deleted, res)
+	}
+}
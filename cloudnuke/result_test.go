@@ -0,0 +1,136 @@
+package cloudnuke
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultRecorder(t *testing.T) {
+	var seen []reporting.Event
+	recorder := newResultRecorder(AWS, []EventHook{func(e reporting.Event) { seen = append(seen, e) }})
+
+	recorder.OnEvent(reporting.ScanStarted{})
+	recorder.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1", Nukable: true})
+	recorder.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-2", Reason: "protected"})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1", Success: true})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "cloudwatch-loggroup", Region: "us-east-1", Identifier: "/app", Success: true, Modified: true})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-1", Error: "DependencyViolation", Warning: true})
+	recorder.OnEvent(reporting.GeneralError{ResourceType: "s3", Description: "list failed", Error: "AccessDenied"})
+
+	result := recorder.result
+	require.Equal(t, AWS, result.Provider)
+	require.Len(t, seen, 7)
+	require.Equal(t, []FoundResource{
+		{Resource: Resource{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"}, Nukable: true},
+		{Resource: Resource{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-2"}, Reason: "protected"},
+	}, result.Found)
+	require.Equal(t, []Resource{{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"}}, result.Deleted)
+	require.Equal(t, []Resource{{ResourceType: "cloudwatch-loggroup", Region: "us-east-1", Identifier: "/app"}}, result.Modified)
+	require.Len(t, result.Failed, 1)
+	require.True(t, result.Failed[0].Warning)
+	require.Equal(t, []GeneralError{{ResourceType: "s3", Description: "list failed", Error: "AccessDenied"}}, result.Errors)
+}
+
+func TestResultRecorderSuspend(t *testing.T) {
+	recorder := newResultRecorder(AWS, nil)
+
+	recorder.OnEvent(reporting.NukeStarted{Total: 2, Action: "suspend"})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1", Success: true, Detail: "stopped"})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "asg", Region: "us-east-1", Identifier: "web", Error: "boom"})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-2", Success: true, Skipped: true, Detail: "instance is not running (stopped)"})
+
+	result := recorder.result
+	require.Empty(t, result.Deleted)
+	require.Equal(t, []Resource{{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"}}, result.Suspended)
+	require.Equal(t, []SkippedResource{{Resource: Resource{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-2"}, Reason: "instance is not running (stopped)"}}, result.Skipped)
+	require.Len(t, result.Failed, 1)
+}
+
+func TestResultRecorderInterrupted(t *testing.T) {
+	recorder := newResultRecorder(AWS, nil)
+
+	recorder.OnEvent(reporting.NukeStarted{Total: 3, Action: "delete"})
+	recorder.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1", Success: true})
+	recorder.OnEvent(reporting.NukeInterrupted{
+		Reason:       "interrupted by signal: terminated",
+		NotAttempted: reporting.Unattempted("ebs", "us-east-1", []string{"vol-1", "vol-2"}),
+	})
+	recorder.OnEvent(reporting.NukeComplete{})
+
+	result := recorder.result
+	require.Equal(t, "interrupted by signal: terminated", result.Interrupted)
+	require.Equal(t, []Resource{
+		{ResourceType: "ebs", Region: "us-east-1", Identifier: "vol-1"},
+		{ResourceType: "ebs", Region: "us-east-1", Identifier: "vol-2"},
+	}, result.NotAttempted)
+	require.Equal(t, []Resource{{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"}}, result.Deleted)
+}
+
+func TestResultHasFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   Result
+		expected bool
+	}{
+		{name: "empty", result: Result{}, expected: false},
+		{name: "warning only", result: Result{Failed: []FailedResource{{Warning: true}}}, expected: false},
+		{name: "failed", result: Result{Failed: []FailedResource{{Error: "boom"}}}, expected: true},
+		{name: "general error", result: Result{Errors: []GeneralError{{Error: "boom"}}}, expected: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.result.HasFailures())
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	o := newOptions([]Option{
+		WithRegions("us-east-1"),
+		WithRegions("us-west-2"),
+		WithOlderThan(time.Hour),
+		WithNewerThan(24 * time.Hour),
+		WithEventHook(nil),
+	})
+	require.Equal(t, []string{"us-east-1", "us-west-2"}, o.regions)
+	require.Empty(t, o.hooks)
+	require.Nil(t, o.timeoutPtr())
+
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	excludeAfter, includeAfter := o.timeBounds(now)
+	require.Equal(t, now.Add(-time.Hour), *excludeAfter)
+	require.Equal(t, now.Add(-24*time.Hour), *includeAfter)
+
+	o = newOptions([]Option{WithTimeout(time.Minute)})
+	require.Equal(t, time.Minute, *o.timeoutPtr())
+	excludeAfter, includeAfter = o.timeBounds(now)
+	require.Nil(t, excludeAfter)
+	require.Nil(t, includeAfter)
+
+	o = newOptions([]Option{
+		WithAction("suspend"),
+		WithVpcIDs("vpc-1"),
+		WithOrphansOnly(true),
+		WithProtectedIdentifiers(map[string]string{"i-1": "managed by terraform: aws_instance.a"}),
+		WithProtectedIdentifiers(map[string]string{"i-2": "managed by terraform: aws_instance.b"}),
+	})
+	require.Equal(t, "suspend", o.action)
+	require.Equal(t, []string{"vpc-1"}, o.vpcIDs)
+	require.True(t, o.orphansOnly)
+	require.Len(t, o.protectedIdentifiers, 2)
+}
+
+func TestRunErrors(t *testing.T) {
+	_, err := Inspect(context.Background(), Provider("azure"))
+	require.ErrorAs(t, err, &UnsupportedProviderError{})
+
+	_, err = Inspect(context.Background(), GCP)
+	require.ErrorIs(t, err, MissingGCPProjectError{})
+
+	_, err = Nuke(context.Background(), GCP, WithGCPProject("my-project"), WithAction("suspend"))
+	require.ErrorAs(t, err, &UnsupportedGCPOptionError{})
+}
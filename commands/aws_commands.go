@@ -1,13 +1,18 @@
 package commands
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/gruntwork-io/cloud-nuke/aws"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
 	"github.com/gruntwork-io/cloud-nuke/renderers"
 	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"github.com/gruntwork-io/go-commons/errors"
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 	"github.com/urfave/cli/v2"
 )
 
@@ -19,21 +24,40 @@ import (
 func awsNuke(c *cli.Context) error {
 	defer telemetry.TrackCommandLifecycle("aws")()
 
-	// Handle the --list-resource-types flag
-	if c.Bool(FlagListResourceTypes) {
-		return handleListResourceTypes()
+	// Record or replay API traffic when a hidden cassette flag is set
+	cas, err := startCassette(c)
+	if err != nil {
+		return err
 	}
+	defer stopCassette(cas)
 
-	// Parse and set log level
-	if err := parseLogLevel(c); err != nil {
+	// Load plugins first so their resource types can be listed and selected
+	if err := loadAwsPlugins(c); err != nil {
 		return err
 	}
 
-	// Load config file if provided
+	// Load config file if provided. Its Cloud Control types and endpoint overrides must be in place
+	// before resource types are listed and regions are discovered.
 	configObj, err := loadConfigFile(c.String(FlagConfig))
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
+	if err := aws.RegisterCloudControlTypes(configObj.CloudControl); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	externalcreds.SetEndpoints(c.String(FlagEndpointURL), configObj.Endpoints)
+
+	// Handle the --list-resource-types flag
+	if c.Bool(FlagListResourceTypes) {
+		return handleListResourceTypes()
+	}
+
+	// Parse and set log level, format and file
+	closeLog, err := setupLogging(c)
+	if err != nil {
+		return err
+	}
+	defer closeLog()
 
 	// Apply timeout to config (consistent with GCP behavior)
 	if err = parseAndApplyTimeout(c, &configObj); err != nil {
@@ -58,10 +82,19 @@ func awsNuke(c *cli.Context) error {
 func awsDefaults(c *cli.Context) error {
 	defer telemetry.TrackCommandLifecycle("aws-defaults")()
 
-	// Parse and set log level
-	if err := parseLogLevel(c); err != nil {
+	// Record or replay API traffic when a hidden cassette flag is set
+	cas, err := startCassette(c)
+	if err != nil {
 		return err
 	}
+	defer stopCassette(cas)
+
+	// Parse and set log level, format and file
+	closeLog, err := setupLogging(c)
+	if err != nil {
+		return err
+	}
+	defer closeLog()
 
 	// Determine which default resources to target based on flags.
 	// Default VPCs have dependencies that must be deleted first.
@@ -78,6 +111,9 @@ func awsDefaults(c *cli.Context) error {
 		resourceTypes = []string{"security-group"} // Only target default security groups
 	}
 
+	// Point AWS clients at the custom endpoint before regions are discovered
+	externalcreds.SetEndpoints(c.String(FlagEndpointURL), nil)
+
 	// Build query for default resources only
 	query, err := generateQuery(c, false, resourceTypes, true)
 	if err != nil {
@@ -94,21 +130,40 @@ func awsDefaults(c *cli.Context) error {
 func awsInspect(c *cli.Context) error {
 	defer telemetry.TrackCommandLifecycle("aws-inspect")()
 
-	// Handle the --list-resource-types flag
-	if c.Bool(FlagListResourceTypes) {
-		return handleListResourceTypes()
+	// Record or replay API traffic when a hidden cassette flag is set
+	cas, err := startCassette(c)
+	if err != nil {
+		return err
 	}
+	defer stopCassette(cas)
 
-	// Parse and set log level
-	if err := parseLogLevel(c); err != nil {
+	// Load plugins first so their resource types can be listed and selected
+	if err := loadAwsPlugins(c); err != nil {
 		return err
 	}
 
-	// Load config file if provided
+	// Load config file if provided. Its Cloud Control types and endpoint overrides must be in place
+	// before resource types are listed and regions are discovered.
 	configObj, err := loadConfigFile(c.String(FlagConfig))
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
+	if err := aws.RegisterCloudControlTypes(configObj.CloudControl); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	externalcreds.SetEndpoints(c.String(FlagEndpointURL), configObj.Endpoints)
+
+	// Handle the --list-resource-types flag
+	if c.Bool(FlagListResourceTypes) {
+		return handleListResourceTypes()
+	}
+
+	// Parse and set log level, format and file
+	closeLog, err := setupLogging(c)
+	if err != nil {
+		return err
+	}
+	defer closeLog()
 
 	// Apply timeout to config
 	if err = parseAndApplyTimeout(c, &configObj); err != nil {
@@ -130,6 +185,77 @@ func awsInspect(c *cli.Context) error {
 	return err
 }
 
+// awsIAMPolicy prints the IAM policy needed to nuke, or with --inspect to inspect, the selected
+// resource types. With --check it instead lists the actions the current principal is missing.
+func awsIAMPolicy(c *cli.Context) error {
+	defer telemetry.TrackCommandLifecycle("aws-iam-policy")()
+
+	if err := loadAwsPlugins(c); err != nil {
+		return err
+	}
+
+	configObj, err := loadConfigFile(c.String(FlagConfig))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := aws.RegisterCloudControlTypes(configObj.CloudControl); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	externalcreds.SetEndpoints(c.String(FlagEndpointURL), configObj.Endpoints)
+
+	if c.Bool(FlagListResourceTypes) {
+		return handleListResourceTypes()
+	}
+
+	resourceTypes, err := aws.HandleResourceTypeSelections(c.StringSlice(FlagResourceType), c.StringSlice(FlagExcludeResourceType))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	nuke := !c.Bool(FlagInspectOnly)
+	actions, undeclared := aws.RequiredIAMActions(resourceTypes, nuke)
+	for _, resourceType := range undeclared {
+		fmt.Fprintf(c.App.ErrWriter, "Warning: resource type %s declares no IAM actions; grant its permissions separately\n", resourceType)
+	}
+
+	if c.Bool(FlagCheck) {
+		return checkIAMActions(c, actions)
+	}
+
+	sid := "CloudNukeNuke"
+	if !nuke {
+		sid = "CloudNukeInspect"
+	}
+	policy, err := json.MarshalIndent(aws.NewIAMPolicy(sid, actions), "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	_, err = fmt.Fprintln(c.App.Writer, string(policy))
+	return err
+}
+
+// checkIAMActions simulates actions for the current principal and fails if any of them are denied.
+func checkIAMActions(c *cli.Context, actions []string) error {
+	cfg, err := aws.NewSession(aws.GlobalRegion)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	missing, err := aws.MissingIAMActions(c.Context, sts.NewFromConfig(cfg), iam.NewFromConfig(cfg), actions)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if len(missing) > 0 {
+		for _, action := range missing {
+			fmt.Fprintln(c.App.Writer, action)
+		}
+		return MissingIAMActionsError{Actions: missing}
+	}
+
+	fmt.Fprintf(c.App.Writer, "All %d required IAM actions are allowed\n", len(actions))
+	return nil
+}
+
 // Helper Functions
 // These functions contain shared logic used by multiple command handlers
 
@@ -137,7 +263,7 @@ func awsInspect(c *cli.Context) error {
 // It retrieves resources, confirms deletion with the user, and executes the nuke operation.
 func awsNukeHelper(c *cli.Context, configObj config.Config, query *aws.Query, outputFormat string, outputFile string) error {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupAwsReporting(outputFormat, outputFile, query)
+	collector, cleanup, err := setupAwsReporting(outputFormat, outputFile, c.StringSlice(FlagOutputColumns), query)
 	if err != nil {
 		return err
 	}
@@ -149,9 +275,7 @@ func awsNukeHelper(c *cli.Context, configObj config.Config, query *aws.Query, ou
 	// Retrieve all matching resources (emits ResourceFound events via collector)
 	account, err := aws.GetAllResources(c.Context, query, configObj, collector)
 	if err != nil {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error getting resources",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.GetResourcesError())
 		return errors.WithStackTrace(aws.ResourceInspectionError{Underlying: err})
 	}
 
@@ -159,14 +283,17 @@ func awsNukeHelper(c *cli.Context, configObj config.Config, query *aws.Query, ou
 	collector.Emit(reporting.ScanComplete{})
 
 	// Confirm with user before proceeding (unless --force or --dry-run is set)
-	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0)
+	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0, query.Action)
 	if err != nil {
 		return err
 	}
 
 	// Execute the nuke operation if confirmed
 	if shouldProceed {
-		return aws.NukeAllResources(c.Context, account, query.Regions, query.Parallelism, collector)
+		// Interrupts are handled only once confirmed, so that interrupting the prompt still exits
+		ctx, stop := handleInterrupts(c.Context)
+		defer stop()
+		return aws.ApplyAction(ctx, account, query.Regions, query.Parallelism, collector, query.Action)
 	}
 
 	return nil
@@ -198,6 +325,12 @@ func generateQuery(c *cli.Context, includeUnaliasedKmsKeys bool, overridingResou
 		return nil, errors.WithStackTrace(err)
 	}
 
+	// Protect the resources managed by Terraform
+	protected, err := loadTerraformProtection(c.StringSlice(FlagProtectTFState))
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
 	// Determine which resource types to target
 	resourceTypes := c.StringSlice(FlagResourceType)
 	if overridingResourceTypes != nil {
@@ -215,6 +348,10 @@ func generateQuery(c *cli.Context, includeUnaliasedKmsKeys bool, overridingResou
 		ListUnaliasedKMSKeys: includeUnaliasedKmsKeys,
 		Timeout:              timeout,
 		DefaultOnly:          onlyDefault,
+		VpcIDs:               c.StringSlice(FlagVpcID),
+		OrphansOnly:          c.Bool(FlagOrphansOnly),
+		Action:               c.String(FlagAction),
+		ProtectedIdentifiers: protected,
 		ExcludeFirstSeen:     c.Bool(FlagExcludeFirstSeen),
 		IncludeTags:          includeTags,
 		Parallelism:          c.Int(FlagParallelism),
@@ -230,7 +367,7 @@ func generateQuery(c *cli.Context, includeUnaliasedKmsKeys bool, overridingResou
 func handleGetResourcesWithFormat(c *cli.Context, configObj config.Config, query *aws.Query, outputFormat string, outputFile string) (
 	*aws.AwsAccountResources, error) {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupAwsReporting(outputFormat, outputFile, query)
+	collector, cleanup, err := setupAwsReporting(outputFormat, outputFile, c.StringSlice(FlagOutputColumns), query)
 	if err != nil {
 		return nil, err
 	}
@@ -242,9 +379,7 @@ func handleGetResourcesWithFormat(c *cli.Context, configObj config.Config, query
 	// Retrieve all resources matching the query (emits ResourceFound events via collector)
 	accountResources, err := aws.GetAllResources(c.Context, query, configObj, collector)
 	if err != nil {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error inspecting resources",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.InspectResourcesError())
 		return nil, errors.WithStackTrace(aws.ResourceInspectionError{Underlying: err})
 	}
 
@@ -254,6 +389,18 @@ func handleGetResourcesWithFormat(c *cli.Context, configObj config.Config, query
 	return accountResources, nil
 }
 
+// loadAwsPlugins registers the plugins in --plugin-dir, if set, as AWS resource types.
+func loadAwsPlugins(c *cli.Context) error {
+	dir := c.String(FlagPluginDir)
+	if dir == "" {
+		return nil
+	}
+	if err := aws.LoadPlugins(c.Context, dir); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
 // handleListResourceTypes displays all available AWS resource types that can be targeted.
 func handleListResourceTypes() error {
 	return printResourceTypes("AWS Resource Types", aws.ListResourceTypes())
@@ -261,7 +408,7 @@ func handleListResourceTypes() error {
 
 // setupAwsReporting creates a collector and appropriate renderer for AWS operations.
 // Returns the collector, cleanup function (which calls Complete() and closes writer), and any error.
-func setupAwsReporting(outputFormat string, outputFile string, query *aws.Query) (
+func setupAwsReporting(outputFormat string, outputFile string, columns []string, query *aws.Query) (
 	*reporting.Collector, func(), error) {
 	// Build query params for JSON output
 	queryParams := &renderers.QueryParams{
@@ -280,6 +427,7 @@ func setupAwsReporting(outputFormat string, outputFile string, query *aws.Query)
 		Command: "aws",
 		Query:   queryParams,
 		Regions: query.Regions,
+		Columns: columns,
 	})
 }
 
@@ -1,10 +1,7 @@
 package commands
 
 import (
-	"os"
-
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 
 	"github.com/gruntwork-io/go-commons/errors"
 	"github.com/pterm/pterm"
@@ -16,18 +13,17 @@ import (
 func CreateCli(version string) *cli.App {
 	app := cli.NewApp()
 
+	// Initialize telemetry for usage tracking
+	telemetry.InitTelemetry("cloud-nuke", version)
+
 	// Display telemetry warning unless explicitly disabled
-	_, disableTelemetryFlag := os.LookupEnv("DISABLE_TELEMETRY")
-	if !disableTelemetryFlag {
-		pterm.Warning.Println("This program sends telemetry to Gruntwork. To disable, set DISABLE_TELEMETRY=true as an environment variable")
+	if destination := telemetry.Destination(); destination != "" {
+		pterm.Warning.Printfln("This program sends telemetry to %s. To disable, set %s=true as an environment variable",
+			destination, telemetry.EnvDisableTelemetry)
 		pterm.Println()
 	}
 
-	// Initialize telemetry for usage tracking
-	telemetry.InitTelemetry("cloud-nuke", version)
-	telemetry.TrackEvent(commonTelemetry.EventContext{
-		EventName: "initialized",
-	}, map[string]interface{}{})
+	telemetry.Track(telemetry.Initialized())
 
 	// Configure basic app metadata
 	app.Name = "cloud-nuke"
@@ -38,6 +34,12 @@ func CreateCli(version string) *cli.App {
 	app.Version = version
 	app.Usage = "A CLI tool to nuke (delete) cloud resources."
 
+	// Close telemetry once the command ends, so that backends flush their records
+	app.After = func(*cli.Context) error {
+		telemetry.Close()
+		return nil
+	}
+
 	// Register all available commands
 	app.Commands = []*cli.Command{
 		{
@@ -46,6 +48,7 @@ func CreateCli(version string) *cli.App {
 			Action: errors.WithPanicHandling(awsNuke),
 			Flags: CombineFlags(
 				RegionFlags(),
+				CassetteFlags(),
 				CommonResourceTypeFlags(),
 				CommonTimeFlags(),
 				TagFlags(),
@@ -53,6 +56,12 @@ func CreateCli(version string) *cli.App {
 				CommonOutputFlags(),
 				[]cli.Flag{
 					ConfigFlag(),
+					PluginDirFlag(),
+					EndpointURLFlag(),
+					VpcIDFlag(),
+					OrphansOnlyFlag(),
+					ProtectTFStateFlag(),
+					ActionFlag(),
 					&cli.BoolFlag{
 						Name:  FlagDeleteUnaliasedKMSKeys,
 						Usage: "Delete KMS keys that do not have aliases associated with them.",
@@ -70,6 +79,7 @@ func CreateCli(version string) *cli.App {
 			Flags: CombineFlags(
 				[]cli.Flag{GCPProjectFlag()},
 				RegionFlags(),
+				CassetteFlags(),
 				CommonResourceTypeFlags(),
 				CommonTimeFlags(),
 				CommonExecutionFlags(),
@@ -89,6 +99,7 @@ func CreateCli(version string) *cli.App {
 			Flags: CombineFlags(
 				[]cli.Flag{GCPProjectFlag()},
 				RegionFlags(),
+				CassetteFlags(),
 				InspectResourceTypeFlags(),
 				CommonTimeFlags(),
 				CommonOutputFlags(),
@@ -106,7 +117,9 @@ func CreateCli(version string) *cli.App {
 			Action: errors.WithPanicHandling(awsDefaults),
 			Flags: CombineFlags(
 				RegionFlags(),
+				CassetteFlags(),
 				[]cli.Flag{
+					EndpointURLFlag(),
 					&cli.BoolFlag{
 						Name:  FlagSGOnly,
 						Usage: "Destroy default security group rules only. Do not destroy default VPCs.",
@@ -125,6 +138,8 @@ func CreateCli(version string) *cli.App {
 						Usage:   "Set log level",
 						EnvVars: []string{"LOG_LEVEL"},
 					},
+					LogFormatFlag(),
+					LogFileFlag(),
 				},
 			),
 		}, {
@@ -133,12 +148,18 @@ func CreateCli(version string) *cli.App {
 			Action: errors.WithPanicHandling(awsInspect),
 			Flags: CombineFlags(
 				RegionFlags(),
+				CassetteFlags(),
 				InspectResourceTypeFlags(),
 				CommonTimeFlags(),
 				TagFlags(),
 				CommonOutputFlags(),
 				[]cli.Flag{
 					ConfigFlag(),
+					PluginDirFlag(),
+					EndpointURLFlag(),
+					VpcIDFlag(),
+					OrphansOnlyFlag(),
+					ProtectTFStateFlag(),
 					&cli.BoolFlag{
 						Name:  FlagListUnaliasedKMSKeys,
 						Usage: "List KMS keys that do not have aliases associated with them.",
@@ -149,6 +170,26 @@ func CreateCli(version string) *cli.App {
 					},
 				},
 			),
+		}, {
+			Name:   "iam-policy",
+			Usage:  "Print the minimal IAM policy needed to nuke or inspect the selected AWS resource types",
+			Action: errors.WithPanicHandling(awsIAMPolicy),
+			Flags: CombineFlags(
+				CommonResourceTypeFlags(),
+				[]cli.Flag{
+					ConfigFlag(),
+					PluginDirFlag(),
+					EndpointURLFlag(),
+					&cli.BoolFlag{
+						Name:  FlagInspectOnly,
+						Usage: "Print the read-only policy needed by inspect-aws instead of the policy needed to nuke.",
+					},
+					&cli.BoolFlag{
+						Name:  FlagCheck,
+						Usage: "Instead of printing the policy, list the actions the current principal is not allowed, using iam:SimulatePrincipalPolicy.",
+					},
+				},
+			),
 		},
 	}
 
@@ -65,3 +65,11 @@ type DuplicateTagKeyError struct {
 func (e DuplicateTagKeyError) Error() string {
 	return fmt.Sprintf("Duplicate tag key '%s': each tag key may only be specified once", e.Key)
 }
+
+type MissingIAMActionsError struct {
+	Actions []string
+}
+
+func (e MissingIAMActionsError) Error() string {
+	return fmt.Sprintf("The current principal is not allowed %d of the required IAM actions", len(e.Actions))
+}
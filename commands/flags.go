@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"github.com/gruntwork-io/cloud-nuke/aws"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/urfave/cli/v2"
 )
@@ -10,6 +11,7 @@ const (
 	DefaultOutputFormat     = "table"
 	DefaultDuration         = "0s"
 	DefaultLogLevel         = "info"
+	DefaultLogFormat        = "text"
 	NukeConfirmationWord    = "nuke"
 	ForceNukeCountdown      = 10
 	MaxConfirmationAttempts = 2
@@ -20,6 +22,8 @@ const (
 const (
 	FlagListResourceTypes      = "list-resource-types"
 	FlagLogLevel               = "log-level"
+	FlagLogFormat              = "log-format"
+	FlagLogFile                = "log-file"
 	FlagConfig                 = "config"
 	FlagOlderThan              = "older-than"
 	FlagNewerThan              = "newer-than"
@@ -28,6 +32,7 @@ const (
 	FlagForce                  = "force"
 	FlagOutputFormat           = "output-format"
 	FlagOutputFile             = "output-file"
+	FlagOutputColumns          = "output-columns"
 	FlagDeleteUnaliasedKMSKeys = "delete-unaliased-kms-keys"
 	FlagListUnaliasedKMSKeys   = "list-unaliased-kms-keys"
 	FlagExcludeFirstSeen       = "exclude-first-seen"
@@ -39,6 +44,16 @@ const (
 	FlagExcludeRegion          = "exclude-region"
 	FlagIncludeTag             = "include-tag"
 	FlagParallelism            = "parallelism"
+	FlagPluginDir              = "plugin-dir"
+	FlagEndpointURL            = "endpoint-url"
+	FlagRecordCassette         = "record-cassette"
+	FlagReplayCassette         = "replay-cassette"
+	FlagInspectOnly            = "inspect"
+	FlagCheck                  = "check"
+	FlagVpcID                  = "vpc-id"
+	FlagOrphansOnly            = "orphans-only"
+	FlagProtectTFState         = "protect-tfstate"
+	FlagAction                 = "action"
 )
 
 // Common flag sets for reuse across commands
@@ -123,19 +138,43 @@ func CommonOutputFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
 			Name:  FlagOutputFormat,
-			Usage: "Output format (table, json)",
+			Usage: "Output format (table, json, html, csv, markdown, junit)",
 			Value: DefaultOutputFormat,
 		},
 		&cli.StringFlag{
 			Name:  FlagOutputFile,
 			Usage: "Write output to file instead of stdout (optional)",
 		},
+		&cli.StringSliceFlag{
+			Name:  FlagOutputColumns,
+			Usage: "Columns of the csv and markdown output formats, e.g. resource_type,identifier,status (optional)",
+		},
 		&cli.StringFlag{
 			Name:    FlagLogLevel,
 			Value:   DefaultLogLevel,
 			Usage:   "Set log level",
 			EnvVars: []string{"LOG_LEVEL"},
 		},
+		LogFormatFlag(),
+		LogFileFlag(),
+	}
+}
+
+// LogFormatFlag returns the flag selecting the log format
+func LogFormatFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    FlagLogFormat,
+		Value:   DefaultLogFormat,
+		Usage:   "Log format (text, json). json logs are written to stderr, or --log-file, apart from the interactive output",
+		EnvVars: []string{"LOG_FORMAT"},
+	}
+}
+
+// LogFileFlag returns the flag selecting the file logs are written to
+func LogFileFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  FlagLogFile,
+		Usage: "Append logs to file instead of printing them with the interactive output (optional)",
 	}
 }
 
@@ -147,6 +186,72 @@ func ConfigFlag() cli.Flag {
 	}
 }
 
+// PluginDirFlag returns the flag for loading out-of-process plugin resource types
+func PluginDirFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    FlagPluginDir,
+		Usage:   "Directory of plugin executables that add custom resource types.",
+		EnvVars: []string{"CLOUD_NUKE_PLUGIN_DIR"},
+	}
+}
+
+// EndpointURLFlag returns the flag for sending all AWS API calls to a custom endpoint
+func EndpointURLFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  FlagEndpointURL,
+		Usage: "Send all AWS API calls to this endpoint, e.g. http://localhost:4566 for LocalStack.",
+	}
+}
+
+// VpcIDFlag returns the flag for restricting VPC-scoped resource types to given VPCs
+func VpcIDFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  FlagVpcID,
+		Usage: "Only include resources inside this VPC. Restricts the run to VPC-scoped resource types. Include multiple times if more than one.",
+	}
+}
+
+// OrphansOnlyFlag returns the flag for restricting the run to orphaned resources
+func OrphansOnlyFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  FlagOrphansOnly,
+		Usage: "Only include resources that nothing uses, such as unattached volumes. Restricts the run to resource types that can detect orphans.",
+	}
+}
+
+// ActionFlag returns the flag for choosing what to do with the selected resources
+func ActionFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  FlagAction,
+		Value: aws.ActionDelete,
+		Usage: "What to do with the selected resources: delete, suspend (stop or scale to zero, recording the previous state in a tag) or resume (restore suspended resources). Suspend and resume skip resource types that cannot be suspended.",
+	}
+}
+
+// ProtectTFStateFlag returns the flag for protecting the resources recorded in Terraform state files
+func ProtectTFStateFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  FlagProtectTFState,
+		Usage: "Never nuke resources managed by this Terraform state file, which may also be `terraform show -json` output. Include multiple times if more than one.",
+	}
+}
+
+// CassetteFlags returns the hidden flags for recording and replaying API traffic (see the cassette package)
+func CassetteFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:   FlagRecordCassette,
+			Usage:  "Record every AWS and GCP HTTP exchange, scrubbed of credentials, to this cassette file.",
+			Hidden: true,
+		},
+		&cli.StringFlag{
+			Name:   FlagReplayCassette,
+			Usage:  "Serve every AWS and GCP HTTP exchange from this cassette file instead of the real APIs.",
+			Hidden: true,
+		},
+	}
+}
+
 // RegionFlags returns region-related flags (applicable to both AWS and GCP)
 func RegionFlags() []cli.Flag {
 	return []cli.Flag{
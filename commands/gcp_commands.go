@@ -7,7 +7,6 @@ import (
 	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"github.com/gruntwork-io/go-commons/errors"
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 	"github.com/urfave/cli/v2"
 )
 
@@ -20,15 +19,24 @@ import (
 func gcpNuke(c *cli.Context) error {
 	defer telemetry.TrackCommandLifecycle("gcp")()
 
+	// Record or replay API traffic when a hidden cassette flag is set
+	cas, err := startCassette(c)
+	if err != nil {
+		return err
+	}
+	defer stopCassette(cas)
+
 	// Handle the --list-resource-types flag
 	if c.Bool(FlagListResourceTypes) {
 		return handleListGcpResourceTypes()
 	}
 
-	// Parse and set log level
-	if err := parseLogLevel(c); err != nil {
+	// Parse and set log level, format and file
+	closeLog, err := setupLogging(c)
+	if err != nil {
 		return err
 	}
+	defer closeLog()
 
 	// Load config file if provided
 	configObj, err := loadConfigFile(c.String(FlagConfig))
@@ -45,6 +53,11 @@ func gcpNuke(c *cli.Context) error {
 		ExcludeFirstSeen:     c.Bool(FlagExcludeFirstSeen),
 		Parallelism:          c.Int(FlagParallelism),
 	}
+	if cas != nil {
+		if query.HTTPClient, err = cas.GCPHTTPClient(c.Context); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
 
 	// Apply timeout to config
 	if err := parseAndApplyTimeout(c, &configObj); err != nil {
@@ -72,15 +85,24 @@ func gcpNuke(c *cli.Context) error {
 func gcpInspect(c *cli.Context) error {
 	defer telemetry.TrackCommandLifecycle("gcp-inspect")()
 
+	// Record or replay API traffic when a hidden cassette flag is set
+	cas, err := startCassette(c)
+	if err != nil {
+		return err
+	}
+	defer stopCassette(cas)
+
 	// Handle the --list-resource-types flag
 	if c.Bool(FlagListResourceTypes) {
 		return handleListGcpResourceTypes()
 	}
 
-	// Parse and set log level
-	if err := parseLogLevel(c); err != nil {
+	// Parse and set log level, format and file
+	closeLog, err := setupLogging(c)
+	if err != nil {
 		return err
 	}
+	defer closeLog()
 
 	query := &gcp.Query{
 		ProjectID:            c.String(FlagProjectID),
@@ -91,6 +113,11 @@ func gcpInspect(c *cli.Context) error {
 		ExcludeFirstSeen:     c.Bool(FlagExcludeFirstSeen),
 		Parallelism:          c.Int(FlagParallelism),
 	}
+	if cas != nil {
+		if query.HTTPClient, err = cas.GCPHTTPClient(c.Context); err != nil {
+			return errors.WithStackTrace(err)
+		}
+	}
 
 	// Load config file if provided
 	configObj, err := loadConfigFile(c.String(FlagConfig))
@@ -128,7 +155,7 @@ func gcpInspect(c *cli.Context) error {
 // It retrieves resources, confirms deletion with the user, and executes the nuke operation.
 func gcpNukeHelper(c *cli.Context, configObj config.Config, query *gcp.Query, outputFormat string, outputFile string) error {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupGcpReporting(outputFormat, outputFile, query.ProjectID)
+	collector, cleanup, err := setupGcpReporting(outputFormat, outputFile, c.StringSlice(FlagOutputColumns), query.ProjectID)
 	if err != nil {
 		return err
 	}
@@ -137,9 +164,7 @@ func gcpNukeHelper(c *cli.Context, configObj config.Config, query *gcp.Query, ou
 	// Retrieve all matching resources (emits ResourceFound events via collector)
 	account, err := gcp.GetAllResources(c.Context, query, configObj, collector)
 	if err != nil {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error getting resources",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.GetResourcesError())
 		return errors.WithStackTrace(err)
 	}
 
@@ -147,14 +172,17 @@ func gcpNukeHelper(c *cli.Context, configObj config.Config, query *gcp.Query, ou
 	collector.Emit(reporting.ScanComplete{})
 
 	// Confirm with user before proceeding (unless --force or --dry-run is set)
-	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0)
+	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0, "")
 	if err != nil {
 		return err
 	}
 
 	// Execute the nuke operation if confirmed
 	if shouldProceed {
-		if err := gcp.NukeAllResources(c.Context, account, query.Regions, query.Parallelism, collector); err != nil {
+		// Interrupts are handled only once confirmed, so that interrupting the prompt still exits
+		ctx, stop := handleInterrupts(c.Context)
+		defer stop()
+		if err := gcp.NukeAllResources(ctx, account, query.Regions, query.Parallelism, collector); err != nil {
 			return err
 		}
 	}
@@ -167,7 +195,7 @@ func gcpNukeHelper(c *cli.Context, configObj config.Config, query *gcp.Query, ou
 func handleGetGcpResourcesWithFormat(c *cli.Context, configObj config.Config, query *gcp.Query, outputFormat string, outputFile string) (
 	*gcp.GcpProjectResources, error) {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupGcpReporting(outputFormat, outputFile, query.ProjectID)
+	collector, cleanup, err := setupGcpReporting(outputFormat, outputFile, c.StringSlice(FlagOutputColumns), query.ProjectID)
 	if err != nil {
 		return nil, err
 	}
@@ -176,9 +204,7 @@ func handleGetGcpResourcesWithFormat(c *cli.Context, configObj config.Config, qu
 	// Retrieve all resources matching the filters (emits ResourceFound events via collector)
 	accountResources, err := gcp.GetAllResources(c.Context, query, configObj, collector)
 	if err != nil {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error inspecting resources",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.InspectResourcesError())
 		return nil, errors.WithStackTrace(err)
 	}
 
@@ -195,10 +221,11 @@ func handleListGcpResourceTypes() error {
 
 // setupGcpReporting creates a collector and appropriate renderer for GCP operations.
 // Returns the collector, cleanup function (which calls Complete() and closes writer), and any error.
-func setupGcpReporting(outputFormat string, outputFile string, projectID string) (
+func setupGcpReporting(outputFormat string, outputFile string, columns []string, projectID string) (
 	*reporting.Collector, func(), error) {
 	return setupReporting(outputFormat, outputFile, renderers.JSONRendererConfig{
 		Command: "gcp",
 		Regions: []string{projectID},
+		Columns: columns,
 	})
 }
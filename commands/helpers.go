@@ -1,40 +1,94 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"regexp"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/cassette"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/gruntwork-io/cloud-nuke/tfstate"
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/errors"
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 	"github.com/urfave/cli/v2"
 )
 
+// startCassette records or replays every AWS and GCP HTTP exchange when one of the hidden cassette
+// flags is set, and returns nil otherwise. Callers must defer stopCassette.
+func startCassette(c *cli.Context) (*cassette.Transport, error) {
+	record, replay := c.String(FlagRecordCassette), c.String(FlagReplayCassette)
+	var t *cassette.Transport
+	switch {
+	case record != "" && replay != "":
+		return nil, errors.WithStackTrace(fmt.Errorf("--%s and --%s cannot be used together", FlagRecordCassette, FlagReplayCassette))
+	case record != "":
+		t = cassette.NewRecorder(record, nil)
+	case replay != "":
+		var err error
+		if t, err = cassette.NewReplayer(replay); err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	default:
+		return nil, nil
+	}
+	t.UseForAWS()
+	return t, nil
+}
+
+// stopCassette restores the default AWS HTTP client and writes a recorded cassette.
+func stopCassette(t *cassette.Transport) {
+	if t == nil {
+		return
+	}
+	if err := t.Stop(); err != nil {
+		logging.Errorf("Unable to write cassette: %v", err)
+	}
+}
+
 // loadConfigFile loads and parses a config file from the given path
 func loadConfigFile(configFilePath string) (config.Config, error) {
 	if configFilePath == "" {
 		return config.Config{}, nil
 	}
 
-	telemetry.TrackEvent(commonTelemetry.EventContext{
-		EventName: "Reading config file",
-	}, map[string]interface{}{})
+	telemetry.Track(telemetry.ConfigRead())
 
 	configObjPtr, err := config.GetConfig(configFilePath)
 	if err != nil {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error reading config file",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.ConfigReadError())
 		return config.Config{}, ConfigFileReadError{FilePath: configFilePath, Underlying: err}
 	}
 
 	return *configObjPtr, nil
 }
 
+// loadTerraformProtection reads the given Terraform state files and returns the reason every
+// identifier they record is protected, e.g. "managed by terraform: aws_s3_bucket.logs".
+func loadTerraformProtection(statePaths []string) (map[string]string, error) {
+	if len(statePaths) == 0 {
+		return nil, nil
+	}
+
+	addresses, err := tfstate.Load(statePaths)
+	if err != nil {
+		return nil, err
+	}
+	protected := make(map[string]string, len(addresses))
+	for id, address := range addresses {
+		protected[id] = "managed by terraform: " + address
+	}
+	logging.Debugf("Protecting %d identifiers recorded in Terraform state", len(protected))
+	return protected, nil
+}
+
 // parseAndApplyTimeFilters parses time filter flags and applies them to the config
 func parseAndApplyTimeFilters(c *cli.Context, configObj *config.Config) error {
 	excludeAfter, err := parseDurationParam(FlagOlderThan, c.String(FlagOlderThan))
@@ -69,43 +123,38 @@ func parseAndApplyTimeout(c *cli.Context, configObj *config.Config) error {
 
 // confirmNuke handles the nuke confirmation prompt and countdown
 // Returns true if the nuke should proceed, false otherwise
-func confirmNuke(c *cli.Context, hasResources bool) (bool, error) {
+func confirmNuke(c *cli.Context, hasResources bool, action string) (bool, error) {
+	verb := "nuke"
+	if action != "" && action != aws.ActionDelete {
+		verb = action
+	}
+
 	if !hasResources {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "No resources to nuke",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.NoResourcesToNuke())
 		logging.Info("Nothing to nuke, you're all good!")
 		return false, nil
 	}
 
 	if c.Bool(FlagDryRun) {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Skipping nuke, dryrun set",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.DryRunSkipped())
 		logging.Info("Not taking any action as dry-run set to true.")
 		return false, nil
 	}
 
 	if !c.Bool(FlagForce) {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Awaiting nuke confirmation",
-		}, map[string]interface{}{})
+		telemetry.Track(telemetry.NukeConfirmationAwaited())
 
-		promptMessage := fmt.Sprintf("\nAre you sure you want to nuke all listed resources? Enter '%s' to confirm (or exit with ^C) ",
-			NukeConfirmationWord)
+		promptMessage := fmt.Sprintf("\nAre you sure you want to %s all listed resources? Enter '%s' to confirm (or exit with ^C) ",
+			verb, NukeConfirmationWord)
 
 		proceed, err := renderNukeConfirmationPrompt(promptMessage, MaxConfirmationAttempts)
 		if err != nil {
-			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "Error confirming nuke",
-			}, map[string]interface{}{})
+			telemetry.Track(telemetry.NukeConfirmationError())
 			return false, err
 		}
 
 		if !proceed {
-			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: "User aborted nuke",
-			}, map[string]interface{}{})
+			telemetry.Track(telemetry.NukeAborted())
 			return false, nil
 		}
 
@@ -113,12 +162,10 @@ func confirmNuke(c *cli.Context, hasResources bool) (bool, error) {
 	}
 
 	// Force flag is set
-	telemetry.TrackEvent(commonTelemetry.EventContext{
-		EventName: "Forcing nuke in 10 seconds",
-	}, map[string]interface{}{})
+	telemetry.Track(telemetry.NukeForced())
 
-	warningMessage := fmt.Sprintf("The --force flag is set, so waiting for %d seconds before proceeding to nuke everything. If you don't want to proceed, hit CTRL+C now!!",
-		ForceNukeCountdown)
+	warningMessage := fmt.Sprintf("The --force flag is set, so waiting for %d seconds before proceeding to %s everything. If you don't want to proceed, hit CTRL+C now!!",
+		ForceNukeCountdown, verb)
 	logging.Info(warningMessage)
 
 	for i := ForceNukeCountdown; i > 0; i-- {
@@ -143,6 +190,69 @@ func parseLogLevel(c *cli.Context) error {
 	return nil
 }
 
+// setupLogging sets the log level, format and file from CLI context. The returned function closes
+// the log file.
+func setupLogging(c *cli.Context) (func(), error) {
+	if err := parseLogLevel(c); err != nil {
+		return nil, err
+	}
+
+	closeFn, err := logging.Configure(c.String(FlagLogFormat), c.String(FlagLogFile), telemetry.RunID())
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return func() {
+		if err := closeFn(); err != nil {
+			logging.Errorf("Failed to close log file: %v", err)
+		}
+	}, nil
+}
+
+// exitCodeInterrupted is the exit code when a second signal forces cloud-nuke to exit.
+const exitCodeInterrupted = 130
+
+// handleInterrupts returns a context that is canceled with util.ErrInterrupted on the first SIGINT or
+// SIGTERM, so that a nuke operation stops starting new batches and reports what it did. A second
+// signal exits immediately. The returned function stops handling signals.
+func handleInterrupts(ctx context.Context) (context.Context, func()) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	ctx, stop := watchInterrupts(ctx, signals, os.Exit)
+	return ctx, func() {
+		signal.Stop(signals)
+		stop()
+	}
+}
+
+// watchInterrupts cancels the returned context on the first signal received and calls exit on the
+// second, until the returned function is called.
+func watchInterrupts(ctx context.Context, signals <-chan os.Signal, exit func(int)) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-signals:
+		case <-done:
+			return
+		}
+		logging.Warn("Interrupted: finishing the deletions in progress and reporting partial results. Interrupt again to exit immediately.")
+		cancel(util.ErrInterrupted)
+
+		select {
+		case <-signals:
+			logging.Error("Interrupted again, exiting immediately")
+			exit(exitCodeInterrupted)
+		case <-done:
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		cancel(nil)
+	}
+}
+
 // parseDurationParam parses a duration string (e.g., "10h", "5d") and converts it to a time.Time
 // representing a point in the past. This is used for --older-than and --newer-than flags.
 // Returns nil if the paramValue is empty or the default value.
@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
+	"os"
 	"testing"
 
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +86,25 @@ func TestParseTagFlags(t *testing.T) {
 		assert.False(t, expr.RE.MatchString("dev-staging"), "anchored regex should not match partial")
 	})
 }
+
+func TestWatchInterrupts(t *testing.T) {
+	signals := make(chan os.Signal, 2)
+	exited := make(chan int, 1)
+	ctx, stop := watchInterrupts(context.Background(), signals, func(code int) { exited <- code })
+	defer stop()
+
+	// The first signal cancels the context
+	signals <- os.Interrupt
+	<-ctx.Done()
+	require.ErrorIs(t, context.Cause(ctx), util.ErrInterrupted)
+
+	// The second signal forces exit
+	signals <- os.Interrupt
+	require.Equal(t, exitCodeInterrupted, <-exited)
+}
+
+func TestWatchInterrupts_Stop(t *testing.T) {
+	ctx, stop := watchInterrupts(context.Background(), make(chan os.Signal), func(int) { t.Fatal("unexpected exit") })
+	stop()
+	require.ErrorIs(t, context.Cause(ctx), context.Canceled)
+}
@@ -9,9 +9,13 @@ import (
 
 // setupReporting creates a collector and appropriate renderer based on output format.
 // Returns the collector, cleanup function (which calls Complete() and closes writer), and any error.
-// The jsonConfig is used when outputFormat is "json"; ignored otherwise.
+// The jsonConfig is used for every format but the default table; ignored otherwise.
 func setupReporting(outputFormat string, outputFile string, jsonConfig renderers.JSONRendererConfig) (
 	*reporting.Collector, func(), error) {
+	if err := renderers.ValidateColumns(jsonConfig.Columns); err != nil {
+		return nil, nil, err
+	}
+
 	writer, writerCleanup, err := renderers.GetOutputWriter(outputFile)
 	if err != nil {
 		return nil, nil, err
@@ -27,9 +31,22 @@ func setupReporting(outputFormat string, outputFile string, jsonConfig renderers
 		}
 	}
 
-	if outputFormat == "json" {
+	switch outputFormat {
+	case "json":
 		collector.AddRenderer(renderers.NewJSONRenderer(writer, jsonConfig))
 		return collector, cleanup, nil
+	case "html":
+		collector.AddRenderer(renderers.NewHTMLRenderer(writer, jsonConfig))
+		return collector, cleanup, nil
+	case "csv":
+		collector.AddRenderer(renderers.NewCSVRenderer(writer, jsonConfig))
+		return collector, cleanup, nil
+	case "markdown":
+		collector.AddRenderer(renderers.NewMarkdownRenderer(writer, jsonConfig))
+		return collector, cleanup, nil
+	case "junit":
+		collector.AddRenderer(renderers.NewJUnitRenderer(writer, jsonConfig))
+		return collector, cleanup, nil
 	}
 
 	// CLI format
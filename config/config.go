@@ -1,10 +1,18 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -17,149 +25,191 @@ const (
 	CloudNukeAfterExclusionTagKey       = "cloud-nuke-after"
 	CloudNukeAfterTimeFormat            = time.RFC3339
 	CloudNukeAfterTimeFormatLegacy      = time.DateTime
+	// CloudNukeTTLTagKey is the default tag whose duration value protects a resource until that long
+	// after it was created, e.g. "72h" or "14d".
+	CloudNukeTTLTagKey = "cloud-nuke-ttl"
+	// CloudNukeFirstSeenTagKey is the tag cloud-nuke stamps on resources without a creation time. It
+	// stands in for the creation time of TTL tags.
+	CloudNukeFirstSeenTagKey = "cloud-nuke-first-seen"
+
+	// CloudFormationStackIDTagKey is the tag CloudFormation adds to every resource a stack creates.
+	CloudFormationStackIDTagKey = "aws:cloudformation:stack-id"
+	// CloudFormationMembersProtect excludes resources that belong to a CloudFormation stack.
+	CloudFormationMembersProtect = "protect"
+	// CloudFormationMembersStackFirst excludes resources that belong to a CloudFormation stack and
+	// deletes their owning stacks instead.
+	CloudFormationMembersStackFirst = "stack-first"
 )
 
 // Config - the config object we pass around
 type Config struct {
-	ACM                             ResourceType               `yaml:"ACM"`
-	ACMPCA                          ResourceType               `yaml:"ACMPCA"`
-	AMI                             ResourceType               `yaml:"AMI"`
-	APIGateway                      ResourceType               `yaml:"APIGateway"`
-	APIGatewayV2                    ResourceType               `yaml:"APIGatewayV2"`
-	AccessAnalyzer                  ResourceType               `yaml:"AccessAnalyzer"`
-	AutoScalingGroup                ResourceType               `yaml:"AutoScalingGroup"`
-	AppRunnerService                ResourceType               `yaml:"AppRunnerService"`
-	BackupPlan                      ResourceType               `yaml:"BackupPlan"`
-	BackupVault                     ResourceType               `yaml:"BackupVault"`
-	ManagedPrometheus               ResourceType               `yaml:"ManagedPrometheus"`
-	CloudWatchAlarm                 ResourceType               `yaml:"CloudWatchAlarm"`
-	CloudWatchDashboard             ResourceType               `yaml:"CloudWatchDashboard"`
-	CloudWatchLogGroup              ResourceType               `yaml:"CloudWatchLogGroup"`
-	CloudMapNamespace               ResourceType               `yaml:"CloudMapNamespace"`
-	CloudMapService                 ResourceType               `yaml:"CloudMapService"`
-	CloudTrailTrail                 ResourceType               `yaml:"CloudTrailTrail"`
-	CloudFrontDistribution          ResourceType               `yaml:"CloudFrontDistribution"`
-	CloudFormationStack             ResourceType               `yaml:"CloudFormationStack"`
-	CodeDeployApplications          ResourceType               `yaml:"CodeDeployApplications"`
-	ConfigServiceRecorder           ResourceType               `yaml:"ConfigServiceRecorder"`
-	ConfigServiceRule               ResourceType               `yaml:"ConfigServiceRule"`
-	DataPipeline                    ResourceType               `yaml:"DataPipeline"`
-	DataSyncLocation                ResourceType               `yaml:"DataSyncLocation"`
-	DataSyncTask                    ResourceType               `yaml:"DataSyncTask"`
-	DBGlobalClusters                ResourceType               `yaml:"DBGlobalClusters"`
-	DBClusters                      AWSProtectableResourceType `yaml:"DBClusters"`
-	DBInstances                     AWSProtectableResourceType `yaml:"DBInstances"`
-	DBGlobalClusterMemberships      ResourceType               `yaml:"DBGlobalClusterMemberships"`
-	DBSubnetGroups                  ResourceType               `yaml:"DBSubnetGroups"`
-	DynamoDB                        ResourceType               `yaml:"DynamoDB"`
-	EBSVolume                       ResourceType               `yaml:"EBSVolume"`
-	ElasticBeanstalk                ResourceType               `yaml:"ElasticBeanstalk"`
-	EC2                             ResourceType               `yaml:"EC2"`
-	EC2DedicatedHosts               ResourceType               `yaml:"EC2DedicatedHosts"`
-	EC2DHCPOption                   ResourceType               `yaml:"EC2DHCPOption"`
-	EC2KeyPairs                     ResourceType               `yaml:"EC2KeyPairs"`
-	EC2IPAM                         ResourceType               `yaml:"EC2IPAM"`
-	EC2IPAMByoasn                   ResourceType               `yaml:"EC2IPAMByoasn"`
-	EC2IPAMCustomAllocation         ResourceType               `yaml:"EC2IPAMCustomAllocation"`
-	EC2IPAMPool                     ResourceType               `yaml:"EC2IPAMPool"`
-	EC2IPAMResourceDiscovery        ResourceType               `yaml:"EC2IPAMResourceDiscovery"`
-	EC2IPAMScope                    ResourceType               `yaml:"EC2IPAMScope"`
-	EC2Endpoint                     EC2ResourceType            `yaml:"EC2Endpoint"`
-	EC2Subnet                       EC2ResourceType            `yaml:"EC2Subnet"`
-	EC2PlacementGroups              ResourceType               `yaml:"EC2PlacementGroups"`
-	EgressOnlyInternetGateway       ResourceType               `yaml:"EgressOnlyInternetGateway"`
-	ECRRepository                   ResourceType               `yaml:"ECRRepository"`
-	ECSCluster                      ResourceType               `yaml:"ECSCluster"`
-	ECSService                      ResourceType               `yaml:"ECSService"`
-	EKSCluster                      ResourceType               `yaml:"EKSCluster"`
-	ELBv1                           ResourceType               `yaml:"ELBv1"`
-	ELBv2                           ResourceType               `yaml:"ELBv2"`
-	ElasticFileSystem               ResourceType               `yaml:"ElasticFileSystem"`
-	ElasticIP                       ResourceType               `yaml:"ElasticIP"`
-	ElastiCache                     ResourceType               `yaml:"ElastiCache"`
-	ElastiCacheParameterGroup       ResourceType               `yaml:"ElastiCacheParameterGroup"`
-	ElastiCacheServerless           ResourceType               `yaml:"ElastiCacheServerless"`
-	ElastiCacheSubnetGroup          ResourceType               `yaml:"ElastiCacheSubnetGroup"`
-	EventBridge                     ResourceType               `yaml:"EventBridge"`
-	EventBridgeArchive              ResourceType               `yaml:"EventBridgeArchive"`
-	EventBridgeRule                 ResourceType               `yaml:"EventBridgeRule"`
-	EventBridgeSchedule             ResourceType               `yaml:"EventBridgeSchedule"`
-	EventBridgeScheduleGroup        ResourceType               `yaml:"EventBridgeScheduleGroup"`
-	Grafana                         ResourceType               `yaml:"Grafana"`
-	GuardDuty                       ResourceType               `yaml:"GuardDuty"`
-	IAMGroups                       ResourceType               `yaml:"IAMGroups"`
-	IAMPolicies                     ResourceType               `yaml:"IAMPolicies"`
-	IAMInstanceProfiles             ResourceType               `yaml:"IAMInstanceProfiles"`
-	IAMRoles                        ResourceType               `yaml:"IAMRoles"`
-	IAMServiceLinkedRoles           ResourceType               `yaml:"IAMServiceLinkedRoles"`
-	IAMUsers                        ResourceType               `yaml:"IAMUsers"`
-	KMSCustomerKeys                 KMSCustomerKeyResourceType `yaml:"KMSCustomerKeys"`
-	KinesisStream                   ResourceType               `yaml:"KinesisStream"`
-	KinesisFirehose                 ResourceType               `yaml:"KinesisFirehose"`
-	LambdaFunction                  ResourceType               `yaml:"LambdaFunction"`
-	LambdaLayer                     ResourceType               `yaml:"LambdaLayer"`
-	LaunchConfiguration             ResourceType               `yaml:"LaunchConfiguration"`
-	LaunchTemplate                  ResourceType               `yaml:"LaunchTemplate"`
-	MacieMember                     ResourceType               `yaml:"MacieMember"`
-	MSKCluster                      ResourceType               `yaml:"MSKCluster"`
-	MQBroker                        ResourceType               `yaml:"MQBroker"`
-	NATGateway                      EC2ResourceType            `yaml:"NATGateway"`
-	OIDCProvider                    ResourceType               `yaml:"OIDCProvider"`
-	OpenSearchDomain                ResourceType               `yaml:"OpenSearchDomain"`
-	Redshift                        ResourceType               `yaml:"Redshift"`
-	RedshiftSnapshotCopyGrant       ResourceType               `yaml:"RedshiftSnapshotCopyGrant"`
-	ResourceShare                   ResourceType               `yaml:"ResourceShare"`
-	RDSSnapshot                     ResourceType               `yaml:"RDSSnapshot"`
-	RDSClusterSnapshot              ResourceType               `yaml:"RDSClusterSnapshot"`
-	RDSParameterGroup               ResourceType               `yaml:"RDSParameterGroup"`
-	RDSProxy                        ResourceType               `yaml:"RDSProxy"`
-	S3                              ResourceType               `yaml:"S3"`
-	S3AccessPoint                   ResourceType               `yaml:"S3AccessPoint"`
-	S3ObjectLambdaAccessPoint       ResourceType               `yaml:"S3ObjectLambdaAccessPoint"`
-	S3MultiRegionAccessPoint        ResourceType               `yaml:"S3MultiRegionAccessPoint"`
-	SESIdentity                     ResourceType               `yaml:"SESIdentity"`
-	SESConfigurationSet             ResourceType               `yaml:"SESConfigurationSet"`
-	SESReceiptRuleSet               ResourceType               `yaml:"SESReceiptRuleSet"`
-	SESReceiptFilter                ResourceType               `yaml:"SESReceiptFilter"`
-	SESEmailTemplates               ResourceType               `yaml:"SESEmailTemplates"`
-	SNS                             ResourceType               `yaml:"SNS"`
-	SQS                             ResourceType               `yaml:"SQS"`
-	SageMakerEndpoint               ResourceType               `yaml:"SageMakerEndpoint"`
-	SageMakerEndpointConfig         ResourceType               `yaml:"SageMakerEndpointConfig"`
-	SageMakerNotebook               ResourceType               `yaml:"SageMakerNotebook"`
-	SageMakerStudioDomain           ResourceType               `yaml:"SageMakerStudioDomain"`
-	SecretsManager                  ResourceType               `yaml:"SecretsManager"`
-	SSMParameter                    ResourceType               `yaml:"SSMParameter"`
-	SecurityHub                     ResourceType               `yaml:"SecurityHub"`
-	Snapshots                       ResourceType               `yaml:"Snapshots"`
-	TransitGateway                  ResourceType               `yaml:"TransitGateway"`
-	TransitGatewayRouteTable        ResourceType               `yaml:"TransitGatewayRouteTable"`
-	TransitGatewayVPCAttachment     ResourceType               `yaml:"TransitGatewayVPCAttachment"`
-	TransitGatewayPeeringAttachment ResourceType               `yaml:"TransitGatewayPeeringAttachment"`
-	VPC                             EC2ResourceType            `yaml:"VPC"`
-	Route53HostedZone               ResourceType               `yaml:"Route53HostedZone"`
-	Route53CIDRCollection           ResourceType               `yaml:"Route53CIDRCollection"`
-	Route53TrafficPolicy            ResourceType               `yaml:"Route53TrafficPolicy"`
-	InternetGateway                 EC2ResourceType            `yaml:"InternetGateway"`
-	NetworkACL                      ResourceType               `yaml:"NetworkACL"`
-	NetworkInterface                EC2ResourceType            `yaml:"NetworkInterface"`
-	SecurityGroup                   EC2ResourceType            `yaml:"SecurityGroup"`
-	NetworkFirewall                 ResourceType               `yaml:"NetworkFirewall"`
-	NetworkFirewallPolicy           ResourceType               `yaml:"NetworkFirewallPolicy"`
-	NetworkFirewallRuleGroup        ResourceType               `yaml:"NetworkFirewallRuleGroup"`
-	NetworkFirewallTLSConfig        ResourceType               `yaml:"NetworkFirewallTLSConfig"`
-	NetworkFirewallResourcePolicy   ResourceType               `yaml:"NetworkFirewallResourcePolicy"`
-	VPCLatticeServiceNetwork        ResourceType               `yaml:"VPCLatticeServiceNetwork"`
-	VPCLatticeService               ResourceType               `yaml:"VPCLatticeService"`
-	VPCLatticeTargetGroup           ResourceType               `yaml:"VPCLatticeTargetGroup"`
-	RouteTable                      EC2ResourceType            `yaml:"RouteTable"`
-	VPCPeeringConnection            ResourceType               `yaml:"VPCPeeringConnection"`
+	ACM                             ResourceType                    `yaml:"ACM"`
+	ACMPCA                          ResourceType                    `yaml:"ACMPCA"`
+	AMI                             RetentionResourceType           `yaml:"AMI"`
+	APIGateway                      ResourceType                    `yaml:"APIGateway"`
+	APIGatewayV2                    ResourceType                    `yaml:"APIGatewayV2"`
+	AccessAnalyzer                  ResourceType                    `yaml:"AccessAnalyzer"`
+	AutoScalingGroup                ResourceType                    `yaml:"AutoScalingGroup"`
+	AppRunnerService                ResourceType                    `yaml:"AppRunnerService"`
+	BackupPlan                      ResourceType                    `yaml:"BackupPlan"`
+	BackupVault                     ResourceType                    `yaml:"BackupVault"`
+	ManagedPrometheus               ResourceType                    `yaml:"ManagedPrometheus"`
+	CloudWatchAlarm                 ResourceType                    `yaml:"CloudWatchAlarm"`
+	CloudWatchDashboard             ResourceType                    `yaml:"CloudWatchDashboard"`
+	CloudWatchLogGroup              CloudWatchLogGroupResourceType  `yaml:"CloudWatchLogGroup"`
+	CloudMapNamespace               ResourceType                    `yaml:"CloudMapNamespace"`
+	CloudMapService                 ResourceType                    `yaml:"CloudMapService"`
+	CloudTrailTrail                 ResourceType                    `yaml:"CloudTrailTrail"`
+	CloudFrontDistribution          ResourceType                    `yaml:"CloudFrontDistribution"`
+	CloudFormationStack             CloudFormationStackResourceType `yaml:"CloudFormationStack"`
+	CodeDeployApplications          ResourceType                    `yaml:"CodeDeployApplications"`
+	ConfigServiceRecorder           ResourceType                    `yaml:"ConfigServiceRecorder"`
+	ConfigServiceRule               ResourceType                    `yaml:"ConfigServiceRule"`
+	DataPipeline                    ResourceType                    `yaml:"DataPipeline"`
+	DataSyncLocation                ResourceType                    `yaml:"DataSyncLocation"`
+	DataSyncTask                    ResourceType                    `yaml:"DataSyncTask"`
+	DBGlobalClusters                ResourceType                    `yaml:"DBGlobalClusters"`
+	DBClusters                      AWSProtectableResourceType      `yaml:"DBClusters"`
+	DBInstances                     AWSProtectableResourceType      `yaml:"DBInstances"`
+	DBGlobalClusterMemberships      ResourceType                    `yaml:"DBGlobalClusterMemberships"`
+	DBSubnetGroups                  ResourceType                    `yaml:"DBSubnetGroups"`
+	DynamoDB                        ResourceType                    `yaml:"DynamoDB"`
+	EBSVolume                       ResourceType                    `yaml:"EBSVolume"`
+	ElasticBeanstalk                ResourceType                    `yaml:"ElasticBeanstalk"`
+	EC2                             EC2ResourceType                 `yaml:"EC2"`
+	EC2DedicatedHosts               ResourceType                    `yaml:"EC2DedicatedHosts"`
+	EC2DHCPOption                   ResourceType                    `yaml:"EC2DHCPOption"`
+	EC2KeyPairs                     ResourceType                    `yaml:"EC2KeyPairs"`
+	EC2IPAM                         ResourceType                    `yaml:"EC2IPAM"`
+	EC2IPAMByoasn                   ResourceType                    `yaml:"EC2IPAMByoasn"`
+	EC2IPAMCustomAllocation         ResourceType                    `yaml:"EC2IPAMCustomAllocation"`
+	EC2IPAMPool                     ResourceType                    `yaml:"EC2IPAMPool"`
+	EC2IPAMResourceDiscovery        ResourceType                    `yaml:"EC2IPAMResourceDiscovery"`
+	EC2IPAMScope                    ResourceType                    `yaml:"EC2IPAMScope"`
+	EC2Endpoint                     EC2ResourceType                 `yaml:"EC2Endpoint"`
+	EC2Subnet                       EC2ResourceType                 `yaml:"EC2Subnet"`
+	EC2PlacementGroups              ResourceType                    `yaml:"EC2PlacementGroups"`
+	EgressOnlyInternetGateway       ResourceType                    `yaml:"EgressOnlyInternetGateway"`
+	ECRRepository                   ResourceType                    `yaml:"ECRRepository"`
+	ECRImage                        ECRImageResourceType            `yaml:"ECRImage"`
+	ECSCluster                      ResourceType                    `yaml:"ECSCluster"`
+	ECSService                      ResourceType                    `yaml:"ECSService"`
+	EKSCluster                      EC2ResourceType                 `yaml:"EKSCluster"`
+	ELBv1                           EC2ResourceType                 `yaml:"ELBv1"`
+	ELBv2                           EC2ResourceType                 `yaml:"ELBv2"`
+	ElasticFileSystem               ResourceType                    `yaml:"ElasticFileSystem"`
+	ElasticIP                       ResourceType                    `yaml:"ElasticIP"`
+	ElastiCache                     EC2ResourceType                 `yaml:"ElastiCache"`
+	ElastiCacheParameterGroup       ResourceType                    `yaml:"ElastiCacheParameterGroup"`
+	ElastiCacheServerless           ResourceType                    `yaml:"ElastiCacheServerless"`
+	ElastiCacheSubnetGroup          ResourceType                    `yaml:"ElastiCacheSubnetGroup"`
+	EventBridge                     ResourceType                    `yaml:"EventBridge"`
+	EventBridgeArchive              ResourceType                    `yaml:"EventBridgeArchive"`
+	EventBridgeRule                 ResourceType                    `yaml:"EventBridgeRule"`
+	EventBridgeSchedule             ResourceType                    `yaml:"EventBridgeSchedule"`
+	EventBridgeScheduleGroup        ResourceType                    `yaml:"EventBridgeScheduleGroup"`
+	Grafana                         ResourceType                    `yaml:"Grafana"`
+	GuardDuty                       ResourceType                    `yaml:"GuardDuty"`
+	IAMGroups                       ResourceType                    `yaml:"IAMGroups"`
+	IAMPolicies                     ResourceType                    `yaml:"IAMPolicies"`
+	IAMInstanceProfiles             ResourceType                    `yaml:"IAMInstanceProfiles"`
+	IAMRoles                        ResourceType                    `yaml:"IAMRoles"`
+	IAMServiceLinkedRoles           ResourceType                    `yaml:"IAMServiceLinkedRoles"`
+	IAMUsers                        ResourceType                    `yaml:"IAMUsers"`
+	KMSCustomerKeys                 KMSCustomerKeyResourceType      `yaml:"KMSCustomerKeys"`
+	KinesisStream                   ResourceType                    `yaml:"KinesisStream"`
+	KinesisFirehose                 ResourceType                    `yaml:"KinesisFirehose"`
+	LambdaFunction                  EC2ResourceType                 `yaml:"LambdaFunction"`
+	LambdaLayer                     ResourceType                    `yaml:"LambdaLayer"`
+	LambdaLayerVersion              RetentionResourceType           `yaml:"LambdaLayerVersion"`
+	LambdaVersion                   RetentionResourceType           `yaml:"LambdaVersion"`
+	LaunchConfiguration             ResourceType                    `yaml:"LaunchConfiguration"`
+	LaunchTemplate                  ResourceType                    `yaml:"LaunchTemplate"`
+	MacieMember                     ResourceType                    `yaml:"MacieMember"`
+	MSKCluster                      ResourceType                    `yaml:"MSKCluster"`
+	MQBroker                        ResourceType                    `yaml:"MQBroker"`
+	NATGateway                      EC2ResourceType                 `yaml:"NATGateway"`
+	OIDCProvider                    ResourceType                    `yaml:"OIDCProvider"`
+	OpenSearchDomain                ResourceType                    `yaml:"OpenSearchDomain"`
+	Redshift                        ResourceType                    `yaml:"Redshift"`
+	RedshiftSnapshotCopyGrant       ResourceType                    `yaml:"RedshiftSnapshotCopyGrant"`
+	ResourceShare                   ResourceType                    `yaml:"ResourceShare"`
+	RDSSnapshot                     RetentionResourceType           `yaml:"RDSSnapshot"`
+	RDSClusterSnapshot              RetentionResourceType           `yaml:"RDSClusterSnapshot"`
+	RDSParameterGroup               ResourceType                    `yaml:"RDSParameterGroup"`
+	RDSProxy                        ResourceType                    `yaml:"RDSProxy"`
+	S3                              ResourceType                    `yaml:"S3"`
+	S3Object                        S3ObjectResourceType            `yaml:"S3Object"`
+	S3AccessPoint                   ResourceType                    `yaml:"S3AccessPoint"`
+	S3ObjectLambdaAccessPoint       ResourceType                    `yaml:"S3ObjectLambdaAccessPoint"`
+	S3MultiRegionAccessPoint        ResourceType                    `yaml:"S3MultiRegionAccessPoint"`
+	SESIdentity                     ResourceType                    `yaml:"SESIdentity"`
+	SESConfigurationSet             ResourceType                    `yaml:"SESConfigurationSet"`
+	SESReceiptRuleSet               ResourceType                    `yaml:"SESReceiptRuleSet"`
+	SESReceiptFilter                ResourceType                    `yaml:"SESReceiptFilter"`
+	SESEmailTemplates               ResourceType                    `yaml:"SESEmailTemplates"`
+	SNS                             ResourceType                    `yaml:"SNS"`
+	SQS                             ResourceType                    `yaml:"SQS"`
+	SageMakerEndpoint               ResourceType                    `yaml:"SageMakerEndpoint"`
+	SageMakerEndpointConfig         ResourceType                    `yaml:"SageMakerEndpointConfig"`
+	SageMakerNotebook               ResourceType                    `yaml:"SageMakerNotebook"`
+	SageMakerStudioDomain           ResourceType                    `yaml:"SageMakerStudioDomain"`
+	SecretsManager                  ResourceType                    `yaml:"SecretsManager"`
+	SSMParameter                    ResourceType                    `yaml:"SSMParameter"`
+	SecurityHub                     ResourceType                    `yaml:"SecurityHub"`
+	Snapshots                       RetentionResourceType           `yaml:"Snapshots"`
+	TransitGateway                  ResourceType                    `yaml:"TransitGateway"`
+	TransitGatewayRouteTable        ResourceType                    `yaml:"TransitGatewayRouteTable"`
+	TransitGatewayVPCAttachment     ResourceType                    `yaml:"TransitGatewayVPCAttachment"`
+	TransitGatewayPeeringAttachment ResourceType                    `yaml:"TransitGatewayPeeringAttachment"`
+	VPC                             EC2ResourceType                 `yaml:"VPC"`
+	Route53HostedZone               ResourceType                    `yaml:"Route53HostedZone"`
+	Route53CIDRCollection           ResourceType                    `yaml:"Route53CIDRCollection"`
+	Route53TrafficPolicy            ResourceType                    `yaml:"Route53TrafficPolicy"`
+	InternetGateway                 EC2ResourceType                 `yaml:"InternetGateway"`
+	NetworkACL                      ResourceType                    `yaml:"NetworkACL"`
+	NetworkInterface                EC2ResourceType                 `yaml:"NetworkInterface"`
+	SecurityGroup                   EC2ResourceType                 `yaml:"SecurityGroup"`
+	NetworkFirewall                 ResourceType                    `yaml:"NetworkFirewall"`
+	NetworkFirewallPolicy           ResourceType                    `yaml:"NetworkFirewallPolicy"`
+	NetworkFirewallRuleGroup        ResourceType                    `yaml:"NetworkFirewallRuleGroup"`
+	NetworkFirewallTLSConfig        ResourceType                    `yaml:"NetworkFirewallTLSConfig"`
+	NetworkFirewallResourcePolicy   ResourceType                    `yaml:"NetworkFirewallResourcePolicy"`
+	VPCLatticeServiceNetwork        ResourceType                    `yaml:"VPCLatticeServiceNetwork"`
+	VPCLatticeService               ResourceType                    `yaml:"VPCLatticeService"`
+	VPCLatticeTargetGroup           ResourceType                    `yaml:"VPCLatticeTargetGroup"`
+	RouteTable                      EC2ResourceType                 `yaml:"RouteTable"`
+	VPCPeeringConnection            ResourceType                    `yaml:"VPCPeeringConnection"`
 
 	// GCP Resources
 	GCSBucket        ResourceType `yaml:"GCSBucket"`
 	CloudFunction    ResourceType `yaml:"CloudFunction"`
 	ArtifactRegistry ResourceType `yaml:"ArtifactRegistry"`
 	GcpPubSubTopic   ResourceType `yaml:"GcpPubSubTopic"`
+
+	// Plugins holds the rules for out-of-process plugin resource types, keyed by plugin resource name.
+	Plugins map[string]*ResourceType `yaml:"Plugins"`
+
+	// CloudControl declares additional AWS resource types, by CloudFormation type name, that are
+	// listed and deleted through the generic Cloud Control API.
+	CloudControl []CloudControlResourceType `yaml:"cloudcontrol"`
+
+	// Endpoints overrides the AWS endpoint URL of individual services, keyed by SDK service ID such as
+	// "S3" or "CloudWatch Logs". It takes precedence over --endpoint-url for the services it names.
+	Endpoints map[string]string `yaml:"endpoints"`
+
+	// ProtectedIdentifiers maps the identifiers of resources that must never be nuked to the reason
+	// they are protected. It is set from CLI flags such as --protect-tfstate, not from the config file.
+	ProtectedIdentifiers map[string]string `yaml:"-"`
+
+	// CloudFormationMembers controls how resources created by a CloudFormation stack are handled:
+	// CloudFormationMembersProtect or CloudFormationMembersStackFirst. By default they are nuked like
+	// any other resource.
+	CloudFormationMembers string `yaml:"cloudformation_members"`
+
+	// TTL configures relative TTL tags, such as cloud-nuke-ttl=72h, which protect a resource until the
+	// duration has passed since it was created.
+	TTL TTLConfig `yaml:"ttl"`
 }
 
 // allResourceTypes returns pointers to the embedded ResourceType for every
@@ -167,10 +217,10 @@ type Config struct {
 // with a type-safe enumeration. If you add a new field to Config, add it here
 // too — TestAllResourceTypesComplete will catch any omission.
 func (c *Config) allResourceTypes() []*ResourceType {
-	return []*ResourceType{
+	all := []*ResourceType{
 		&c.ACM,
 		&c.ACMPCA,
-		&c.AMI,
+		&c.AMI.ResourceType,
 		&c.APIGateway,
 		&c.APIGatewayV2,
 		&c.AccessAnalyzer,
@@ -181,12 +231,12 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.ManagedPrometheus,
 		&c.CloudWatchAlarm,
 		&c.CloudWatchDashboard,
-		&c.CloudWatchLogGroup,
+		&c.CloudWatchLogGroup.ResourceType,
 		&c.CloudMapNamespace,
 		&c.CloudMapService,
 		&c.CloudTrailTrail,
 		&c.CloudFrontDistribution,
-		&c.CloudFormationStack,
+		&c.CloudFormationStack.ResourceType,
 		&c.CodeDeployApplications,
 		&c.ConfigServiceRecorder,
 		&c.ConfigServiceRule,
@@ -201,7 +251,7 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.DynamoDB,
 		&c.EBSVolume,
 		&c.ElasticBeanstalk,
-		&c.EC2,
+		&c.EC2.ResourceType,
 		&c.EC2DedicatedHosts,
 		&c.EC2DHCPOption,
 		&c.EC2KeyPairs,
@@ -216,14 +266,16 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.EC2PlacementGroups,
 		&c.EgressOnlyInternetGateway,
 		&c.ECRRepository,
+		&c.ECRImage.ResourceType,
+		&c.S3Object.ResourceType,
 		&c.ECSCluster,
 		&c.ECSService,
-		&c.EKSCluster,
-		&c.ELBv1,
-		&c.ELBv2,
+		&c.EKSCluster.ResourceType,
+		&c.ELBv1.ResourceType,
+		&c.ELBv2.ResourceType,
 		&c.ElasticFileSystem,
 		&c.ElasticIP,
-		&c.ElastiCache,
+		&c.ElastiCache.ResourceType,
 		&c.ElastiCacheParameterGroup,
 		&c.ElastiCacheServerless,
 		&c.ElastiCacheSubnetGroup,
@@ -243,8 +295,10 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.KMSCustomerKeys.ResourceType,
 		&c.KinesisStream,
 		&c.KinesisFirehose,
-		&c.LambdaFunction,
+		&c.LambdaFunction.ResourceType,
 		&c.LambdaLayer,
+		&c.LambdaLayerVersion.ResourceType,
+		&c.LambdaVersion.ResourceType,
 		&c.LaunchConfiguration,
 		&c.LaunchTemplate,
 		&c.MacieMember,
@@ -256,8 +310,8 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.Redshift,
 		&c.RedshiftSnapshotCopyGrant,
 		&c.ResourceShare,
-		&c.RDSSnapshot,
-		&c.RDSClusterSnapshot,
+		&c.RDSSnapshot.ResourceType,
+		&c.RDSClusterSnapshot.ResourceType,
 		&c.RDSParameterGroup,
 		&c.RDSProxy,
 		&c.S3,
@@ -278,7 +332,7 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.SecretsManager,
 		&c.SSMParameter,
 		&c.SecurityHub,
-		&c.Snapshots,
+		&c.Snapshots.ResourceType,
 		&c.TransitGateway,
 		&c.TransitGatewayRouteTable,
 		&c.TransitGatewayVPCAttachment,
@@ -306,10 +360,59 @@ func (c *Config) allResourceTypes() []*ResourceType {
 		&c.ArtifactRegistry,
 		&c.GcpPubSubTopic,
 	}
+	for i := range c.CloudControl {
+		all = append(all, &c.CloudControl[i].ResourceType)
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Plugins)) {
+		if rt := c.Plugins[name]; rt != nil {
+			all = append(all, rt)
+		}
+	}
+	return all
+}
+
+// PrepareDynamicResourceTypes ensures every named plugin has a config entry, so global filters such as
+// --older-than apply to plugins that are not configured in the YAML file. The plugin map and the
+// Cloud Control list are copied first because Config is passed by value and their entries must not
+// be shared with the caller's copy when the global filters are applied.
+func (c *Config) PrepareDynamicResourceTypes(pluginNames []string) {
+	plugins := make(map[string]*ResourceType, len(c.Plugins)+len(pluginNames))
+	for name, rt := range c.Plugins {
+		if rt == nil {
+			continue
+		}
+		clone := *rt
+		plugins[name] = &clone
+	}
+	for _, name := range pluginNames {
+		if _, ok := plugins[name]; !ok {
+			plugins[name] = &ResourceType{}
+		}
+	}
+	c.Plugins = plugins
+	c.CloudControl = slices.Clone(c.CloudControl)
+}
+
+// CloudControlType returns the declaration for the given CloudFormation type name.
+func (c Config) CloudControlType(typeName string) (CloudControlResourceType, bool) {
+	for _, cc := range c.CloudControl {
+		if cc.Type == typeName {
+			return cc, true
+		}
+	}
+	return CloudControlResourceType{}, false
+}
+
+// Plugin returns the rules for the named plugin resource type.
+func (c Config) Plugin(name string) ResourceType {
+	if rt := c.Plugins[name]; rt != nil {
+		return *rt
+	}
+	return ResourceType{}
 }
 
-// allEC2ResourceTypes returns pointers to the EC2ResourceType fields in Config.
-// These are the only fields that have a DefaultOnly flag.
+// allEC2ResourceTypes returns pointers to the EC2 networking fields in Config.
+// These are the only fields whose DefaultOnly flag is honored.
 func (c *Config) allEC2ResourceTypes() []*EC2ResourceType {
 	return []*EC2ResourceType{
 		&c.EC2Endpoint,
@@ -323,6 +426,58 @@ func (c *Config) allEC2ResourceTypes() []*EC2ResourceType {
 	}
 }
 
+// allRetentionResourceTypes returns pointers to the RetentionResourceType fields in Config.
+func (c *Config) allRetentionResourceTypes() []*RetentionResourceType {
+	return []*RetentionResourceType{
+		&c.AMI,
+		&c.RDSSnapshot,
+		&c.RDSClusterSnapshot,
+		&c.Snapshots,
+		&c.LambdaVersion,
+		&c.LambdaLayerVersion,
+	}
+}
+
+// allVpcScopedResourceTypes returns pointers to the VPC filters of the resource types in Config that
+// live inside a VPC.
+func (c *Config) allVpcScopedResourceTypes() []*VpcResourceFilters {
+	filters := []*VpcResourceFilters{
+		&c.EC2.VpcResourceFilters,
+		&c.ELBv1.VpcResourceFilters,
+		&c.ELBv2.VpcResourceFilters,
+		&c.DBInstances.VpcResourceFilters,
+		&c.ElastiCache.VpcResourceFilters,
+		&c.LambdaFunction.VpcResourceFilters,
+		&c.EKSCluster.VpcResourceFilters,
+	}
+	for _, rt := range c.allEC2ResourceTypes() {
+		filters = append(filters, &rt.VpcResourceFilters)
+	}
+	return filters
+}
+
+// allIdleResourceTypes returns pointers to the VPC filters of the resource types in Config that
+// publish a usage metric. These are the only ones whose Idle rule is honored.
+func (c *Config) allIdleResourceTypes() []*VpcResourceFilters {
+	return []*VpcResourceFilters{
+		&c.EC2.VpcResourceFilters,
+		&c.DBInstances.VpcResourceFilters,
+		&c.ELBv1.VpcResourceFilters,
+		&c.ELBv2.VpcResourceFilters,
+		&c.NATGateway.VpcResourceFilters,
+	}
+}
+
+// HasIdleRules reports whether any resource type is limited to idle resources.
+func (c *Config) HasIdleRules() bool {
+	for _, rt := range c.allIdleResourceTypes() {
+		if rt.Idle != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Config) AddIncludeAfterTime(includeAfter *time.Time) {
 	if includeAfter == nil {
 		return
@@ -359,6 +514,59 @@ func (c *Config) AddEC2DefaultOnly(flag bool) {
 	}
 }
 
+// AddVpcIDs restricts every VPC-scoped resource type to resources inside the given VPCs. VPC IDs set
+// for a resource type in the config file take precedence.
+func (c *Config) AddVpcIDs(vpcIDs []string) {
+	if len(vpcIDs) == 0 {
+		return
+	}
+	for _, rt := range c.allVpcScopedResourceTypes() {
+		if len(rt.VpcIDs) == 0 {
+			rt.VpcIDs = vpcIDs
+		}
+	}
+}
+
+// AddProtectedIdentifiers marks the given identifiers, mapped to the reason they are protected, as
+// non-nukable for every resource type.
+func (c *Config) AddProtectedIdentifiers(protected map[string]string) {
+	if len(protected) == 0 {
+		return
+	}
+	merged := maps.Clone(c.ProtectedIdentifiers)
+	if merged == nil {
+		merged = make(map[string]string, len(protected))
+	}
+	maps.Copy(merged, protected)
+	c.ProtectedIdentifiers = merged
+}
+
+// SkipCloudFormationMembers excludes resources that belong to a CloudFormation stack from every
+// resource type except CloudFormationStack, and records their owning stacks in stacks.
+func (c *Config) SkipCloudFormationMembers(stacks *CloudFormationStackSet) {
+	for _, rt := range c.allResourceTypes() {
+		if rt != &c.CloudFormationStack.ResourceType {
+			rt.cloudFormationStacks = stacks
+		}
+	}
+}
+
+// OnlyOrphans restricts every resource type to orphaned resources. Listers without an orphan
+// predicate ignore it; see ShouldIncludeOrphan.
+func (c *Config) OnlyOrphans() {
+	for _, rt := range c.allResourceTypes() {
+		rt.orphansOnly = true
+	}
+}
+
+// RecordFindings makes every resource type record in findings why its resources were included, such
+// as why they are considered orphaned or idle. See AddFinding.
+func (c *Config) RecordFindings(findings *Findings) {
+	for _, rt := range c.allResourceTypes() {
+		rt.findings = findings
+	}
+}
+
 // AddIncludeTags applies global tag include filters to all resource types.
 // This merges CLI-provided tags with any existing per-resource-type include tags
 // from the config file, with config file tags taking precedence on conflicts.
@@ -388,28 +596,525 @@ type KMSCustomerKeyResourceType struct {
 	ResourceType         `yaml:",inline"`
 }
 
+// CloudFormationStackResourceType is the config of the cloudformation-stack resource type, with
+// opt-in recovery for stacks that cannot be deleted with a plain DeleteStack.
+type CloudFormationStackResourceType struct {
+	// RecoverDeleteFailed retries stacks that end in DELETE_FAILED, retaining the resources that could
+	// not be deleted, and then nukes those resources with the matching cloud-nuke resource type.
+	RecoverDeleteFailed bool `yaml:"recover_delete_failed"`
+	// DisableTerminationProtection turns off termination protection before deleting a stack.
+	DisableTerminationProtection bool `yaml:"disable_termination_protection"`
+	ResourceType                 `yaml:",inline"`
+}
+
+// CloudWatchLogGroupResourceType is the config of the cloudwatch-loggroup resource type.
+type CloudWatchLogGroupResourceType struct {
+	// EnforceRetentionDays bounds the retention of matching log groups instead of deleting them. Only
+	// groups that never expire or retain events longer are selected, and their retention is set to
+	// this many days.
+	EnforceRetentionDays int32 `yaml:"enforce_retention_days"`
+	ResourceType         `yaml:",inline"`
+}
+
+// logRetentionDays are the retention periods CloudWatch Logs accepts.
+var logRetentionDays = []int32{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+
+// EC2ResourceType is the config of a resource type that lives inside a VPC.
 type EC2ResourceType struct {
-	DefaultOnly  bool `yaml:"default_only"`
+	DefaultOnly        bool `yaml:"default_only"`
+	VpcResourceFilters `yaml:",inline"`
+	ResourceType       `yaml:",inline"`
+}
+
+// VpcResourceFilters are the filters of resource types that live inside a VPC.
+type VpcResourceFilters struct {
+	VpcIDs []string `yaml:"vpc_ids"`
+	// Idle restricts the resource type to resources whose CloudWatch metric stayed at or below a
+	// threshold. Only resource types that publish a usage metric support it.
+	Idle *IdleRule `yaml:"idle"`
+}
+
+// IdleRule considers a resource idle when the daily value of a CloudWatch metric never exceeded Max
+// over the last Days days, e.g. CPUUtilization at most 2 for 14 days. A resource without datapoints
+// is idle, so pair the rule with a time filter to spare resources created within the window.
+type IdleRule struct {
+	// Metric is the CloudWatch metric name. Defaults to the resource type's usage metric.
+	Metric string `yaml:"metric"`
+	// Max is the highest daily value an idle resource may have.
+	Max float64 `yaml:"max"`
+	// Days is how many days the metric is evaluated over.
+	Days int `yaml:"days"`
+	// Statistic aggregates the metric per day, e.g. Average or Sum. Defaults to the statistic that
+	// suits the metric.
+	Statistic string `yaml:"statistic"`
+}
+
+// RetentionResourceType is the config of a resource type whose resources are versions of each other,
+// such as images and snapshots, allowing the newest of each group to be retained.
+type RetentionResourceType struct {
+	// Retain keeps the newest resources of each group, so that only the surplus is nuked.
+	Retain       *RetainRule `yaml:"retain"`
 	ResourceType `yaml:",inline"`
 }
 
-type AWSProtectableResourceType struct {
+// IsConfigured reports whether the retain rule or any of the filters were set in the config file.
+func (r RetentionResourceType) IsConfigured() bool {
+	return r.Retain != nil || r.ResourceType.IsConfigured()
+}
+
+// ECRImageResourceType is the config of the ecr-image resource type. Its name filters match the
+// repository name, and its time filters the time the image was pushed.
+type ECRImageResourceType struct {
+	// Untagged selects images without image tags. Combined with ImageTagsRegex, images matching
+	// either are selected.
+	Untagged bool `yaml:"untagged"`
+	// ImageTagsRegex selects images with an image tag matching one of the expressions.
+	ImageTagsRegex []Expression `yaml:"image_tags_regex"`
+	// PushedBefore selects images pushed longer ago than this duration, e.g. 720h.
+	PushedBefore time.Duration `yaml:"pushed_before"`
+	// Retain keeps the newest images of each repository, grouped by the image tag matching its
+	// name_regex, so that only the surplus is nuked.
+	Retain *RetainRule `yaml:"retain"`
+	// SkipInUse spares images that running ECS tasks or Lambda functions use.
+	SkipInUse    bool `yaml:"skip_in_use"`
+	ResourceType `yaml:",inline"`
+}
+
+// HasImageFilters reports whether any of the image predicates is set. Without one, every image of
+// the selected repositories would be nuked.
+func (r ECRImageResourceType) HasImageFilters() bool {
+	return r.Untagged || len(r.ImageTagsRegex) > 0 || r.PushedBefore > 0 || r.Retain != nil
+}
+
+// ShouldIncludeImage reports whether an image with the given image tags and push time is selected by
+// the image predicates. It does not apply the ResourceType filters.
+func (r ECRImageResourceType) ShouldIncludeImage(imageTags []string, pushedAt *time.Time) bool {
+	if r.PushedBefore > 0 && (pushedAt == nil || time.Since(*pushedAt) < r.PushedBefore) {
+		return false
+	}
+	if !r.Untagged && len(r.ImageTagsRegex) == 0 {
+		return true
+	}
+	if r.Untagged && len(imageTags) == 0 {
+		return true
+	}
+	for _, tag := range imageTags {
+		if matches(tag, r.ImageTagsRegex) {
+			return true
+		}
+	}
+	return false
+}
+
+// S3ObjectResourceType is the config of the s3-object resource type. Its name and tag filters match
+// the bucket, and its time filters the time each object was last modified.
+type S3ObjectResourceType struct {
+	// Prefixes restricts the objects to keys starting with one of these prefixes. Defaults to all keys.
+	Prefixes []string `yaml:"prefixes"`
+	// ModifiedBefore selects objects last modified longer ago than this duration, e.g. 168h.
+	ModifiedBefore time.Duration `yaml:"modified_before"`
+	// MinSize and MaxSize select objects of at least and at most this many bytes.
+	MinSize      int64 `yaml:"min_size"`
+	MaxSize      int64 `yaml:"max_size"`
+	ResourceType `yaml:",inline"`
+}
+
+// IsConfigured reports whether any of the object or bucket filters were set in the config file.
+func (r S3ObjectResourceType) IsConfigured() bool {
+	return len(r.Prefixes) > 0 || r.ModifiedBefore > 0 || r.MinSize > 0 || r.MaxSize > 0 || r.ResourceType.IsConfigured()
+}
+
+// ShouldIncludeObject reports whether an object of the given size, last modified at the given time,
+// is selected. Delete markers have no size and are selected by their age only; callers must also
+// check that the version a marker hides is selected, or deleting the marker restores it.
+func (r S3ObjectResourceType) ShouldIncludeObject(lastModified *time.Time, size *int64) bool {
+	if lastModified == nil || !r.ShouldIncludeBasedOnTime(*lastModified) {
+		return false
+	}
+	if r.ModifiedBefore > 0 && time.Since(*lastModified) < r.ModifiedBefore {
+		return false
+	}
+	if size == nil {
+		return true
+	}
+	return *size >= r.MinSize && (r.MaxSize == 0 || *size <= r.MaxSize)
+}
+
+// BucketFilter returns the filters that apply to the bucket itself: those of the resource type
+// without its time filters, which apply to objects.
+func (r S3ObjectResourceType) BucketFilter() ResourceType {
+	bucket := r.ResourceType
+	bucket.IncludeRule.TimeAfter, bucket.IncludeRule.TimeBefore = nil, nil
+	bucket.ExcludeRule.TimeAfter, bucket.ExcludeRule.TimeBefore = nil, nil
+	return bucket
+}
+
+// RetainRule keeps the newest Keep resources of each group, e.g. the 5 newest AMIs per name prefix.
+// Resources are grouped by the first capture group of NameRegex, or the whole match when it has none,
+// or by the value of the Tag tag. Without either, all resources form a single group. Resources that
+// do not match NameRegex or lack Tag belong to no group and are always retained.
+type RetainRule struct {
+	// Keep is how many of the newest resources of each group are retained.
+	Keep int `yaml:"keep"`
+	// NameRegex groups resources by their name.
+	NameRegex *Expression `yaml:"name_regex"`
+	// Tag groups resources by the value of this tag.
+	Tag string `yaml:"tag"`
+}
+
+// validate returns an error if the rule cannot be applied.
+func (r *RetainRule) validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.Keep <= 0 {
+		return fmt.Errorf("invalid retain keep %d: must be at least 1", r.Keep)
+	}
+	if r.NameRegex != nil && r.Tag != "" {
+		return fmt.Errorf("invalid retain rule: name_regex and tag are mutually exclusive")
+	}
+	return nil
+}
+
+// group returns the group of a resource, or false if it belongs to none.
+func (r *RetainRule) group(value ResourceValue) (string, bool) {
+	switch {
+	case r.NameRegex != nil:
+		match := r.NameRegex.RE.FindStringSubmatch(value.name())
+		if match == nil {
+			return "", false
+		}
+		if len(match) > 1 {
+			return match[1], true
+		}
+		return match[0], true
+	case r.Tag != "":
+		group, ok := value.Tags[r.Tag]
+		return group, ok
+	default:
+		return "", true
+	}
+}
+
+// Surplus returns the values that are not among the newest Keep of their group, and records their
+// rank as a finding of cfg. Values without a creation time count as the newest. A nil rule retains
+// nothing and returns all values.
+func (r *RetainRule) Surplus(cfg ResourceType, values []ResourceValue) []ResourceValue {
+	if r == nil {
+		return values
+	}
+
+	groups := map[string][]ResourceValue{}
+	var order []string
+	for _, value := range values {
+		group, ok := r.group(value)
+		if !ok {
+			logging.Debugf("[Skip] %s belongs to no retention group", value.identifier())
+			continue
+		}
+		if _, seen := groups[group]; !seen {
+			order = append(order, group)
+		}
+		groups[group] = append(groups[group], value)
+	}
+
+	var surplus []ResourceValue
+	for _, group := range order {
+		members := groups[group]
+		sort.SliceStable(members, func(i, j int) bool {
+			if members[i].Time == nil || members[j].Time == nil {
+				return members[i].Time == nil && members[j].Time != nil
+			}
+			return members[i].Time.After(*members[j].Time)
+		})
+		for i, value := range members {
+			if i < r.Keep {
+				logging.Debugf("[Skip] %s is retained as one of the %d newest", value.identifier(), r.Keep)
+				continue
+			}
+			detail := fmt.Sprintf("#%d newest, beyond the %d retained", i+1, r.Keep)
+			if group != "" {
+				detail = fmt.Sprintf("#%d newest of group %q, beyond the %d retained", i+1, group, r.Keep)
+			}
+			cfg.AddFinding(cfg.region, value.identifier(), detail)
+			surplus = append(surplus, value)
+		}
+	}
+	return surplus
+}
+
+// InVpc reports whether a resource in the given VPC is in scope: always when no VPC IDs are set, and
+// otherwise only when vpcID is one of them. Resources outside any VPC have a nil vpcID.
+func (r VpcResourceFilters) InVpc(vpcID *string) bool {
+	if len(r.VpcIDs) == 0 {
+		return true
+	}
+	return vpcID != nil && slices.Contains(r.VpcIDs, *vpcID)
+}
+
+// CloudControlResourceType declares a CloudFormation resource type, e.g. "AWS::Glue::Job", to be nuked
+// through the Cloud Control API. Name, creation time and tags are read from the resource model; the
+// property names are detected automatically unless overridden.
+type CloudControlResourceType struct {
+	Type         string `yaml:"type"`
+	Global       bool   `yaml:"global"`
+	NameProperty string `yaml:"name_property"`
+	TimeProperty string `yaml:"time_property"`
 	ResourceType `yaml:",inline"`
 }
 
+// AWSProtectableResourceType is the config of a database resource type. Only DBInstances supports
+// the VPC and idle filters.
+type AWSProtectableResourceType struct {
+	VpcResourceFilters `yaml:",inline"`
+	ResourceType       `yaml:",inline"`
+}
+
 type ResourceType struct {
-	IncludeRule        FilterRule `yaml:"include"`
-	ExcludeRule        FilterRule `yaml:"exclude"`
-	Timeout            string     `yaml:"timeout"`
-	ProtectUntilExpire *bool      `yaml:"protect_until_expire"`
+	IncludeRule        FilterRule `yaml:"include" json:"include"`
+	ExcludeRule        FilterRule `yaml:"exclude" json:"exclude"`
+	Timeout            string     `yaml:"timeout" json:"timeout,omitempty"`
+	ProtectUntilExpire *bool      `yaml:"protect_until_expire" json:"protect_until_expire,omitempty"`
+
+	// cloudFormationStacks, when set, excludes resources that belong to a CloudFormation stack and
+	// collects their stack IDs. See Config.SkipCloudFormationMembers.
+	cloudFormationStacks *CloudFormationStackSet
+
+	// orphansOnly restricts the resource type to orphaned resources. See Config.OnlyOrphans.
+	orphansOnly bool
+
+	// findings, when set, collects why each resource was included. See Config.RecordFindings.
+	findings *Findings
+
+	// region attributes findings recorded while filtering to the region being listed. See InRegion.
+	region string
+
+	// ttl configures TTL tags; when nil, the defaults apply. It is set by GetConfig.
+	ttl *TTLConfig
+}
+
+// IsConfigured reports whether any of the filters or settings of the resource type were set in the
+// config file.
+func (r ResourceType) IsConfigured() bool {
+	configured := ResourceType{
+		IncludeRule:        r.IncludeRule,
+		ExcludeRule:        r.ExcludeRule,
+		Timeout:            r.Timeout,
+		ProtectUntilExpire: r.ProtectUntilExpire,
+	}
+	return !reflect.DeepEqual(configured, ResourceType{})
+}
+
+// InRegion returns a copy of the resource type that attributes the findings recorded by ShouldInclude
+// to the given region.
+func (r ResourceType) InRegion(region string) ResourceType {
+	r.region = region
+	return r
+}
+
+// OrphansOnly reports whether only orphaned resources should be included. Listers use it to skip
+// the lookups their orphan predicate needs when it is not.
+func (r ResourceType) OrphansOnly() bool {
+	return r.orphansOnly
+}
+
+// AddFinding records why the resource with the given identifier in region was included, if findings
+// are being recorded.
+func (r ResourceType) AddFinding(region string, id string, detail string) {
+	if r.findings != nil {
+		r.findings.Add(region, id, detail)
+	}
+}
+
+// ShouldIncludeOrphan reports whether a resource that passed ShouldInclude is in scope given why it
+// is considered orphaned, with an empty reason meaning it is in use. Outside orphans-only mode every
+// resource is in scope; otherwise only orphans are, and their reason is recorded.
+func (r ResourceType) ShouldIncludeOrphan(region string, id string, reason string) bool {
+	if !r.orphansOnly {
+		return true
+	}
+	if reason == "" {
+		logging.Debugf("[Skip] %s is in use", id)
+		return false
+	}
+	r.AddFinding(region, id, reason)
+	return true
+}
+
+// Findings collects why each resource was included, such as why it is considered orphaned or idle.
+// It is safe for concurrent use by listers.
+type Findings struct {
+	mu      sync.Mutex
+	details map[string]string
+}
+
+// NewFindings returns an empty Findings.
+func NewFindings() *Findings {
+	return &Findings{details: make(map[string]string)}
+}
+
+// Add records why the resource with the given identifier in region was included. Details recorded
+// for the same resource are joined.
+func (f *Findings) Add(region string, id string, detail string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := region + "/" + id
+	if existing := f.details[key]; existing != "" {
+		detail = existing + "; " + detail
+	}
+	f.details[key] = detail
+}
+
+// Detail returns why the resource with the given identifier in region was included, or "" if
+// nothing was recorded.
+func (f *Findings) Detail(region string, id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.details[region+"/"+id]
+}
+
+// TTLConfig configures relative TTL tags, whose value is how long after its creation a resource is
+// protected, e.g. cloud-nuke-ttl=72h. Resources without a creation time use their
+// cloud-nuke-first-seen tag instead.
+type TTLConfig struct {
+	// TagKeys are the tags read as TTLs, in order of precedence. Defaults to cloud-nuke-ttl.
+	TagKeys []string `yaml:"tag_keys"`
+	// Units maps the accepted unit suffixes to their length as a Go duration, e.g. d: 24h. Defaults to
+	// m, h, d and w.
+	Units map[string]string `yaml:"units"`
+
+	// units are the parsed Units. See parseUnits.
+	units map[string]time.Duration
+}
+
+// defaultTTLUnits are the units accepted in TTL tags unless the config file sets its own.
+var defaultTTLUnits = map[string]time.Duration{
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ttlValue matches a TTL tag value, a number followed by a unit such as "72h" or "1.5d".
+var ttlValue = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]+)$`)
+
+// parseUnits validates Units and stores their lengths.
+func (t *TTLConfig) parseUnits() error {
+	if len(t.Units) == 0 {
+		return nil
+	}
+	t.units = make(map[string]time.Duration, len(t.Units))
+	for unit, length := range t.Units {
+		d, err := time.ParseDuration(length)
+		if err != nil || d <= 0 || !ttlValue.MatchString("1"+unit) {
+			return fmt.Errorf("invalid ttl unit %q: %q must be a positive duration such as 24h", unit, length)
+		}
+		t.units[unit] = d
+	}
+	return nil
+}
+
+// ParseTTL parses a TTL tag value, such as "72h" or "14d", with the configured units.
+func (t *TTLConfig) ParseTTL(value string) (time.Duration, error) {
+	units := defaultTTLUnits
+	if t != nil && t.units != nil {
+		units = t.units
+	}
+	match := ttlValue.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, fmt.Errorf("invalid TTL %q: expected a number followed by a unit", value)
+	}
+	unit, ok := units[match[2]]
+	if !ok {
+		return 0, fmt.Errorf("invalid TTL %q: unit %q is not accepted", value, match[2])
+	}
+	n, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid TTL %q: %w", value, err)
+	}
+	return time.Duration(n * float64(unit)), nil
+}
+
+// tagKeys returns the TTL tag keys, in order of precedence.
+func (t *TTLConfig) tagKeys() []string {
+	if t == nil || len(t.TagKeys) == 0 {
+		return []string{CloudNukeTTLTagKey}
+	}
+	return t.TagKeys
+}
+
+// ttlExpired reports whether the TTL tag of a resource, if any, has expired, together with the computed
+// expiry to report. Like cloud-nuke-after, TTL tags are ignored when protect_until_expire is false or
+// their value cannot be parsed. A resource whose creation time is unknown stays protected.
+func (r ResourceType) ttlExpired(value ResourceValue) (string, bool) {
+	if r.ProtectUntilExpire != nil && !*r.ProtectUntilExpire {
+		return "", true
+	}
+
+	for _, key := range r.ttl.tagKeys() {
+		tag, ok := value.Tags[key]
+		if !ok {
+			continue
+		}
+		ttl, err := r.ttl.ParseTTL(tag)
+		if err != nil {
+			logging.Debugf("Ignoring %s tag: %s", key, err)
+			return "", true
+		}
+
+		start := value.Time
+		if start == nil {
+			if firstSeen, ok := value.Tags[CloudNukeFirstSeenTagKey]; ok {
+				start, _ = ParseTimestamp(firstSeen)
+			}
+		}
+		if start == nil {
+			logging.Debugf("[Skip] the resource has a %s tag but no creation time", key)
+			return "", false
+		}
+
+		expiry := start.Add(ttl)
+		if expiry.After(time.Now()) {
+			logging.Debugf("[Skip] the resource is protected by %s=%s until %v", key, tag, expiry)
+			return "", false
+		}
+		return fmt.Sprintf("%s=%s expired at %s", key, tag, expiry.UTC().Format(time.RFC3339)), true
+	}
+	return "", true
+}
+
+// CloudFormationStackSet collects the IDs of the CloudFormation stacks that own skipped resources.
+// It is safe for concurrent use by listers.
+type CloudFormationStackSet struct {
+	mu  sync.Mutex
+	ids map[string]bool
+}
+
+// NewCloudFormationStackSet returns an empty CloudFormationStackSet.
+func NewCloudFormationStackSet() *CloudFormationStackSet {
+	return &CloudFormationStackSet{ids: make(map[string]bool)}
+}
+
+// Add records a stack ID.
+func (s *CloudFormationStackSet) Add(stackID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids[stackID] = true
+}
+
+// IDs returns the recorded stack IDs, sorted.
+func (s *CloudFormationStackSet) IDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Sorted(maps.Keys(s.ids))
 }
 
 type FilterRule struct {
-	NamesRegExp  []Expression          `yaml:"names_regex"`
-	TimeAfter    *time.Time            `yaml:"time_after"`
-	TimeBefore   *time.Time            `yaml:"time_before"`
-	Tags         map[string]Expression `yaml:"tags"`
-	TagsOperator string                `yaml:"tags_operator"` // "AND" or "OR" - defaults to "OR" for backward compatibility
+	NamesRegExp  []Expression          `yaml:"names_regex" json:"names_regex,omitempty"`
+	TimeAfter    *time.Time            `yaml:"time_after" json:"time_after,omitempty"`
+	TimeBefore   *time.Time            `yaml:"time_before" json:"time_before,omitempty"`
+	Tags         map[string]Expression `yaml:"tags" json:"tags,omitempty"`
+	TagsOperator string                `yaml:"tags_operator" json:"tags_operator,omitempty"` // "AND" or "OR" - defaults to "OR" for backward compatibility
 }
 
 type Expression struct {
@@ -434,6 +1139,29 @@ func (expression *Expression) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// MarshalText - Serializes an Expression back to its pattern, e.g. when passing config to plugins
+func (expression Expression) MarshalText() ([]byte, error) {
+	return []byte(expression.RE.String()), nil
+}
+
+// UnmarshalJSON - Parses an Expression from a JSON string. Unlike UnmarshalText, the pattern is
+// used verbatim rather than being decoded as YAML, so patterns such as "[a-z]+" round-trip.
+func (expression *Expression) UnmarshalJSON(data []byte) error {
+	var pattern string
+	if err := json.Unmarshal(data, &pattern); err != nil {
+		return err
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+
+	expression.RE = *re
+
+	return nil
+}
+
 // GetConfig - Unmarshall the config file and parse it into a config object.
 func GetConfig(filePath string) (*Config, error) {
 	var configObj Config
@@ -453,6 +1181,54 @@ func GetConfig(filePath string) (*Config, error) {
 		return nil, err
 	}
 
+	switch configObj.CloudFormationMembers {
+	case "", CloudFormationMembersProtect, CloudFormationMembersStackFirst:
+	default:
+		return nil, fmt.Errorf("invalid cloudformation_members %q: must be %q or %q", configObj.CloudFormationMembers, CloudFormationMembersProtect, CloudFormationMembersStackFirst)
+	}
+
+	if len(configObj.DBClusters.VpcIDs) > 0 || configObj.DBClusters.Idle != nil {
+		return nil, fmt.Errorf("invalid DBClusters config: vpc_ids and idle are not supported")
+	}
+
+	idleResourceTypes := configObj.allIdleResourceTypes()
+	for _, rt := range configObj.allVpcScopedResourceTypes() {
+		if rt.Idle == nil {
+			continue
+		}
+		if !slices.Contains(idleResourceTypes, rt) {
+			return nil, fmt.Errorf("invalid idle rule: only EC2, DBInstances, ELBv1, ELBv2 and NATGateway support idle rules")
+		}
+		if rt.Idle.Days <= 0 {
+			return nil, fmt.Errorf("invalid idle days %d: must be at least 1", rt.Idle.Days)
+		}
+	}
+
+	for _, rt := range configObj.allRetentionResourceTypes() {
+		if err := rt.Retain.validate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := configObj.ECRImage.Retain.validate(); err != nil {
+		return nil, err
+	}
+	if configObj.ECRImage.Retain != nil && configObj.ECRImage.Retain.Tag != "" {
+		return nil, fmt.Errorf("invalid ECRImage retain rule: images have no tags to group by, use name_regex to group by image tag")
+	}
+
+	if days := configObj.CloudWatchLogGroup.EnforceRetentionDays; days != 0 && !slices.Contains(logRetentionDays, days) {
+		return nil, fmt.Errorf("invalid enforce_retention_days %d: must be one of %v", days, logRetentionDays)
+	}
+
+	if len(configObj.TTL.TagKeys) > 0 || len(configObj.TTL.Units) > 0 {
+		if err := configObj.TTL.parseUnits(); err != nil {
+			return nil, err
+		}
+		for _, rt := range configObj.allResourceTypes() {
+			rt.ttl = &configObj.TTL
+		}
+	}
+
 	return &configObj, nil
 }
 
@@ -552,11 +1328,30 @@ func ShouldInclude(name *string, includeREs []Expression, excludeREs []Expressio
 }
 
 type ResourceValue struct {
+	// ID is the identifier the lister returns for the resource, when it differs from Name. Findings
+	// recorded while filtering, such as the expiry of a TTL tag, are attributed to it.
+	ID   *string
 	Name *string
 	Time *time.Time
 	Tags map[string]string
 }
 
+// name returns the name of the resource, or "" if it has none.
+func (v ResourceValue) name() string {
+	if v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// identifier returns the identifier the lister returns for the resource: its ID, or else its name.
+func (v ResourceValue) identifier() string {
+	if v.ID != nil {
+		return *v.ID
+	}
+	return v.name()
+}
+
 func (r ResourceType) ShouldIncludeBasedOnTime(time time.Time) bool {
 	if r.ExcludeRule.TimeAfter != nil && time.After(*r.ExcludeRule.TimeAfter) {
 		return false
@@ -671,5 +1466,21 @@ func (r ResourceType) ShouldInclude(value ResourceValue) bool {
 		return false
 	}
 
+	ttlDetail, expired := r.ttlExpired(value)
+	if !expired {
+		return false
+	}
+
+	// Stack members are checked last, so that only stacks owning otherwise matching resources are recorded
+	if stackID, ok := value.Tags[CloudFormationStackIDTagKey]; ok && r.cloudFormationStacks != nil {
+		logging.Debugf("[Skip] the resource belongs to CloudFormation stack %s", stackID)
+		r.cloudFormationStacks.Add(stackID)
+		return false
+	}
+
+	if id := value.identifier(); ttlDetail != "" && id != "" {
+		r.AddFinding(r.region, id, ttlDetail)
+	}
+
 	return true
 }
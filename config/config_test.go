@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -17,7 +18,7 @@ func emptyConfig() *Config {
 	return &Config{
 		ACM:                             ResourceType{},
 		ACMPCA:                          ResourceType{},
-		AMI:                             ResourceType{},
+		AMI:                             RetentionResourceType{},
 		APIGateway:                      ResourceType{},
 		APIGatewayV2:                    ResourceType{},
 		AccessAnalyzer:                  ResourceType{},
@@ -28,10 +29,10 @@ func emptyConfig() *Config {
 		ManagedPrometheus:               ResourceType{},
 		CloudWatchAlarm:                 ResourceType{},
 		CloudWatchDashboard:             ResourceType{},
-		CloudWatchLogGroup:              ResourceType{},
+		CloudWatchLogGroup:              CloudWatchLogGroupResourceType{},
 		CloudTrailTrail:                 ResourceType{},
 		CloudFrontDistribution:          ResourceType{},
-		CloudFormationStack:             ResourceType{},
+		CloudFormationStack:             CloudFormationStackResourceType{},
 		CodeDeployApplications:          ResourceType{},
 		ConfigServiceRecorder:           ResourceType{},
 		ConfigServiceRule:               ResourceType{},
@@ -46,7 +47,7 @@ func emptyConfig() *Config {
 		DynamoDB:                        ResourceType{},
 		EBSVolume:                       ResourceType{},
 		ElasticBeanstalk:                ResourceType{},
-		EC2:                             ResourceType{},
+		EC2:                             EC2ResourceType{},
 		EC2DedicatedHosts:               ResourceType{},
 		EC2DHCPOption:                   ResourceType{},
 		EC2KeyPairs:                     ResourceType{},
@@ -54,19 +55,21 @@ func emptyConfig() *Config {
 		EC2IPAMPool:                     ResourceType{},
 		EC2IPAMResourceDiscovery:        ResourceType{},
 		EC2IPAMScope:                    ResourceType{},
-		EC2Endpoint:                     EC2ResourceType{false, ResourceType{}},
-		EC2Subnet:                       EC2ResourceType{false, ResourceType{}},
+		EC2Endpoint:                     EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
+		EC2Subnet:                       EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
 		EC2PlacementGroups:              ResourceType{},
 		EgressOnlyInternetGateway:       ResourceType{},
 		ECRRepository:                   ResourceType{},
+		ECRImage:                        ECRImageResourceType{},
+		S3Object:                        S3ObjectResourceType{},
 		ECSCluster:                      ResourceType{},
 		ECSService:                      ResourceType{},
-		EKSCluster:                      ResourceType{},
-		ELBv1:                           ResourceType{},
-		ELBv2:                           ResourceType{},
+		EKSCluster:                      EC2ResourceType{},
+		ELBv1:                           EC2ResourceType{},
+		ELBv2:                           EC2ResourceType{},
 		ElasticFileSystem:               ResourceType{},
 		ElasticIP:                       ResourceType{},
-		ElastiCache:                     ResourceType{},
+		ElastiCache:                     EC2ResourceType{},
 		ElastiCacheParameterGroup:       ResourceType{},
 		ElastiCacheServerless:           ResourceType{},
 		ElastiCacheSubnetGroup:          ResourceType{},
@@ -86,19 +89,21 @@ func emptyConfig() *Config {
 		KMSCustomerKeys:                 KMSCustomerKeyResourceType{false, ResourceType{}},
 		KinesisStream:                   ResourceType{},
 		KinesisFirehose:                 ResourceType{},
-		LambdaFunction:                  ResourceType{},
+		LambdaFunction:                  EC2ResourceType{},
 		LambdaLayer:                     ResourceType{},
+		LambdaLayerVersion:              RetentionResourceType{},
+		LambdaVersion:                   RetentionResourceType{},
 		LaunchConfiguration:             ResourceType{},
 		LaunchTemplate:                  ResourceType{},
 		MacieMember:                     ResourceType{},
 		MSKCluster:                      ResourceType{},
-		NATGateway:                      EC2ResourceType{false, ResourceType{}},
+		NATGateway:                      EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
 		OIDCProvider:                    ResourceType{},
 		OpenSearchDomain:                ResourceType{},
 		Redshift:                        ResourceType{},
 		ResourceShare:                   ResourceType{},
-		RDSSnapshot:                     ResourceType{},
-		RDSClusterSnapshot:              ResourceType{},
+		RDSSnapshot:                     RetentionResourceType{},
+		RDSClusterSnapshot:              RetentionResourceType{},
 		RDSParameterGroup:               ResourceType{},
 		RDSProxy:                        ResourceType{},
 		S3:                              ResourceType{},
@@ -117,19 +122,19 @@ func emptyConfig() *Config {
 		SecretsManager:                  ResourceType{},
 		SSMParameter:                    ResourceType{},
 		SecurityHub:                     ResourceType{},
-		Snapshots:                       ResourceType{},
+		Snapshots:                       RetentionResourceType{},
 		TransitGateway:                  ResourceType{},
 		TransitGatewayRouteTable:        ResourceType{},
 		TransitGatewayVPCAttachment:     ResourceType{},
 		TransitGatewayPeeringAttachment: ResourceType{},
-		VPC:                             EC2ResourceType{false, ResourceType{}},
+		VPC:                             EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
 		Route53HostedZone:               ResourceType{},
 		Route53CIDRCollection:           ResourceType{},
 		Route53TrafficPolicy:            ResourceType{},
-		InternetGateway:                 EC2ResourceType{false, ResourceType{}},
+		InternetGateway:                 EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
 		NetworkACL:                      ResourceType{},
-		NetworkInterface:                EC2ResourceType{false, ResourceType{}},
-		SecurityGroup:                   EC2ResourceType{false, ResourceType{}},
+		NetworkInterface:                EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
+		SecurityGroup:                   EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
 		NetworkFirewall:                 ResourceType{},
 		NetworkFirewallPolicy:           ResourceType{},
 		NetworkFirewallRuleGroup:        ResourceType{},
@@ -138,7 +143,7 @@ func emptyConfig() *Config {
 		VPCLatticeServiceNetwork:        ResourceType{},
 		VPCLatticeService:               ResourceType{},
 		VPCLatticeTargetGroup:           ResourceType{},
-		RouteTable:                      EC2ResourceType{false, ResourceType{}},
+		RouteTable:                      EC2ResourceType{false, VpcResourceFilters{}, ResourceType{}},
 		VPCPeeringConnection:            ResourceType{},
 
 		// GCP Resources
@@ -198,6 +203,31 @@ func TestConfig_Empty(t *testing.T) {
 	return
 }
 
+func TestResourceTypeIsConfigured(t *testing.T) {
+	require.False(t, ResourceType{}.IsConfigured())
+	require.False(t, ResourceType{ttl: &TTLConfig{}, orphansOnly: true}.IsConfigured())
+	require.True(t, ResourceType{Timeout: "5m"}.IsConfigured())
+	require.True(t, ResourceType{IncludeRule: FilterRule{NamesRegExp: []Expression{{RE: *regexp.MustCompile("^dev-")}}}}.IsConfigured())
+}
+
+func TestS3ObjectIsConfigured(t *testing.T) {
+	require.False(t, S3ObjectResourceType{}.IsConfigured())
+	require.True(t, S3ObjectResourceType{Prefixes: []string{"tmp/"}}.IsConfigured())
+	require.True(t, S3ObjectResourceType{ResourceType: ResourceType{Timeout: "5m"}}.IsConfigured())
+}
+
+func TestRetentionResourceTypeIsConfigured(t *testing.T) {
+	require.False(t, RetentionResourceType{}.IsConfigured())
+	require.True(t, RetentionResourceType{Retain: &RetainRule{Keep: 3}}.IsConfigured())
+}
+
+func TestECRImageHasImageFilters(t *testing.T) {
+	require.False(t, ECRImageResourceType{SkipInUse: true}.HasImageFilters())
+	require.True(t, ECRImageResourceType{Untagged: true}.HasImageFilters())
+	require.True(t, ECRImageResourceType{PushedBefore: time.Hour}.HasImageFilters())
+	require.True(t, ECRImageResourceType{Retain: &RetainRule{Keep: 5}}.HasImageFilters())
+}
+
 func TestShouldInclude_AllowWhenEmpty(t *testing.T) {
 	var includeREs []Expression
 	var excludeREs []Expression
@@ -961,6 +991,22 @@ func TestAllResourceTypesComplete(t *testing.T) {
 			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
 		case reflect.TypeOf(KMSCustomerKeyResourceType{}):
 			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(CloudFormationStackResourceType{}):
+			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(RetentionResourceType{}):
+			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(ECRImageResourceType{}):
+			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(CloudWatchLogGroupResourceType{}):
+			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(S3ObjectResourceType{}):
+			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(map[string]*ResourceType{}), reflect.TypeOf([]CloudControlResourceType{}):
+			// Plugin and Cloud Control entries are dynamic; see TestPluginResourceTypes and TestCloudControlResourceTypes.
+			continue
+		case reflect.TypeOf(map[string]string{}), reflect.TypeOf(""), reflect.TypeOf(TTLConfig{}):
+			// Endpoints, protected identifiers, the CloudFormation members mode and TTL tags are not resource types.
+			continue
 		default:
 			t.Fatalf("Config field %q has unexpected type %s", fieldName, field.Type())
 		}
@@ -971,9 +1017,9 @@ func TestAllResourceTypesComplete(t *testing.T) {
 	}
 }
 
-func TestAllEC2ResourceTypesComplete(t *testing.T) {
+func TestAllVpcScopedResourceTypesComplete(t *testing.T) {
 	c := &Config{}
-	got := c.allEC2ResourceTypes()
+	got := c.allVpcScopedResourceTypes()
 
 	ptrSet := make(map[uintptr]bool, len(got))
 	for _, rt := range got {
@@ -988,10 +1034,11 @@ func TestAllEC2ResourceTypesComplete(t *testing.T) {
 		if field.Type() != ec2Type {
 			continue
 		}
-		if !ptrSet[field.Addr().Pointer()] {
-			t.Errorf("EC2ResourceType field %q is missing from allEC2ResourceTypes()", fieldName)
+		if !ptrSet[field.FieldByName("VpcResourceFilters").Addr().Pointer()] {
+			t.Errorf("EC2ResourceType field %q is missing from allVpcScopedResourceTypes()", fieldName)
 		}
 	}
+	assert.True(t, ptrSet[reflect.ValueOf(&c.DBInstances.VpcResourceFilters).Pointer()], "DBInstances is missing from allVpcScopedResourceTypes()")
 }
 
 func TestShouldIncludeBasedOnTag_NilTagsSafety(t *testing.T) {
@@ -1017,3 +1064,372 @@ func TestShouldIncludeBasedOnTag_NilTagsSafety(t *testing.T) {
 	r2 := ResourceType{}
 	assert.True(t, r2.ShouldIncludeBasedOnTag(nil))
 }
+
+func TestPluginResourceTypes(t *testing.T) {
+	configured := &ResourceType{Timeout: "5m"}
+	c := Config{Plugins: map[string]*ResourceType{"custom-widget": configured}}
+
+	copied := c
+	copied.PrepareDynamicResourceTypes([]string{"custom-widget", "custom-gadget"})
+	excludeAfter := time.Now()
+	copied.AddExcludeAfterTime(&excludeAfter)
+
+	// Global filters reach both the configured and the implicitly added plugin.
+	require.Equal(t, &excludeAfter, copied.Plugin("custom-widget").ExcludeRule.TimeAfter)
+	require.Equal(t, &excludeAfter, copied.Plugin("custom-gadget").ExcludeRule.TimeAfter)
+	require.Equal(t, "5m", copied.Plugin("custom-widget").Timeout)
+
+	// The caller's config is left untouched.
+	require.Nil(t, configured.ExcludeRule.TimeAfter)
+	require.Len(t, c.Plugins, 1)
+	require.Equal(t, ResourceType{}, c.Plugin("unknown"))
+}
+
+func TestPluginsFromYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`Plugins:
+  custom-widget:
+    include:
+      names_regex:
+        - ^test-
+`), 0600))
+
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	require.Len(t, c.Plugin("custom-widget").IncludeRule.NamesRegExp, 1)
+	require.Equal(t, "^test-", c.Plugin("custom-widget").IncludeRule.NamesRegExp[0].RE.String())
+}
+
+func TestResourceTypeJSONRoundTrip(t *testing.T) {
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rt := ResourceType{
+		IncludeRule: FilterRule{
+			NamesRegExp: []Expression{{RE: *regexp.MustCompile("[a-z]+")}},
+			TimeAfter:   &after,
+		},
+		ExcludeRule: FilterRule{
+			Tags: map[string]Expression{"team": {RE: *regexp.MustCompile("^platform$")}},
+		},
+	}
+
+	data, err := json.Marshal(rt)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"include": {"names_regex": ["[a-z]+"], "time_after": "2024-01-01T00:00:00Z"},
+		"exclude": {"tags": {"team": "^platform$"}}
+	}`, string(data))
+
+	var decoded ResourceType
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, "[a-z]+", decoded.IncludeRule.NamesRegExp[0].RE.String())
+	team := decoded.ExcludeRule.Tags["team"]
+	require.Equal(t, "^platform$", team.RE.String())
+	require.Equal(t, after, *decoded.IncludeRule.TimeAfter)
+}
+
+func TestCloudControlResourceTypes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`cloudcontrol:
+  - type: AWS::Glue::Job
+    exclude:
+      names_regex:
+        - ^prod-
+  - type: AWS::IAM::OIDCProvider
+    global: true
+    name_property: Url
+`), 0600))
+
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	require.Len(t, c.CloudControl, 2)
+
+	glue, ok := c.CloudControlType("AWS::Glue::Job")
+	require.True(t, ok)
+	require.Len(t, glue.ExcludeRule.NamesRegExp, 1)
+	oidc, ok := c.CloudControlType("AWS::IAM::OIDCProvider")
+	require.True(t, ok)
+	require.True(t, oidc.Global)
+	require.Equal(t, "Url", oidc.NameProperty)
+	_, ok = c.CloudControlType("AWS::Nope::Nope")
+	require.False(t, ok)
+
+	// Global filters reach the declared types without modifying the original config.
+	copied := *c
+	copied.PrepareDynamicResourceTypes(nil)
+	excludeAfter := time.Now()
+	copied.AddExcludeAfterTime(&excludeAfter)
+	glue, _ = copied.CloudControlType("AWS::Glue::Job")
+	require.Equal(t, &excludeAfter, glue.ExcludeRule.TimeAfter)
+	require.Nil(t, c.CloudControl[0].ExcludeRule.TimeAfter)
+}
+
+func TestEndpoints(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`endpoints:
+  S3: http://localhost:4566
+  CloudWatch Logs: http://localhost:5000
+`), 0600))
+
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"S3": "http://localhost:4566", "CloudWatch Logs": "http://localhost:5000"}, c.Endpoints)
+}
+
+func TestAddVpcIDs(t *testing.T) {
+	testConfig := &Config{}
+	testConfig.ELBv2.VpcIDs = []string{"vpc-config"}
+
+	testConfig.AddVpcIDs([]string{"vpc-flag"})
+	assert.Equal(t, []string{"vpc-flag"}, testConfig.VPC.VpcIDs)
+	assert.Equal(t, []string{"vpc-flag"}, testConfig.LambdaFunction.VpcIDs)
+	assert.Equal(t, []string{"vpc-config"}, testConfig.ELBv2.VpcIDs)
+}
+
+func TestInVpc(t *testing.T) {
+	assert.True(t, EC2ResourceType{}.InVpc(nil))
+	assert.True(t, EC2ResourceType{}.InVpc(aws.String("vpc-1")))
+
+	r := EC2ResourceType{VpcResourceFilters: VpcResourceFilters{VpcIDs: []string{"vpc-1", "vpc-2"}}}
+	assert.True(t, r.InVpc(aws.String("vpc-2")))
+	assert.False(t, r.InVpc(aws.String("vpc-3")))
+	assert.False(t, r.InVpc(nil))
+}
+
+func TestSkipCloudFormationMembers(t *testing.T) {
+	stackID := "arn:aws:cloudformation:us-east-1:123456789012:stack/app/1234"
+	member := ResourceValue{Name: aws.String("i-member"), Tags: map[string]string{CloudFormationStackIDTagKey: stackID}}
+	excluded := ResourceValue{Name: aws.String("skip-me"), Tags: map[string]string{CloudFormationStackIDTagKey: "arn:aws:cloudformation:us-east-1:123456789012:stack/other/5678"}}
+	standalone := ResourceValue{Name: aws.String("i-standalone"), Tags: map[string]string{}}
+
+	testConfig := &Config{}
+	testConfig.EC2.ExcludeRule.NamesRegExp = []Expression{{RE: *regexp.MustCompile("^skip-")}}
+	assert.True(t, testConfig.EC2.ShouldInclude(member))
+
+	stacks := NewCloudFormationStackSet()
+	testConfig.SkipCloudFormationMembers(stacks)
+	assert.False(t, testConfig.EC2.ShouldInclude(member))
+	assert.False(t, testConfig.EC2.ShouldInclude(excluded))
+	assert.True(t, testConfig.EC2.ShouldInclude(standalone))
+	assert.True(t, testConfig.CloudFormationStack.ShouldInclude(member))
+
+	// Only stacks owning resources that pass the other filters are recorded
+	assert.Equal(t, []string{stackID}, stacks.IDs())
+}
+
+func TestOnlyOrphans(t *testing.T) {
+	testConfig := &Config{}
+	assert.False(t, testConfig.EBSVolume.OrphansOnly())
+	assert.True(t, testConfig.EBSVolume.ShouldIncludeOrphan("us-east-1", "vol-in-use", ""))
+
+	findings := NewFindings()
+	testConfig.RecordFindings(findings)
+	testConfig.OnlyOrphans()
+	assert.True(t, testConfig.EBSVolume.OrphansOnly())
+	assert.True(t, testConfig.NATGateway.OrphansOnly())
+	assert.False(t, testConfig.EBSVolume.ShouldIncludeOrphan("us-east-1", "vol-in-use", ""))
+	assert.True(t, testConfig.EBSVolume.ShouldIncludeOrphan("us-east-1", "vol-orphan", "unattached"))
+
+	assert.Equal(t, "unattached", findings.Detail("us-east-1", "vol-orphan"))
+	assert.Empty(t, findings.Detail("us-west-2", "vol-orphan"))
+	assert.Empty(t, findings.Detail("us-east-1", "vol-in-use"))
+}
+
+func TestCloudFormationMembersFromYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("cloudformation_members: stack-first\n"), 0600))
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, CloudFormationMembersStackFirst, c.CloudFormationMembers)
+
+	require.NoError(t, os.WriteFile(path, []byte("cloudformation_members: delete\n"), 0600))
+	_, err = GetConfig(path)
+	require.Error(t, err)
+}
+
+func TestIdleFromYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("EC2:\n  idle: {metric: CPUUtilization, max: 2, days: 14}\n"), 0600))
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, &IdleRule{Metric: "CPUUtilization", Max: 2, Days: 14}, c.EC2.Idle)
+	assert.Nil(t, c.NATGateway.Idle)
+
+	require.NoError(t, os.WriteFile(path, []byte("ELBv2:\n  idle: {max: 0}\n"), 0600))
+	_, err = GetConfig(path)
+	require.Error(t, err)
+
+	// Resource types without a usage metric are rejected before anything is listed
+	require.NoError(t, os.WriteFile(path, []byte("SecurityGroup:\n  idle: {max: 0, days: 14}\n"), 0600))
+	_, err = GetConfig(path)
+	require.ErrorContains(t, err, "invalid idle rule")
+
+	// DBInstances and DBClusters share a config type, but only DBInstances has VPC and idle filters
+	require.NoError(t, os.WriteFile(path, []byte("DBInstances:\n  vpc_ids: [vpc-1]\n  idle: {max: 2, days: 14}\n"), 0600))
+	c, err = GetConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vpc-1"}, c.DBInstances.VpcIDs)
+	require.NoError(t, os.WriteFile(path, []byte("DBClusters:\n  vpc_ids: [vpc-1]\n"), 0600))
+	_, err = GetConfig(path)
+	require.ErrorContains(t, err, "invalid DBClusters config")
+}
+
+func TestRetainFromYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("AMI:\n  retain:\n    keep: 5\n    name_regex: '^(golden-.*)-\\d+$'\n"), 0600))
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	require.NotNil(t, c.AMI.Retain)
+	assert.Equal(t, 5, c.AMI.Retain.Keep)
+	assert.Equal(t, `^(golden-.*)-\d+$`, c.AMI.Retain.NameRegex.RE.String())
+	assert.Nil(t, c.Snapshots.Retain)
+
+	require.NoError(t, os.WriteFile(path, []byte("Snapshots:\n  retain: {keep: 0, tag: family}\n"), 0600))
+	_, err = GetConfig(path)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("RDSSnapshot:\n  retain: {keep: 1, tag: family, name_regex: db}\n"), 0600))
+	_, err = GetConfig(path)
+	require.Error(t, err)
+}
+
+func TestEnforceRetentionDaysFromYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("CloudWatchLogGroup:\n  enforce_retention_days: 30\n"), 0600))
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, int32(30), c.CloudWatchLogGroup.EnforceRetentionDays)
+
+	require.NoError(t, os.WriteFile(path, []byte("CloudWatchLogGroup:\n  enforce_retention_days: 31\n"), 0600))
+	_, err = GetConfig(path)
+	require.ErrorContains(t, err, "invalid enforce_retention_days 31")
+}
+
+func TestRetainRuleSurplus(t *testing.T) {
+	now := time.Now()
+	value := func(id string, age time.Duration, family string) ResourceValue {
+		created := now.Add(-age)
+		return ResourceValue{ID: aws.String(id), Time: &created, Tags: map[string]string{"family": family}}
+	}
+	values := []ResourceValue{
+		value("snap-1", 3*time.Hour, "web"),
+		value("snap-2", 1*time.Hour, "web"),
+		value("snap-3", 2*time.Hour, "web"),
+		value("snap-4", 4*time.Hour, "db"),
+		{ID: aws.String("snap-5")},
+	}
+
+	findings := NewFindings()
+	testConfig := &Config{}
+	testConfig.RecordFindings(findings)
+	cfg := testConfig.Snapshots.ResourceType.InRegion("us-east-1")
+
+	var nilRule *RetainRule
+	assert.Equal(t, values, nilRule.Surplus(cfg, values))
+
+	byTag := &RetainRule{Keep: 1, Tag: "family"}
+	surplus := byTag.Surplus(cfg, values)
+	require.Len(t, surplus, 2)
+	assert.Equal(t, "snap-3", *surplus[0].ID)
+	assert.Equal(t, "snap-1", *surplus[1].ID)
+	assert.Equal(t, `#3 newest of group "web", beyond the 1 retained`, findings.Detail("us-east-1", "snap-1"))
+
+	// Without grouping, resources without a creation time count as the newest
+	surplus = (&RetainRule{Keep: 4}).Surplus(cfg, values)
+	require.Len(t, surplus, 1)
+	assert.Equal(t, "snap-4", *surplus[0].ID)
+	assert.Equal(t, "#5 newest, beyond the 4 retained", findings.Detail("us-east-1", "snap-4"))
+}
+
+func TestFindingsJoinDetails(t *testing.T) {
+	findings := NewFindings()
+	testConfig := &Config{}
+	testConfig.EC2.AddFinding("us-east-1", "i-1", "ignored")
+	testConfig.RecordFindings(findings)
+	testConfig.EC2.AddFinding("us-east-1", "i-1", "unattached")
+	testConfig.EC2.AddFinding("us-east-1", "i-1", "idle")
+	assert.Equal(t, "unattached; idle", findings.Detail("us-east-1", "i-1"))
+}
+
+func TestShouldIncludeBasedOnTTL(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-48 * time.Hour)
+	firstSeen := now.Add(-48 * time.Hour).Format(time.RFC3339)
+	disabled := false
+
+	tests := []struct {
+		name     string
+		rt       ResourceType
+		value    ResourceValue
+		included bool
+		detail   string
+	}{
+		{
+			name:     "no ttl tag",
+			value:    ResourceValue{Name: aws.String("r"), Time: &created, Tags: map[string]string{}},
+			included: true,
+		},
+		{
+			name:     "ttl not yet expired",
+			value:    ResourceValue{Name: aws.String("r"), Time: &created, Tags: map[string]string{CloudNukeTTLTagKey: "72h"}},
+			included: false,
+		},
+		{
+			name:     "ttl in days expired",
+			value:    ResourceValue{ID: aws.String("i-1"), Name: aws.String("r"), Time: &created, Tags: map[string]string{CloudNukeTTLTagKey: "1d"}},
+			included: true,
+			detail:   "cloud-nuke-ttl=1d expired at " + created.Add(24*time.Hour).UTC().Format(time.RFC3339),
+		},
+		{
+			name:     "first-seen tag stands in for creation time",
+			value:    ResourceValue{Name: aws.String("r"), Tags: map[string]string{CloudNukeTTLTagKey: "36h", CloudNukeFirstSeenTagKey: firstSeen}},
+			included: true,
+		},
+		{
+			name:     "unknown creation time stays protected",
+			value:    ResourceValue{Name: aws.String("r"), Tags: map[string]string{CloudNukeTTLTagKey: "1h"}},
+			included: false,
+		},
+		{
+			name:     "unparseable ttl is ignored",
+			value:    ResourceValue{Name: aws.String("r"), Time: &created, Tags: map[string]string{CloudNukeTTLTagKey: "forever"}},
+			included: true,
+		},
+		{
+			name:     "protect_until_expire false ignores ttl",
+			rt:       ResourceType{ProtectUntilExpire: &disabled},
+			value:    ResourceValue{Name: aws.String("r"), Time: &created, Tags: map[string]string{CloudNukeTTLTagKey: "72h"}},
+			included: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			findings := NewFindings()
+			tc.rt.findings = findings
+			assert.Equal(t, tc.included, tc.rt.InRegion("us-east-1").ShouldInclude(tc.value))
+			if tc.detail != "" {
+				assert.Equal(t, tc.detail, findings.Detail("us-east-1", "i-1"))
+			}
+		})
+	}
+}
+
+func TestTTLFromYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ttl:\n  tag_keys: [ttl, cloud-nuke-ttl]\n  units: {h: 1h, sprint: 336h}\n"), 0600))
+	c, err := GetConfig(path)
+	require.NoError(t, err)
+
+	ttl, err := c.EC2.ttl.ParseTTL("2sprint")
+	require.NoError(t, err)
+	assert.Equal(t, 672*time.Hour, ttl)
+	_, err = c.EC2.ttl.ParseTTL("14d")
+	require.Error(t, err)
+
+	created := time.Now().Add(-2 * time.Hour)
+	assert.False(t, c.EC2.ShouldInclude(ResourceValue{Time: &created, Tags: map[string]string{"ttl": "3h", CloudNukeTTLTagKey: "1h"}}))
+	assert.True(t, c.EC2.ShouldInclude(ResourceValue{Time: &created, Tags: map[string]string{CloudNukeTTLTagKey: "1h"}}))
+
+	require.NoError(t, os.WriteFile(path, []byte("ttl:\n  units: {d: tomorrow}\n"), 0600))
+	_, err = GetConfig(path)
+	require.Error(t, err)
+}
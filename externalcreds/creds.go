@@ -2,6 +2,7 @@ package externalcreds
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -11,6 +12,16 @@ import (
 // When non-nil, Get delegates to this function instead of using LoadDefaultConfig.
 var configProvider func(region string) (aws.Config, error)
 
+// httpClient is an optional override for the HTTP client of every config returned by Get.
+var httpClient aws.HTTPClient
+
+// baseEndpoint and serviceEndpoints redirect the clients built from Get to a stand-in for AWS,
+// such as LocalStack or moto-server.
+var (
+	baseEndpoint     string
+	serviceEndpoints serviceEndpointSource
+)
+
 // SetConfigProvider overrides the default AWS config creation used by cloud-nuke.
 // This is useful when importing cloud-nuke as a library and retrieving AWS
 // credentials at runtime (e.g., assume-role, vault, custom credential providers).
@@ -21,18 +32,81 @@ func SetConfigProvider(fn func(region string) (aws.Config, error)) {
 	configProvider = fn
 }
 
+// SetHTTPClient overrides the HTTP client used by every AWS client created from the config returned
+// by Get, for example to record or replay API traffic. It also applies to configs from a custom
+// config provider.
+//
+// Pass nil to restore the default HTTP client.
+func SetHTTPClient(client aws.HTTPClient) {
+	httpClient = client
+}
+
+// SetEndpoints points every AWS client created from the config returned by Get at a custom endpoint.
+// baseURL applies to all services, and services overrides it for individual services keyed by SDK
+// service ID, e.g. "S3" or "CloudWatch Logs" (matched case-insensitively, ignoring spaces, dashes
+// and underscores).
+//
+// Pass empty values to restore the default AWS endpoints.
+// This should be called once at startup, before any cloud-nuke operations.
+func SetEndpoints(baseURL string, services map[string]string) {
+	baseEndpoint = baseURL
+	serviceEndpoints = nil
+	if len(services) > 0 {
+		serviceEndpoints = make(serviceEndpointSource, len(services))
+		for id, url := range services {
+			serviceEndpoints[normalizeServiceID(id)] = url
+		}
+	}
+}
+
+// UsePathStyle reports whether S3 clients should use path-style addressing. Local stand-ins for AWS
+// usually cannot serve virtual-hosted bucket addresses, so it is enabled whenever an endpoint is set.
+func UsePathStyle() bool {
+	return baseEndpoint != "" || len(serviceEndpoints) > 0
+}
+
 func Get(region string) (aws.Config, error) {
 	if configProvider != nil {
-		return configProvider(region)
+		cfg, err := configProvider(region)
+		if err != nil {
+			return aws.Config{}, err
+		}
+		return withOverrides(cfg), nil
 	}
 
 	cfg, err := config.LoadDefaultConfig(context.TODO(),
 		config.WithRegion(region),
 	)
-
 	if err != nil {
 		return aws.Config{}, err
 	}
+	return withOverrides(cfg), nil
+}
+
+// withOverrides applies the HTTP client and endpoints from SetHTTPClient and SetEndpoints to cfg.
+// Service clients give a per-service endpoint from a config source precedence over cfg.BaseEndpoint,
+// so the endpoint overrides are added as the first config source.
+func withOverrides(cfg aws.Config) aws.Config {
+	if httpClient != nil {
+		cfg.HTTPClient = httpClient
+	}
+	if baseEndpoint != "" {
+		cfg.BaseEndpoint = aws.String(baseEndpoint)
+	}
+	if len(serviceEndpoints) > 0 {
+		cfg.ConfigSources = append([]interface{}{serviceEndpoints}, cfg.ConfigSources...)
+	}
+	return cfg
+}
+
+// serviceEndpointSource implements the SDK's service base endpoint provider, keyed by normalized service ID.
+type serviceEndpointSource map[string]string
+
+func (s serviceEndpointSource) GetServiceBaseEndpoint(_ context.Context, sdkID string) (string, bool, error) {
+	url, ok := s[normalizeServiceID(sdkID)]
+	return url, ok, nil
+}
 
-	return cfg, nil
+func normalizeServiceID(id string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(id))
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,3 +56,26 @@ func TestGet_NilProviderRestoresDefault(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "ap-southeast-1", cfg.Region)
 }
+
+func TestGet_Endpoints(t *testing.T) {
+	SetConfigProvider(func(region string) (aws.Config, error) {
+		return aws.Config{Region: region}, nil
+	})
+	SetEndpoints("http://localhost:4566", map[string]string{"Cloud-Watch_logs": "http://localhost:5000"})
+	t.Cleanup(func() {
+		SetConfigProvider(nil)
+		SetEndpoints("", nil)
+	})
+
+	cfg, err := Get("us-east-1")
+	require.NoError(t, err)
+	assert.True(t, UsePathStyle())
+	assert.Equal(t, "http://localhost:4566", aws.ToString(ec2.NewFromConfig(cfg).Options().BaseEndpoint))
+	assert.Equal(t, "http://localhost:5000", aws.ToString(cloudwatchlogs.NewFromConfig(cfg).Options().BaseEndpoint))
+
+	SetEndpoints("", nil)
+	cfg, err = Get("us-east-1")
+	require.NoError(t, err)
+	assert.False(t, UsePathStyle())
+	assert.Nil(t, ec2.NewFromConfig(cfg).Options().BaseEndpoint)
+}
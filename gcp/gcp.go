@@ -18,7 +18,6 @@ import (
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/collections"
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -66,7 +65,12 @@ func GetAllResources(ctx context.Context, query *Query, configObj config.Config,
 	setupGroup := new(errgroup.Group)
 	for _, region := range query.Regions {
 		setupGroup.Go(func() error {
-			cfg := resources.GcpConfig{ProjectID: query.ProjectID, Region: region}
+			cfg := resources.GcpConfig{
+				ProjectID:     query.ProjectID,
+				Region:        region,
+				ClientOptions: query.ClientOptions,
+				HTTPClient:    query.HTTPClient,
+			}
 			regionResources := GetAndInitRegisteredResources(cfg, region)
 			setup := &regionSetup{}
 			for i, res := range regionResources {
@@ -191,14 +195,17 @@ func NukeAllResources(ctx context.Context, account *GcpProjectResources, regions
 	eg.SetLimit(p)
 	var mu sync.Mutex
 	var allErrors *multierror.Error
+	var notAttempted []reporting.UnattemptedResource
 
 	for _, region := range regions {
 		eg.Go(func() error {
-			if err := nukeAllResourcesInRegion(ctx, account, region, collector); err != nil {
-				mu.Lock()
+			unattempted, err := nukeAllResourcesInRegion(ctx, account, region, collector)
+			mu.Lock()
+			notAttempted = append(notAttempted, unattempted...)
+			if err != nil {
 				allErrors = multierror.Append(allErrors, err)
-				mu.Unlock()
 			}
+			mu.Unlock()
 			return nil
 		})
 	}
@@ -206,28 +213,41 @@ func NukeAllResources(ctx context.Context, account *GcpProjectResources, regions
 		allErrors = multierror.Append(allErrors, err)
 	}
 
+	// Report the resources that were never attempted if the operation was interrupted
+	if ctx.Err() != nil {
+		logging.Warnf("Nuking interrupted: %d resources were not attempted", len(notAttempted))
+		collector.Emit(reporting.NukeInterrupted{Reason: context.Cause(ctx).Error(), NotAttempted: notAttempted})
+		allErrors = multierror.Append(allErrors, context.Cause(ctx))
+	}
+
 	// Emit NukeComplete event (triggers final output in renderers)
 	collector.Emit(reporting.NukeComplete{})
 
 	return allErrors.ErrorOrNil()
 }
 
-// nukeAllResourcesInRegion nukes all resources in a single region.
-func nukeAllResourcesInRegion(ctx context.Context, account *GcpProjectResources, region string, collector *reporting.Collector) error {
+// nukeAllResourcesInRegion nukes all resources in a single region, and returns the resources it did
+// not attempt because ctx was canceled.
+func nukeAllResourcesInRegion(ctx context.Context, account *GcpProjectResources, region string, collector *reporting.Collector) ([]reporting.UnattemptedResource, error) {
 	var allErrors *multierror.Error
+	var notAttempted []reporting.UnattemptedResource
 
 	resourcesInRegion := account.Resources[region]
 	for _, gcpResource := range resourcesInRegion.Resources {
-		if err := nukeResource(ctx, gcpResource, region, collector); err != nil {
+		unattempted, err := nukeResource(ctx, gcpResource, region, collector)
+		notAttempted = append(notAttempted, unattempted...)
+		if err != nil {
 			allErrors = multierror.Append(allErrors, err)
 		}
 	}
 
-	return allErrors.ErrorOrNil()
+	return notAttempted, allErrors.ErrorOrNil()
 }
 
-// nukeResource nukes a single GCP resource type
-func nukeResource(ctx context.Context, gcpResource *GcpResource, region string, collector *reporting.Collector) error {
+// nukeResource nukes a single GCP resource type batch by batch. Once ctx is canceled, the batch in
+// flight is given util.InterruptGracePeriod to finish and no new batch is started; the resources of
+// the batches that were not started are returned.
+func nukeResource(ctx context.Context, gcpResource *GcpResource, region string, collector *reporting.Collector) ([]reporting.UnattemptedResource, error) {
 	// Filter to only nukable resources
 	var nukableIdentifiers []string
 	for _, id := range (*gcpResource).ResourceIdentifiers() {
@@ -239,7 +259,7 @@ func nukeResource(ctx context.Context, gcpResource *GcpResource, region string,
 	}
 
 	if len(nukableIdentifiers) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	// Split API calls into batches
@@ -249,6 +269,10 @@ func nukeResource(ctx context.Context, gcpResource *GcpResource, region string,
 	var allErrors *multierror.Error
 
 	for i, batch := range batches {
+		if ctx.Err() != nil {
+			return reporting.Unattempted((*gcpResource).ResourceName(), region, slices.Concat(batches[i:]...)), allErrors.ErrorOrNil()
+		}
+
 		// Emit progress event (CLIRenderer updates its progress bar)
 		collector.Emit(reporting.NukeProgress{
 			ResourceType: (*gcpResource).ResourceName(),
@@ -256,7 +280,9 @@ func nukeResource(ctx context.Context, gcpResource *GcpResource, region string,
 			BatchSize:    len(batch),
 		})
 
-		results, err := (*gcpResource).Nuke(ctx, batch)
+		batchCtx, cancel := util.WithGracePeriod(ctx, util.InterruptGracePeriod)
+		results, err := (*gcpResource).Nuke(batchCtx, batch)
+		cancel()
 
 		// Emit ResourceDeleted for each result
 		for _, result := range results {
@@ -270,6 +296,7 @@ func nukeResource(ctx context.Context, gcpResource *GcpResource, region string,
 				Identifier:   result.Identifier,
 				Success:      result.Error == nil,
 				Error:        errStr,
+				Detail:       result.Detail,
 			})
 		}
 
@@ -278,27 +305,23 @@ func nukeResource(ctx context.Context, gcpResource *GcpResource, region string,
 				logging.Debug(
 					"Quota exceeded. Waiting 1 minute before making new requests",
 				)
-				time.Sleep(1 * time.Minute)
+				util.Sleep(ctx, 1*time.Minute)
 				continue
 			}
 
 			allErrors = multierror.Append(allErrors, fmt.Errorf("[%s] %s: %w", region, (*gcpResource).ResourceName(), err))
 
 			// Report to telemetry - aggregated metrics of failures per resources.
-			telemetry.TrackEvent(commonTelemetry.EventContext{
-				EventName: fmt.Sprintf("error:Nuke:%s", (*gcpResource).ResourceName()),
-			}, map[string]interface{}{
-				"region": region,
-			})
+			telemetry.Track(telemetry.NukeError((*gcpResource).ResourceName(), region, err))
 		}
 
 		if i != len(batches)-1 {
 			logging.Debug("Sleeping for 10 seconds before processing next batch...")
-			time.Sleep(10 * time.Second)
+			util.Sleep(ctx, 10*time.Second)
 		}
 	}
 
-	return allErrors.ErrorOrNil()
+	return nil, allErrors.ErrorOrNil()
 }
 
 // ListResourceTypes returns a sorted list of resources which can be passed to --resource-type
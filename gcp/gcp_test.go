@@ -1,9 +1,14 @@
 package gcp
 
 import (
+	"context"
 	"testing"
 
+	"github.com/gruntwork-io/cloud-nuke/cassette"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsNukeable_EmptyLists(t *testing.T) {
@@ -50,3 +55,43 @@ func TestIsNukeable_ExcludeOverridesAll(t *testing.T) {
 	assert.False(t, IsNukeable("Excluded", []string{"all"}, []string{"Excluded"}))
 	assert.True(t, IsNukeable("Included", []string{"all"}, []string{"Excluded"}))
 }
+
+// eventRecorder is a reporting.Renderer that keeps every event it receives.
+type eventRecorder struct {
+	events []reporting.Event
+}
+
+func (r *eventRecorder) OnEvent(event reporting.Event) {
+	r.events = append(r.events, event)
+}
+
+// TestReplayPubSubTopics lists and nukes Pub/Sub topics from a recorded cassette. The listing labels
+// the topic seen for the first time, and the topic that is already gone when it is deleted counts as deleted.
+func TestReplayPubSubTopics(t *testing.T) {
+	replayer, err := cassette.NewReplayer("testdata/pubsub.json")
+	require.NoError(t, err)
+	httpClient, err := replayer.GCPHTTPClient(context.Background())
+	require.NoError(t, err)
+
+	query := &Query{ProjectID: "example-project", ResourceTypes: []string{"gcp-pubsub-topic"}, HTTPClient: httpClient}
+	require.NoError(t, query.Validate())
+	events := &eventRecorder{}
+	collector := reporting.NewCollector()
+	collector.AddRenderer(events)
+
+	account, err := GetAllResources(context.Background(), query, config.Config{}, collector)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"gcp-pubsub-topic": {"projects/example-project/topics/orders", "projects/example-project/topics/invoices"},
+	}, account.MapResourceTypeToIdentifiers())
+
+	require.NoError(t, NukeAllResources(context.Background(), account, query.Regions, 1, collector))
+	var deleted []string
+	for _, event := range events.events {
+		if e, ok := event.(reporting.ResourceDeleted); ok {
+			assert.True(t, e.Success, e.Error)
+			deleted = append(deleted, e.Identifier)
+		}
+	}
+	assert.Equal(t, []string{"projects/example-project/topics/orders", "projects/example-project/topics/invoices"}, deleted)
+}
@@ -2,9 +2,11 @@ package gcp
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/gruntwork-io/go-commons/collections"
+	"google.golang.org/api/option"
 )
 
 // Query represents the desired parameters for scanning GCP resources.
@@ -20,6 +22,13 @@ type Query struct {
 	Timeout              *time.Duration
 	ExcludeFirstSeen     bool
 	Parallelism          int
+
+	// ClientOptions are passed to every GCP client (e.g. option.WithCredentialsFile).
+	// When empty, clients use Application Default Credentials.
+	ClientOptions []option.ClientOption
+
+	// HTTPClient, when set, carries every GCP API call over REST (see resources.GcpConfig).
+	HTTPClient *http.Client
 }
 
 // Validate ensures the query has valid defaults.
@@ -3,9 +3,12 @@ package resources
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/resource"
+	"google.golang.org/api/option"
 )
 
 const (
@@ -18,6 +21,22 @@ const (
 type GcpConfig struct {
 	ProjectID string
 	Region    string
+
+	// ClientOptions are passed to every GCP client constructor (e.g. option.WithCredentialsFile).
+	// When empty, clients use Application Default Credentials.
+	ClientOptions []option.ClientOption
+
+	// HTTPClient, when set, carries every API call. Clients then use their REST transport instead of
+	// gRPC, so that the traffic can be recorded and replayed (see the cassette package).
+	HTTPClient *http.Client
+}
+
+// clientOptions returns the options for a GCP client constructor, including HTTPClient if set.
+func (cfg GcpConfig) clientOptions() []option.ClientOption {
+	if cfg.HTTPClient == nil {
+		return cfg.ClientOptions
+	}
+	return append(slices.Clone(cfg.ClientOptions), option.WithHTTPClient(cfg.HTTPClient))
 }
 
 // GcpInitClientFunc is the type-safe client initialization function signature.
@@ -34,7 +34,11 @@ func NewArtifactRegistryRepositories() GcpResource {
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*artifactregistry.Client], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
-			client, err := artifactregistry.NewClient(context.Background())
+			newClient := artifactregistry.NewClient
+			if cfg.HTTPClient != nil {
+				newClient = artifactregistry.NewRESTClient
+			}
+			client, err := newClient(context.Background(), cfg.clientOptions()...)
 			if err != nil {
 				r.InitializationError = fmt.Errorf("failed to create Artifact Registry client: %w", err)
 				return
@@ -23,7 +23,11 @@ func NewCloudFunctions() GcpResource {
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*functions.FunctionClient], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
-			client, err := functions.NewFunctionClient(context.Background())
+			newClient := functions.NewFunctionClient
+			if cfg.HTTPClient != nil {
+				newClient = functions.NewFunctionRESTClient
+			}
+			client, err := newClient(context.Background(), cfg.clientOptions()...)
 			if err != nil {
 				panic(fmt.Sprintf("failed to create Cloud Functions client: %v", err))
 			}
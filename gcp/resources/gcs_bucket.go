@@ -21,7 +21,7 @@ func NewGCSBuckets() GcpResource {
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*storage.Client], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
-			client, err := storage.NewClient(context.Background())
+			client, err := storage.NewClient(context.Background(), cfg.clientOptions()...)
 			if err != nil {
 				// Panic is recovered by GcpResourceAdapter.Init() and stored as initErr,
 				// causing subsequent GetAndSetIdentifiers/Nuke calls to return the error gracefully.
@@ -33,7 +33,11 @@ func NewPubSubTopics() GcpResource {
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*pubsub.PublisherClient], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
-			client, err := pubsub.NewPublisherClient(context.Background())
+			newClient := pubsub.NewPublisherClient
+			if cfg.HTTPClient != nil {
+				newClient = pubsub.NewPublisherRESTClient
+			}
+			client, err := newClient(context.Background(), cfg.clientOptions()...)
 			if err != nil {
 				panic(fmt.Sprintf("failed to create Pub/Sub publisher client: %v", err))
 			}
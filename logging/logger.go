@@ -1,14 +1,30 @@
 package logging
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+
 	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
-	"os"
+)
+
+// Log formats accepted by Configure.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
 )
 
 var Logger = InitLogger()
 
+// structured is true once Configure routes log calls through Logger instead of the pterm printers,
+// and baseFields are added to each of its entries.
+var (
+	structured bool
+	baseFields logrus.Fields
+)
+
 func InitLogger() *logrus.Logger {
 	logger := logrus.New()
 
@@ -36,8 +52,85 @@ func ParseLogLevel(logLevel string) error {
 	return nil
 }
 
+// Configure sets where and how logs are written. With the text format and no file, logs are printed
+// with pterm alongside the interactive UI, as by default. Otherwise every log call goes through Logger,
+// which writes to the file, or stderr if file is empty, so that machine-readable logs never interleave
+// with the spinner and progress bar on stdout. runID is added to every structured entry. The returned
+// function closes the log file.
+func Configure(format string, file string, runID string) (func() error, error) {
+	var formatter logrus.Formatter
+	switch format {
+	case "", FormatText:
+		if file == "" {
+			return func() error { return nil }, nil
+		}
+		formatter = &logrus.TextFormatter{DisableColors: true, FullTimestamp: true}
+	case FormatJSON:
+		formatter = &logrus.JSONFormatter{}
+	default:
+		return nil, fmt.Errorf("invalid log format %q: must be %s or %s", format, FormatText, FormatJSON)
+	}
+
+	var output io.Writer = os.Stderr
+	closeFn := func() error { return nil }
+	if file != "" {
+		f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open log file %s: %w", file, err)
+		}
+		output, closeFn = f, f.Close
+	}
+
+	Logger.SetFormatter(formatter)
+	Logger.SetOutput(output)
+	structured = true
+	baseFields = logrus.Fields{}
+	if runID != "" {
+		baseFields["run_id"] = runID
+	}
+	return closeFn, nil
+}
+
+// Fields are structured log fields, such as resource_type, region and identifier.
+type Fields map[string]any
+
+type fieldsKey struct{}
+
+// WithFields returns a copy of ctx carrying the given log fields in addition to those it already
+// carries. The *Context log functions add them to structured entries.
+func WithFields(ctx context.Context, fields Fields) context.Context {
+	merged := Fields{}
+	for k, v := range fieldsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+func fieldsFromContext(ctx context.Context) Fields {
+	fields, _ := ctx.Value(fieldsKey{}).(Fields)
+	return fields
+}
+
+// log writes msg at level, through Logger with the fields of ctx once Configure made logs
+// structured, or with the pterm printer otherwise.
+func log(ctx context.Context, level logrus.Level, printer *pterm.PrefixPrinter, msg string) {
+	if !structured {
+		printer.Println(msg)
+		return
+	}
+
+	entry := Logger.WithFields(baseFields)
+	if fields := fieldsFromContext(ctx); len(fields) > 0 {
+		entry = entry.WithFields(logrus.Fields(fields))
+	}
+	entry.Log(level, msg)
+}
+
 func Debug(msg string) {
-	pterm.Debug.Println(msg)
+	log(context.Background(), logrus.DebugLevel, &pterm.Debug, msg)
 }
 
 func Debugf(msg string, args ...interface{}) {
@@ -45,7 +138,7 @@ func Debugf(msg string, args ...interface{}) {
 }
 
 func Info(msg string) {
-	pterm.Info.Println(msg)
+	log(context.Background(), logrus.InfoLevel, &pterm.Info, msg)
 }
 
 func Infof(msg string, args ...interface{}) {
@@ -53,7 +146,7 @@ func Infof(msg string, args ...interface{}) {
 }
 
 func Error(msg string) {
-	pterm.Error.Println(msg)
+	log(context.Background(), logrus.ErrorLevel, &pterm.Error, msg)
 }
 
 func Errorf(msg string, args ...interface{}) {
@@ -61,9 +154,29 @@ func Errorf(msg string, args ...interface{}) {
 }
 
 func Warn(msg string) {
-	pterm.Warning.Println(msg)
+	log(context.Background(), logrus.WarnLevel, &pterm.Warning, msg)
 }
 
 func Warnf(msg string, args ...interface{}) {
 	Warn(fmt.Sprintf(msg, args...))
 }
+
+// DebugfContext is Debugf with the log fields of ctx.
+func DebugfContext(ctx context.Context, msg string, args ...interface{}) {
+	log(ctx, logrus.DebugLevel, &pterm.Debug, fmt.Sprintf(msg, args...))
+}
+
+// InfofContext is Infof with the log fields of ctx.
+func InfofContext(ctx context.Context, msg string, args ...interface{}) {
+	log(ctx, logrus.InfoLevel, &pterm.Info, fmt.Sprintf(msg, args...))
+}
+
+// WarnfContext is Warnf with the log fields of ctx.
+func WarnfContext(ctx context.Context, msg string, args ...interface{}) {
+	log(ctx, logrus.WarnLevel, &pterm.Warning, fmt.Sprintf(msg, args...))
+}
+
+// ErrorfContext is Errorf with the log fields of ctx.
+func ErrorfContext(ctx context.Context, msg string, args ...interface{}) {
+	log(ctx, logrus.ErrorLevel, &pterm.Error, fmt.Sprintf(msg, args...))
+}
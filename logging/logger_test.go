@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// resetLogger restores the default, unstructured logging after a test.
+func resetLogger(t *testing.T) {
+	t.Cleanup(func() {
+		structured = false
+		baseFields = nil
+		Logger = InitLogger()
+	})
+}
+
+func TestConfigureTextWithoutFileKeepsInteractiveLogs(t *testing.T) {
+	resetLogger(t)
+
+	closeFn, err := Configure(FormatText, "", "run-1")
+	require.NoError(t, err)
+	require.NoError(t, closeFn())
+	require.False(t, structured)
+}
+
+func TestConfigureInvalidFormat(t *testing.T) {
+	resetLogger(t)
+
+	_, err := Configure("yaml", "", "")
+	require.ErrorContains(t, err, "invalid log format")
+}
+
+func TestConfigureJSONWritesContextFields(t *testing.T) {
+	resetLogger(t)
+
+	file := filepath.Join(t.TempDir(), "cloud-nuke.log")
+	closeFn, err := Configure(FormatJSON, file, "run-1")
+	require.NoError(t, err)
+	Logger.SetLevel(logrus.DebugLevel)
+
+	ctx := WithFields(context.Background(), Fields{"resource_type": "ec2", "region": "us-east-1"})
+	ctx = WithFields(ctx, Fields{"identifier": "i-123"})
+	ErrorfContext(ctx, "[Failed] %s %s: %s", "ec2", "i-123", "boom")
+	Infof("Deleting %d %s", 2, "ec2")
+	require.NoError(t, closeFn())
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "error", entry["level"])
+	require.Equal(t, "[Failed] ec2 i-123: boom", entry["msg"])
+	require.Equal(t, "run-1", entry["run_id"])
+	require.Equal(t, "ec2", entry["resource_type"])
+	require.Equal(t, "us-east-1", entry["region"])
+	require.Equal(t, "i-123", entry["identifier"])
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	require.Equal(t, "info", entry["level"])
+	require.Equal(t, "run-1", entry["run_id"])
+}
+
+func TestWithFieldsDoesNotModifyParent(t *testing.T) {
+	parent := WithFields(context.Background(), Fields{"region": "us-east-1"})
+	child := WithFields(parent, Fields{"identifier": "i-123"})
+
+	require.Equal(t, Fields{"region": "us-east-1"}, fieldsFromContext(parent))
+	require.Equal(t, Fields{"region": "us-east-1", "identifier": "i-123"}, fieldsFromContext(child))
+}
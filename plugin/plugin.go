@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+)
+
+// Plugin is a discovered plugin executable together with the Info it reported.
+type Plugin struct {
+	Path string
+	Info Info
+}
+
+// Discover runs describe on every executable file in dir and returns the plugins sorted by path.
+// Hidden files and directories are skipped. Any plugin that fails to describe itself, or that
+// reports a name already used by another plugin, fails discovery as a whole so that a broken
+// plugin is noticed rather than silently ignored.
+func Discover(ctx context.Context, dir string) ([]*Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading plugin directory %s: %w", dir, err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var plugins []*Plugin
+	seen := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading plugin %s: %w", path, err)
+		}
+		if !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+			logging.Debugf("Skipping non-executable file %s in plugin directory", path)
+			continue
+		}
+
+		p, err := Load(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[p.Info.Name]; ok {
+			return nil, fmt.Errorf("plugins %s and %s both declare resource type %q", other, path, p.Info.Name)
+		}
+		seen[p.Info.Name] = path
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
+
+// Load runs describe on a single plugin executable and validates the result.
+func Load(ctx context.Context, path string) (*Plugin, error) {
+	p := &Plugin{Path: path}
+	resp, err := p.call(ctx, nil, Request{Method: MethodDescribe})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Info == nil {
+		return nil, fmt.Errorf("plugin %s: describe returned no info", path)
+	}
+	info := *resp.Info
+	if info.Name == "" {
+		return nil, fmt.Errorf("plugin %s: describe returned an empty name", path)
+	}
+	switch info.Scope {
+	case "":
+		info.Scope = ScopeRegional
+	case ScopeRegional, ScopeGlobal:
+	default:
+		return nil, fmt.Errorf("plugin %s: unknown scope %q (expected %q or %q)", path, info.Scope, ScopeRegional, ScopeGlobal)
+	}
+	if info.BatchSize < 0 {
+		return nil, fmt.Errorf("plugin %s: batch_size must not be negative", path)
+	}
+	p.Info = info
+	return p, nil
+}
+
+// IsGlobal reports whether the plugin's resources live in the "global" region.
+func (p *Plugin) IsGlobal() bool {
+	return p.Info.Scope == ScopeGlobal
+}
+
+// List asks the plugin for every resource in the region. env is appended to the current
+// process environment, which is how provider credentials are passed to the plugin.
+func (p *Plugin) List(ctx context.Context, env []string, region string, accountID string, cfg config.ResourceType) ([]Item, error) {
+	resp, err := p.call(ctx, env, Request{
+		Method:    MethodList,
+		Region:    region,
+		AccountID: accountID,
+		Config:    &cfg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Resources, nil
+}
+
+// Nuke asks the plugin to delete the given identifiers and returns its per-identifier results.
+func (p *Plugin) Nuke(ctx context.Context, env []string, region string, accountID string, identifiers []string) ([]Result, error) {
+	resp, err := p.call(ctx, env, Request{
+		Method:      MethodNuke,
+		Region:      region,
+		AccountID:   accountID,
+		Identifiers: identifiers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// call runs the plugin with a single request and decodes its response.
+func (p *Plugin) call(ctx context.Context, env []string, req Request) (*Response, error) {
+	req.ProtocolVersion = ProtocolVersion
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: encoding %s request: %w", p.Path, req.Method, err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), env...)
+
+	runErr := cmd.Run()
+	p.logStderr(&stderr)
+
+	var resp Response
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		if runErr != nil {
+			return nil, fmt.Errorf("plugin %s: %s failed: %w", p.Path, req.Method, runErr)
+		}
+		return nil, fmt.Errorf("plugin %s: decoding %s response: %w", p.Path, req.Method, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", p.Path, resp.Error)
+	}
+	if runErr != nil {
+		return nil, fmt.Errorf("plugin %s: %s failed: %w", p.Path, req.Method, runErr)
+	}
+	return &resp, nil
+}
+
+func (p *Plugin) logStderr(stderr *bytes.Buffer) {
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		logging.Debugf("[plugin %s] %s", filepath.Base(p.Path), scanner.Text())
+	}
+}
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/require"
+)
+
+// envTestPlugin makes the test binary act as a plugin. The handler is chosen by the name it is invoked as,
+// which lets a single binary be symlinked into a plugin directory under several names.
+const envTestPlugin = "CLOUD_NUKE_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(envTestPlugin) == "1" {
+		h, ok := testHandlers[filepath.Base(os.Args[0])]
+		if !ok {
+			fmt.Print("not json")
+			os.Exit(2)
+		}
+		Serve(h)
+	}
+	os.Exit(m.Run())
+}
+
+var created = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type testHandler struct {
+	info Info
+}
+
+func (h testHandler) Describe() Info {
+	return h.info
+}
+
+func (h testHandler) List(_ context.Context, req Request) ([]Item, error) {
+	if req.Region == "fail" {
+		return nil, fmt.Errorf("list failed")
+	}
+	fmt.Fprintln(os.Stderr, "listing in", req.Region)
+	return []Item{{
+		Identifier: req.Region + "/" + req.AccountID + "/" + os.Getenv("EXTRA"),
+		Name:       fmt.Sprintf("%d", len(req.Config.IncludeRule.NamesRegExp)),
+		CreatedAt:  &created,
+	}}, nil
+}
+
+func (h testHandler) Nuke(_ context.Context, req Request) []Result {
+	var results []Result
+	for _, id := range req.Identifiers {
+		r := Result{Identifier: id}
+		if strings.HasPrefix(id, "bad") {
+			r.Error = "cannot delete " + id
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+var testHandlers = map[string]Handler{
+	"widget":   testHandler{info: Info{Name: "test-widget", BatchSize: 5}},
+	"gadget":   testHandler{info: Info{Name: "test-gadget", Scope: ScopeGlobal, Before: "iam-role"}},
+	"nameless": testHandler{info: Info{}},
+	"badscope": testHandler{info: Info{Name: "test-bad", Scope: "planetary"}},
+	"dup":      testHandler{info: Info{Name: "test-widget"}},
+}
+
+// pluginDir links the test binary into a temporary directory under each of the given names.
+func pluginDir(t *testing.T, names ...string) string {
+	t.Helper()
+	t.Setenv(envTestPlugin, "1")
+	self, err := os.Executable()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	for _, name := range names {
+		require.NoError(t, os.Symlink(self, filepath.Join(dir, name)))
+	}
+	return dir
+}
+
+func TestDiscover(t *testing.T) {
+	dir := pluginDir(t, "widget", "gadget", ".hidden")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("docs"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0755))
+
+	plugins, err := Discover(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+
+	require.Equal(t, "test-gadget", plugins[0].Info.Name)
+	require.True(t, plugins[0].IsGlobal())
+	require.Equal(t, "iam-role", plugins[0].Info.Before)
+
+	require.Equal(t, "test-widget", plugins[1].Info.Name)
+	require.Equal(t, ScopeRegional, plugins[1].Info.Scope)
+	require.False(t, plugins[1].IsGlobal())
+	require.Equal(t, 5, plugins[1].Info.BatchSize)
+}
+
+func TestDiscoverErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins []string
+		err     string
+	}{
+		{name: "empty name", plugins: []string{"nameless"}, err: "empty name"},
+		{name: "unknown scope", plugins: []string{"badscope"}, err: "unknown scope"},
+		{name: "duplicate", plugins: []string{"dup", "widget"}, err: "both declare"},
+		{name: "invalid output", plugins: []string{"garbage"}, err: "describe failed"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Discover(context.Background(), pluginDir(t, tc.plugins...))
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	_, err := Discover(context.Background(), filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "reading plugin directory")
+}
+
+func TestListAndNuke(t *testing.T) {
+	dir := pluginDir(t, "widget")
+	p, err := Load(context.Background(), filepath.Join(dir, "widget"))
+	require.NoError(t, err)
+
+	cfg := config.ResourceType{}
+	require.NoError(t, json.Unmarshal([]byte(`{"include": {"names_regex": ["^a", "^b"]}}`), &cfg))
+
+	items, err := p.List(context.Background(), []string{"EXTRA=env"}, "us-east-1", "123456789012", cfg)
+	require.NoError(t, err)
+	require.Equal(t, []Item{{Identifier: "us-east-1/123456789012/env", Name: "2", CreatedAt: &created}}, items)
+
+	_, err = p.List(context.Background(), nil, "fail", "", cfg)
+	require.ErrorContains(t, err, "list failed")
+
+	results, err := p.Nuke(context.Background(), nil, "us-east-1", "", []string{"good-1", "bad-1"})
+	require.NoError(t, err)
+	require.Equal(t, []Result{
+		{Identifier: "good-1"},
+		{Identifier: "bad-1", Error: "cannot delete bad-1"},
+	}, results)
+}
+
+func TestServe(t *testing.T) {
+	h := testHandlers["widget"]
+	tests := []struct {
+		name     string
+		request  string
+		expected Response
+	}{
+		{
+			name:     "describe",
+			request:  `{"protocol_version": 1, "method": "describe"}`,
+			expected: Response{Info: &Info{Name: "test-widget", BatchSize: 5}},
+		},
+		{
+			name:     "nuke",
+			request:  `{"protocol_version": 1, "method": "nuke", "identifiers": ["bad-2"]}`,
+			expected: Response{Results: []Result{{Identifier: "bad-2", Error: "cannot delete bad-2"}}},
+		},
+		{
+			name:     "wrong version",
+			request:  `{"protocol_version": 99, "method": "describe"}`,
+			expected: Response{Error: "unsupported protocol version 99 (expected 1)"},
+		},
+		{
+			name:     "unknown method",
+			request:  `{"protocol_version": 1, "method": "explode"}`,
+			expected: Response{Error: `unknown method "explode"`},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, serve(context.Background(), h, strings.NewReader(tc.request), &out))
+			var resp Response
+			require.NoError(t, json.Unmarshal(out.Bytes(), &resp))
+			require.Equal(t, tc.expected, resp)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Package plugin implements the out-of-process protocol used to add custom resource types to
+// cloud-nuke without compiling them into the binary.
+//
+// A plugin is any executable file in the plugin directory. cloud-nuke runs it once per call,
+// writes a single JSON Request to its stdin and reads a single JSON Response from its stdout.
+// Anything the plugin writes to stderr is logged at debug level. The three methods mirror
+// resource.NukeableResource:
+//
+//   - describe: returns the plugin's Info (resource name, scope, ordering and batch size)
+//   - list: receives the region and the plugin's config.ResourceType, returns the Items found
+//   - nuke: receives the region and a batch of identifiers, returns one Result per identifier
+//
+// cloud-nuke applies the usual name, time and tag filtering to the listed Items, so plugins
+// only need to return everything they find. Plugins written in Go can use Serve.
+package plugin
+
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+// ProtocolVersion is sent with every request. Plugins should reject versions they do not understand.
+const ProtocolVersion = 1
+
+// Methods supported by the protocol.
+const (
+	MethodDescribe = "describe"
+	MethodList     = "list"
+	MethodNuke     = "nuke"
+)
+
+// Scopes a plugin can declare. Global plugins run once, in the "global" region, after regional resources.
+const (
+	ScopeRegional = "regional"
+	ScopeGlobal   = "global"
+)
+
+// Request is written to the plugin's stdin.
+type Request struct {
+	ProtocolVersion int    `json:"protocol_version"`
+	Method          string `json:"method"`
+	// Region is the region being processed, or "global" for global plugins.
+	Region    string `json:"region,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+	// Config is the plugin's section of the cloud-nuke config file, with CLI filters applied (list only).
+	Config *config.ResourceType `json:"config,omitempty"`
+	// Identifiers is the batch to delete (nuke only).
+	Identifiers []string `json:"identifiers,omitempty"`
+}
+
+// Response is read from the plugin's stdout. Error is set when the whole call failed.
+type Response struct {
+	Info      *Info    `json:"info,omitempty"`
+	Resources []Item   `json:"resources,omitempty"`
+	Results   []Result `json:"results,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// Info describes a plugin's resource type.
+type Info struct {
+	// Name is the resource type name used with --resource-type and in reports.
+	Name string `json:"name"`
+	// Scope is ScopeRegional (the default) or ScopeGlobal.
+	Scope string `json:"scope,omitempty"`
+	// Before is an optional built-in resource type this plugin must be nuked before, e.g. "vpc".
+	// Without it, the plugin runs after all built-in resource types of the same scope.
+	Before string `json:"before,omitempty"`
+	// BatchSize is the maximum number of identifiers per nuke call. Zero uses the default.
+	BatchSize int `json:"batch_size,omitempty"`
+	// ListActions and NukeActions are the IAM actions used by list and nuke, e.g. "ec2:DescribeVpcs".
+	// They are included in the policies generated by `cloud-nuke iam-policy`.
+	ListActions []string `json:"list_actions,omitempty"`
+	NukeActions []string `json:"nuke_actions,omitempty"`
+}
+
+// Item is a single resource returned by list.
+type Item struct {
+	Identifier string            `json:"identifier"`
+	Name       string            `json:"name,omitempty"`
+	CreatedAt  *time.Time        `json:"created_at,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+}
+
+// Result is the outcome of deleting a single identifier. An empty Error means success.
+type Result struct {
+	Identifier string `json:"identifier"`
+	Error      string `json:"error,omitempty"`
+}
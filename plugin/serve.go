@@ -0,0 +1,62 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Handler is implemented by plugins written in Go. It mirrors resource.NukeableResource.
+type Handler interface {
+	// Describe returns the plugin's resource type information.
+	Describe() Info
+	// List returns every resource in req.Region. Filtering is done by cloud-nuke.
+	List(ctx context.Context, req Request) ([]Item, error)
+	// Nuke deletes req.Identifiers and returns one Result per identifier.
+	Nuke(ctx context.Context, req Request) []Result
+}
+
+// Serve handles a single request from cloud-nuke on stdin/stdout and exits.
+// A plugin's main function typically consists of nothing but a call to Serve.
+func Serve(h Handler) {
+	if err := serve(context.Background(), h, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// serve decodes one Request from r, dispatches it to h and encodes the Response to w.
+// Handler errors are reported in Response.Error; only I/O and protocol errors are returned.
+func serve(ctx context.Context, h Handler, r io.Reader, w io.Writer) error {
+	var req Request
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return writeResponse(w, Response{Error: fmt.Sprintf("decoding request: %v", err)})
+	}
+
+	var resp Response
+	switch {
+	case req.ProtocolVersion != ProtocolVersion:
+		resp.Error = fmt.Sprintf("unsupported protocol version %d (expected %d)", req.ProtocolVersion, ProtocolVersion)
+	case req.Method == MethodDescribe:
+		info := h.Describe()
+		resp.Info = &info
+	case req.Method == MethodList:
+		items, err := h.List(ctx, req)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		resp.Resources = items
+	case req.Method == MethodNuke:
+		resp.Results = h.Nuke(ctx, req)
+	default:
+		resp.Error = fmt.Sprintf("unknown method %q", req.Method)
+	}
+	return writeResponse(w, resp)
+}
+
+func writeResponse(w io.Writer, resp Response) error {
+	return json.NewEncoder(w).Encode(resp)
+}
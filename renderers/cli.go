@@ -13,9 +13,11 @@ import (
 
 // Emoji constants for CLI output
 const (
-	SuccessEmoji = "✅"
-	FailureEmoji = "❌"
-	WarningEmoji = "⚠️"
+	SuccessEmoji  = "✅"
+	FailureEmoji  = "❌"
+	WarningEmoji  = "⚠️"
+	ModifiedEmoji = "✏️"
+	SkippedEmoji  = "⏭️"
 )
 
 // MaxResourcesForDetailedTable is the threshold above which the CLI renders
@@ -32,7 +34,31 @@ type CLIRenderer struct {
 	found       []reporting.ResourceFound
 	deleted     []reporting.ResourceDeleted
 	errors      []reporting.GeneralError
-	nukeMode    bool // true if NukeStarted was received, determines if ScanComplete is terminal
+	interrupted *reporting.NukeInterrupted // nil unless the nuke operation was interrupted
+	nukeMode    bool                       // true if NukeStarted was received, determines if ScanComplete is terminal
+	action      string                     // action of the nuke operation, from NukeStarted
+}
+
+// actionProgressive returns the progress verb of a nuke operation's action, e.g. "Suspending".
+func actionProgressive(action string) string {
+	switch action {
+	case "suspend":
+		return "Suspending"
+	case "resume":
+		return "Resuming"
+	}
+	return "Nuking"
+}
+
+// actionPast returns the past tense of a nuke operation's action, e.g. "Suspended".
+func actionPast(action string) string {
+	switch action {
+	case "suspend":
+		return "Suspended"
+	case "resume":
+		return "Resumed"
+	}
+	return "Deleted"
 }
 
 // NewCLIRenderer creates a CLI renderer with an active spinner.
@@ -73,7 +99,9 @@ func (r *CLIRenderer) OnEvent(event reporting.Event) {
 	case reporting.NukeStarted:
 		r.handleNukeStarted(e)
 	case reporting.NukeProgress:
-		r.updateProgressBar(fmt.Sprintf("Nuking batch of %d %s in %s", e.BatchSize, e.ResourceType, e.Region))
+		r.updateProgressBar(fmt.Sprintf("%s batch of %d %s in %s", actionProgressive(r.action), e.BatchSize, e.ResourceType, e.Region))
+	case reporting.NukeInterrupted:
+		r.interrupted = &e
 	case reporting.NukeComplete:
 		r.handleNukeComplete()
 	}
@@ -121,6 +149,7 @@ func (r *CLIRenderer) handleResourceDeleted(e reporting.ResourceDeleted) {
 // handleNukeStarted initializes nuke mode and starts the progress bar.
 func (r *CLIRenderer) handleNukeStarted(e reporting.NukeStarted) {
 	r.nukeMode = true
+	r.action = e.Action
 	progressBar, err := pterm.DefaultProgressbar.WithTotal(e.Total).Start()
 	if err != nil {
 		_, _ = fmt.Fprintf(r.writer, "Warning: failed to start progress bar: %v\n", err)
@@ -136,6 +165,35 @@ func (r *CLIRenderer) handleNukeComplete() {
 	}
 	r.printErrorsTable()
 	r.printDeletedTable()
+	r.printNotAttemptedTable()
+}
+
+// printNotAttemptedTable lists the resources an interrupted nuke operation did not attempt.
+func (r *CLIRenderer) printNotAttemptedTable() {
+	if r.interrupted == nil {
+		return
+	}
+
+	pterm.Warning.WithWriter(r.writer).Printfln("Interrupted (%s): %d resources were not attempted.",
+		r.interrupted.Reason, len(r.interrupted.NotAttempted))
+	if len(r.interrupted.NotAttempted) == 0 || len(r.interrupted.NotAttempted) > MaxResourcesForDetailedTable {
+		return
+	}
+
+	tableData := pterm.TableData{
+		{"Identifier", "Resource Type", "Region"},
+	}
+	for _, e := range r.interrupted.NotAttempted {
+		tableData = append(tableData, []string{e.Identifier, e.ResourceType, e.Region})
+	}
+
+	_ = pterm.DefaultTable.
+		WithHasHeader().
+		WithBoxed(true).
+		WithLeftAlignment().
+		WithData(tableData).
+		WithWriter(r.writer).
+		Render()
 }
 
 func (r *CLIRenderer) printErrorsTable() {
@@ -183,6 +241,8 @@ func (r *CLIRenderer) printFoundTable() {
 		nukable := SuccessEmoji
 		if !e.Nukable {
 			nukable = e.Reason
+		} else if e.Detail != "" {
+			nukable = fmt.Sprintf("%s %s", SuccessEmoji, util.Truncate(util.RemoveNewlines(e.Detail), 40))
 		}
 		tableData = append(tableData, []string{e.ResourceType, e.Region, e.Identifier, nukable})
 	}
@@ -320,13 +380,20 @@ func (r *CLIRenderer) printDeletedTable() {
 	}
 
 	tableData := pterm.TableData{
-		{"Identifier", "Resource Type", "Deleted Successfully"},
+		{"Identifier", "Resource Type", actionPast(r.action) + " Successfully"},
 	}
 
 	for _, e := range r.deleted {
 		var status string
-		if e.Success {
+		if e.Success && e.Modified {
+			status = fmt.Sprintf("%s %s", ModifiedEmoji, util.Truncate(util.RemoveNewlines(e.Detail), 40))
+		} else if e.Success && e.Skipped {
+			status = fmt.Sprintf("%s %s", SkippedEmoji, util.Truncate(util.RemoveNewlines(e.Detail), 40))
+		} else if e.Success {
 			status = SuccessEmoji
+			if e.Detail != "" {
+				status = fmt.Sprintf("%s %s", SuccessEmoji, util.Truncate(util.RemoveNewlines(e.Detail), 40))
+			}
 		} else if e.Warning {
 			status = fmt.Sprintf("%s %s", WarningEmoji, util.Truncate(util.RemoveNewlines(e.Error), 40))
 		} else {
@@ -355,9 +422,11 @@ func (r *CLIRenderer) printDeletedSummaryTable() {
 		Region       string
 	}
 	type counts struct {
-		success int
-		failure int
-		warned  int
+		success  int
+		modified int
+		skipped  int
+		failure  int
+		warned   int
 	}
 
 	summary := make(map[key]*counts)
@@ -371,7 +440,11 @@ func (r *CLIRenderer) printDeletedSummaryTable() {
 			summary[k] = c
 			order = append(order, k)
 		}
-		if e.Success {
+		if e.Success && e.Modified {
+			c.modified++
+		} else if e.Success && e.Skipped {
+			c.skipped++
+		} else if e.Success {
 			c.success++
 		} else if e.Warning {
 			c.warned++
@@ -381,7 +454,7 @@ func (r *CLIRenderer) printDeletedSummaryTable() {
 	}
 
 	tableData := pterm.TableData{
-		{"Resource Type", "Region", "Successful", "Failed", "Warned"},
+		{"Resource Type", "Region", "Successful", "Modified", "Skipped", "Failed", "Warned"},
 	}
 	for _, k := range order {
 		c := summary[k]
@@ -389,14 +462,16 @@ func (r *CLIRenderer) printDeletedSummaryTable() {
 			k.ResourceType,
 			k.Region,
 			fmt.Sprintf("%d", c.success),
+			fmt.Sprintf("%d", c.modified),
+			fmt.Sprintf("%d", c.skipped),
 			fmt.Sprintf("%d", c.failure),
 			fmt.Sprintf("%d", c.warned),
 		})
 	}
 
 	pterm.Info.WithWriter(r.writer).Printfln(
-		"Showing summary (%d resources deleted). Use --output json for full details.",
-		len(r.deleted),
+		"Showing summary (%d resources %s). Use --output json for full details.",
+		len(r.deleted), strings.ToLower(actionPast(r.action)),
 	)
 
 	_ = pterm.DefaultTable.
@@ -0,0 +1,56 @@
+package renderers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+)
+
+// CSVRenderer outputs results as CSV, one row per resource with the configured columns.
+// Like the JSON renderer, output is triggered by the Complete event and built from the same events.
+// General errors are not included; use the JSON output for those.
+type CSVRenderer struct {
+	results
+	writer  io.Writer
+	command string
+	query   *QueryParams
+	regions []string
+	columns []string
+}
+
+// NewCSVRenderer creates a CSV renderer.
+func NewCSVRenderer(writer io.Writer, cfg JSONRendererConfig) *CSVRenderer {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &CSVRenderer{
+		results: newResults(),
+		writer:  writer,
+		command: cfg.Command,
+		query:   cfg.Query,
+		regions: cfg.Regions,
+		columns: cfg.Columns,
+	}
+}
+
+// OnEvent collects events and outputs CSV on Complete.
+func (r *CSVRenderer) OnEvent(event reporting.Event) {
+	if !r.record(event) {
+		return
+	}
+
+	t := inspectTable(r.inspectOutput(r.command, r.query), r.columns)
+	if r.nukeMode {
+		t = nukeTable(r.nukeOutput(r.command, r.regions), r.columns)
+	}
+
+	writer := csv.NewWriter(r.writer)
+	_ = writer.Write(t.header)
+	_ = writer.WriteAll(t.rows)
+	if err := writer.Error(); err != nil {
+		_, _ = fmt.Fprintf(r.writer, "Error rendering CSV output: %v\n", err)
+	}
+}
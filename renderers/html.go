@@ -0,0 +1,362 @@
+package renderers
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+)
+
+// HTMLRenderer outputs results as a single self-contained HTML file, with styles and scripts inlined
+// so it can be attached to a ticket and opened anywhere.
+// Like the JSON renderer, output is triggered by the Complete event and built from the same events.
+type HTMLRenderer struct {
+	results
+	writer  io.Writer
+	command string
+	query   *QueryParams
+	regions []string
+}
+
+// NewHTMLRenderer creates an HTML renderer.
+func NewHTMLRenderer(writer io.Writer, cfg JSONRendererConfig) *HTMLRenderer {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &HTMLRenderer{
+		results: newResults(),
+		writer:  writer,
+		command: cfg.Command,
+		query:   cfg.Query,
+		regions: cfg.Regions,
+	}
+}
+
+// OnEvent collects events and outputs HTML on Complete.
+func (r *HTMLRenderer) OnEvent(event reporting.Event) {
+	if !r.record(event) {
+		return
+	}
+	if err := htmlReportTemplate.Execute(r.writer, r.report()); err != nil {
+		_, _ = fmt.Fprintf(r.writer, "Error rendering HTML output: %v\n", err)
+	}
+}
+
+// htmlReport is the data of the HTML report template.
+type htmlReport struct {
+	Command   string
+	Action    string
+	Timestamp string
+	NukeMode  bool
+	Query     [][2]string
+	Summary   [][2]string
+	Charts    []htmlChart
+	Found     []ResourceInfo
+	Deleted   []NukeResourceInfo
+	Failed    []NukeResourceInfo
+	Errors    []GeneralError
+
+	Interrupted  string
+	NotAttempted []UnattemptedResource
+}
+
+// htmlChart is a horizontal bar chart with a bar per resource type or region.
+type htmlChart struct {
+	Title  string
+	Legend []htmlSegment
+	Bars   []htmlBar
+}
+
+// htmlBar is one bar of an htmlChart, made up of a segment per outcome.
+type htmlBar struct {
+	Label    string
+	Total    int
+	Segments []htmlSegment
+}
+
+// htmlSegment is the part of a bar counting one outcome. Percent is relative to the longest bar.
+type htmlSegment struct {
+	Class   string
+	Label   string
+	Count   int
+	Percent float64
+}
+
+// report builds the template data from the collected events.
+func (r *HTMLRenderer) report() htmlReport {
+	report := htmlReport{
+		Command:   r.command,
+		Action:    actionPast(r.action),
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		NukeMode:  r.nukeMode,
+		Query:     r.queryRows(),
+	}
+
+	if !r.nukeMode {
+		output := r.inspectOutput(r.command, r.query)
+		report.Found = output.Resources
+		report.Errors = output.Errors
+		report.Summary = [][2]string{
+			{"Resources found", fmt.Sprint(output.Summary.TotalResources)},
+			{"Nukable", fmt.Sprint(output.Summary.Nukable)},
+			{"Not nukable", fmt.Sprint(output.Summary.NonNukable)},
+			{"General errors", fmt.Sprint(output.Summary.GeneralErrors)},
+		}
+		legend := []htmlSegment{{Class: "ok", Label: "Nukable"}, {Class: "skip", Label: "Not nukable"}}
+		outcome := func(i int) int {
+			if output.Resources[i].Nukable {
+				return 0
+			}
+			return 1
+		}
+		report.Charts = []htmlChart{
+			buildHTMLChart("Found by resource type", legend, len(output.Resources), func(i int) string { return output.Resources[i].ResourceType }, outcome),
+			buildHTMLChart("Found by region", legend, len(output.Resources), func(i int) string { return output.Resources[i].Region }, outcome),
+		}
+		return report
+	}
+
+	output := r.nukeOutput(r.command, r.regions)
+	report.Found = output.Found
+	report.Errors = output.Errors
+	report.Interrupted = output.Interrupted
+	report.NotAttempted = output.NotAttempted
+	for _, resource := range output.Resources {
+		if resource.Status == "failed" || resource.Status == "warned" {
+			report.Failed = append(report.Failed, resource)
+		} else {
+			report.Deleted = append(report.Deleted, resource)
+		}
+	}
+	report.Summary = [][2]string{
+		{"Resources found", fmt.Sprint(output.Summary.Found)},
+		{"Attempted", fmt.Sprint(output.Summary.Total)},
+		{report.Action, fmt.Sprint(output.Summary.Succeeded)},
+		{"Modified", fmt.Sprint(output.Summary.Modified)},
+		{"Failed", fmt.Sprint(output.Summary.Failed)},
+		{"Warned", fmt.Sprint(output.Summary.Warned)},
+		{"General errors", fmt.Sprint(output.Summary.GeneralErrors)},
+	}
+	if output.Summary.Skipped > 0 {
+		report.Summary = append(report.Summary, [2]string{"Skipped", fmt.Sprint(output.Summary.Skipped)})
+	}
+	if output.Interrupted != "" {
+		report.Summary = append(report.Summary, [2]string{"Not attempted", fmt.Sprint(output.Summary.NotAttempted)})
+	}
+	legend := []htmlSegment{
+		{Class: "ok", Label: report.Action},
+		{Class: "modified", Label: "Modified"},
+		{Class: "failed", Label: "Failed"},
+		{Class: "warned", Label: "Warned"},
+	}
+	if output.Summary.Skipped > 0 {
+		legend = append(legend, htmlSegment{Class: "skip", Label: "Skipped"})
+	}
+	outcome := func(i int) int {
+		switch output.Resources[i].Status {
+		case "modified":
+			return 1
+		case "failed":
+			return 2
+		case "warned":
+			return 3
+		case "skipped":
+			return 4
+		}
+		return 0
+	}
+	report.Charts = []htmlChart{
+		buildHTMLChart("Results by resource type", legend, len(output.Resources), func(i int) string { return output.Resources[i].ResourceType }, outcome),
+		buildHTMLChart("Results by region", legend, len(output.Resources), func(i int) string { return output.Resources[i].Region }, outcome),
+	}
+	return report
+}
+
+// queryRows returns the query parameters to display: those of ScanStarted, or the configured query
+// for commands that do not emit it, such as gcp.
+func (r *HTMLRenderer) queryRows() [][2]string {
+	if r.scan != nil {
+		rows := [][2]string{
+			{"Target Regions", strings.Join(r.scan.Regions, ", ")},
+			{"Target Resource Types", strings.Join(r.scan.ResourceTypes, ", ")},
+		}
+		if r.scan.ExcludeAfter != "" {
+			rows = append(rows, [2]string{"Exclude After Filter", r.scan.ExcludeAfter})
+		}
+		if r.scan.IncludeAfter != "" {
+			rows = append(rows, [2]string{"Include After Filter", r.scan.IncludeAfter})
+		}
+		return append(rows, [2]string{"List Unaliased KMS Keys", fmt.Sprintf("%t", r.scan.ListUnaliasedKMSKeys)})
+	}
+
+	if r.query != nil {
+		rows := [][2]string{
+			{"Target Regions", strings.Join(r.query.Regions, ", ")},
+			{"Target Resource Types", strings.Join(r.query.ResourceTypes, ", ")},
+		}
+		if r.query.ExcludeAfter != nil {
+			rows = append(rows, [2]string{"Exclude After Filter", r.query.ExcludeAfter.Format(time.RFC3339)})
+		}
+		if r.query.IncludeAfter != nil {
+			rows = append(rows, [2]string{"Include After Filter", r.query.IncludeAfter.Format(time.RFC3339)})
+		}
+		return append(rows, [2]string{"List Unaliased KMS Keys", fmt.Sprintf("%t", r.query.ListUnaliasedKMSKeys)})
+	}
+
+	if len(r.regions) > 0 {
+		return [][2]string{{"Target Regions", strings.Join(r.regions, ", ")}}
+	}
+	return nil
+}
+
+// buildHTMLChart counts n items by the key and legend outcome index of each, and scales the bars to
+// the largest total. Bars are ordered by total, largest first.
+func buildHTMLChart(title string, legend []htmlSegment, n int, key func(int) string, outcome func(int) int) htmlChart {
+	counts := map[string][]int{}
+	for i := 0; i < n; i++ {
+		k := key(i)
+		if counts[k] == nil {
+			counts[k] = make([]int, len(legend))
+		}
+		counts[k][outcome(i)]++
+	}
+
+	chart := htmlChart{Title: title, Legend: legend}
+	largest := 0
+	for label, outcomes := range counts {
+		bar := htmlBar{Label: label}
+		for _, count := range outcomes {
+			bar.Total += count
+		}
+		largest = max(largest, bar.Total)
+		chart.Bars = append(chart.Bars, bar)
+	}
+	sort.Slice(chart.Bars, func(i, j int) bool {
+		if chart.Bars[i].Total != chart.Bars[j].Total {
+			return chart.Bars[i].Total > chart.Bars[j].Total
+		}
+		return chart.Bars[i].Label < chart.Bars[j].Label
+	})
+
+	for i := range chart.Bars {
+		bar := &chart.Bars[i]
+		for j, count := range counts[bar.Label] {
+			if count == 0 {
+				continue
+			}
+			bar.Segments = append(bar.Segments, htmlSegment{
+				Class:   legend[j].Class,
+				Label:   legend[j].Label,
+				Count:   count,
+				Percent: float64(count) * 100 / float64(largest),
+			})
+		}
+	}
+	return chart
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"percent": func(p float64) template.CSS { return template.CSS(fmt.Sprintf("width:%.2f%%", p)) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>cloud-nuke {{.Command}} report</title>
+<style>
+body{font-family:-apple-system,"Segoe UI",Helvetica,Arial,sans-serif;margin:2em;color:#222}
+h1{margin-bottom:0}
+.meta{color:#666;margin-top:.25em}
+table{border-collapse:collapse;margin:.5em 0 1.5em;font-size:14px}
+th,td{border:1px solid #ddd;padding:4px 8px;text-align:left;vertical-align:top}
+table.data th{background:#f4f4f4;cursor:pointer;user-select:none}
+table.data th:after{content:" \2195";color:#aaa}
+.cards{display:flex;flex-wrap:wrap;gap:1em;margin:1em 0}
+.card{border:1px solid #ddd;border-radius:4px;padding:.5em 1em;min-width:8em}
+.card b{display:block;font-size:1.6em}
+.charts{display:flex;flex-wrap:wrap;gap:2em}
+.chart{flex:1;min-width:24em}
+.row{display:flex;align-items:center;margin:2px 0;font-size:13px}
+.row .label{width:14em;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}
+.row .bar{flex:1;display:flex;height:14px}
+.row .total{width:4em;text-align:right}
+.legend span{display:inline-block;margin-right:1em;font-size:13px}
+.legend i{display:inline-block;width:10px;height:10px;margin-right:4px}
+.ok{background:#3c9a4f}.skip{background:#999}.modified{background:#3b7dd8}.failed{background:#d64541}.warned{background:#e6a23c}
+input.filter{padding:4px;width:24em}
+.interrupted{padding:8px;border-left:4px solid #e6a23c;background:#fdf3e3}
+</style>
+</head>
+<body>
+<h1>cloud-nuke {{.Command}} report</h1>
+<p class="meta">Generated {{.Timestamp}}</p>
+{{if .Interrupted}}<p class="interrupted">Interrupted ({{.Interrupted}}): the results are partial and {{len .NotAttempted}} resources were not attempted.</p>
+{{end}}{{if .Query}}<h2>Query Parameters</h2>
+<table>{{range .Query}}<tr><th>{{index . 0}}</th><td>{{index . 1}}</td></tr>{{end}}</table>
+{{end}}<h2>Summary</h2>
+<div class="cards">{{range .Summary}}<div class="card"><b>{{index . 1}}</b>{{index . 0}}</div>{{end}}</div>
+<div class="charts">{{range .Charts}}<div class="chart"><h3>{{.Title}}</h3>
+<div class="legend">{{range .Legend}}<span><i class="{{.Class}}"></i>{{.Label}}</span>{{end}}</div>
+{{range .Bars}}<div class="row"><span class="label" title="{{.Label}}">{{.Label}}</span><span class="bar">{{range .Segments}}<span class="{{.Class}}" style="{{percent .Percent}}" title="{{.Label}}: {{.Count}}"></span>{{end}}</span><span class="total">{{.Total}}</span></div>
+{{else}}<p>None</p>
+{{end}}</div>
+{{end}}</div>
+<h2>Found Resources ({{len .Found}})</h2>
+{{if .Found}}<input class="filter" type="search" placeholder="Filter" data-table="found">
+<table class="data" id="found"><thead><tr><th>Resource Type</th><th>Region</th><th>Identifier</th><th>Nukable</th><th>Reason</th><th>Detail</th></tr></thead><tbody>
+{{range .Found}}<tr><td>{{.ResourceType}}</td><td>{{.Region}}</td><td>{{.Identifier}}</td><td>{{if .Nukable}}yes{{else}}no{{end}}</td><td>{{.Reason}}</td><td>{{.Detail}}</td></tr>
+{{end}}</tbody></table>
+{{else}}<p>No resources found.</p>
+{{end}}{{if .NukeMode}}<h2>{{.Action}} Resources ({{len .Deleted}})</h2>
+{{if .Deleted}}<input class="filter" type="search" placeholder="Filter" data-table="deleted">
+<table class="data" id="deleted"><thead><tr><th>Resource Type</th><th>Region</th><th>Identifier</th><th>Status</th><th>Detail</th></tr></thead><tbody>
+{{range .Deleted}}<tr><td>{{.ResourceType}}</td><td>{{.Region}}</td><td>{{.Identifier}}</td><td>{{.Status}}</td><td>{{.Detail}}</td></tr>
+{{end}}</tbody></table>
+{{else}}<p>None.</p>
+{{end}}<h2>Failed Resources ({{len .Failed}})</h2>
+{{if .Failed}}<input class="filter" type="search" placeholder="Filter" data-table="failed">
+<table class="data" id="failed"><thead><tr><th>Resource Type</th><th>Region</th><th>Identifier</th><th>Status</th><th>Error</th></tr></thead><tbody>
+{{range .Failed}}<tr><td>{{.ResourceType}}</td><td>{{.Region}}</td><td>{{.Identifier}}</td><td>{{.Status}}</td><td>{{.Error}}</td></tr>
+{{end}}</tbody></table>
+{{else}}<p>None.</p>
+{{end}}{{if .Interrupted}}<h2>Not Attempted Resources ({{len .NotAttempted}})</h2>
+{{if .NotAttempted}}<input class="filter" type="search" placeholder="Filter" data-table="not-attempted">
+<table class="data" id="not-attempted"><thead><tr><th>Resource Type</th><th>Region</th><th>Identifier</th></tr></thead><tbody>
+{{range .NotAttempted}}<tr><td>{{.ResourceType}}</td><td>{{.Region}}</td><td>{{.Identifier}}</td></tr>
+{{end}}</tbody></table>
+{{else}}<p>None.</p>
+{{end}}{{end}}{{end}}{{if .Errors}}<h2>General Errors ({{len .Errors}})</h2>
+<table class="data" id="errors"><thead><tr><th>Resource Type</th><th>Description</th><th>Error</th></tr></thead><tbody>
+{{range .Errors}}<tr><td>{{.ResourceType}}</td><td>{{.Description}}</td><td>{{.Error}}</td></tr>
+{{end}}</tbody></table>
+{{end}}<script>
+document.querySelectorAll("input.filter").forEach(function (input) {
+  input.addEventListener("input", function () {
+    var needle = input.value.toLowerCase();
+    document.getElementById(input.dataset.table).querySelectorAll("tbody tr").forEach(function (row) {
+      row.style.display = row.textContent.toLowerCase().indexOf(needle) === -1 ? "none" : "";
+    });
+  });
+});
+document.querySelectorAll("table.data th").forEach(function (th) {
+  th.addEventListener("click", function () {
+    var table = th.closest("table"), body = table.tBodies[0];
+    var column = Array.prototype.indexOf.call(th.parentNode.children, th);
+    var ascending = th.dataset.order !== "asc";
+    th.parentNode.querySelectorAll("th").forEach(function (other) { delete other.dataset.order; });
+    th.dataset.order = ascending ? "asc" : "desc";
+    Array.from(body.rows).sort(function (a, b) {
+      var x = a.cells[column].textContent, y = b.cells[column].textContent;
+      var order = x.localeCompare(y, undefined, {numeric: true});
+      return ascending ? order : -order;
+    }).forEach(function (row) { body.appendChild(row); });
+  });
+});
+</script>
+</body>
+</html>
+`))
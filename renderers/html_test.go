@@ -0,0 +1,77 @@
+package renderers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLRenderer_InspectOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewHTMLRenderer(&buf, JSONRendererConfig{Command: "inspect-aws"})
+
+	r.OnEvent(reporting.ScanStarted{
+		Regions:       []string{"us-east-1"},
+		ResourceTypes: []string{"ec2"},
+		ExcludeAfter:  "2024-01-01 00:00:00",
+	})
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Nukable: true})
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "<i-456>", Reason: "protected"})
+	r.OnEvent(reporting.GeneralError{ResourceType: "s3", Description: "Failed to list", Error: "timeout"})
+	r.OnEvent(reporting.ScanComplete{})
+	assert.Empty(t, buf.String(), "output should wait for Complete")
+
+	r.OnEvent(reporting.Complete{})
+	output := buf.String()
+
+	assert.Contains(t, output, "<!DOCTYPE html>")
+	assert.Contains(t, output, "Exclude After Filter")
+	assert.Contains(t, output, "2024-01-01 00:00:00")
+	assert.Contains(t, output, "i-123")
+	assert.Contains(t, output, "&lt;i-456&gt;", "identifiers should be escaped")
+	assert.Contains(t, output, "Found by resource type")
+	assert.Contains(t, output, "Failed to list")
+	assert.NotContains(t, output, "Failed Resources")
+	// Self-contained: no external stylesheets, scripts or images
+	assert.NotContains(t, output, "src=")
+	assert.NotContains(t, output, "href=")
+}
+
+func TestHTMLRenderer_NukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewHTMLRenderer(&buf, JSONRendererConfig{Command: "aws", Regions: []string{"us-east-1"}})
+
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Nukable: true})
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-456", Nukable: true})
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-456", Error: "access denied"})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+	output := buf.String()
+
+	assert.Contains(t, output, "Deleted Resources (1)")
+	assert.Contains(t, output, "Failed Resources (1)")
+	assert.Contains(t, output, "access denied")
+	assert.Contains(t, output, "Results by region")
+	// Without ScanStarted, the configured regions are shown
+	assert.Contains(t, output, "Target Regions")
+}
+
+func TestBuildHTMLChart(t *testing.T) {
+	legend := []htmlSegment{{Class: "ok"}, {Class: "failed"}}
+	keys := []string{"b", "a", "a", "a"}
+	outcomes := []int{0, 0, 1, 0}
+
+	chart := buildHTMLChart("chart", legend, len(keys), func(i int) string { return keys[i] }, func(i int) int { return outcomes[i] })
+
+	assert.Len(t, chart.Bars, 2)
+	assert.Equal(t, "a", chart.Bars[0].Label, "bars should be ordered by total")
+	assert.Equal(t, 3, chart.Bars[0].Total)
+	assert.Len(t, chart.Bars[0].Segments, 2)
+	assert.InDelta(t, 100*2.0/3, chart.Bars[0].Segments[0].Percent, 0.01)
+	assert.Equal(t, "b", chart.Bars[1].Label)
+	assert.Len(t, chart.Bars[1].Segments, 1)
+}
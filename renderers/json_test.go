@@ -86,7 +86,7 @@ func TestJSONRenderer_NukeOutput(t *testing.T) {
 
 	// Simulate nuke flow: ScanComplete, then NukeStarted, deletions, NukeComplete
 	r.OnEvent(reporting.ScanComplete{})
-	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.NukeStarted{Total: 3})
 
 	r.OnEvent(reporting.ResourceDeleted{
 		ResourceType: "ec2",
@@ -101,6 +101,14 @@ func TestJSONRenderer_NukeOutput(t *testing.T) {
 		Success:      false,
 		Error:        "access denied",
 	})
+	r.OnEvent(reporting.ResourceDeleted{
+		ResourceType: "cloudwatch-loggroup",
+		Region:       "us-east-1",
+		Identifier:   "/app/logs",
+		Success:      true,
+		Modified:     true,
+		Detail:       "retention set to 30 days",
+	})
 	r.OnEvent(reporting.GeneralError{
 		ResourceType: "s3",
 		Description:  "Failed to list",
@@ -117,11 +125,59 @@ func TestJSONRenderer_NukeOutput(t *testing.T) {
 
 	assert.Equal(t, "aws", output.Command)
 	assert.Len(t, output.Found, 3)
-	assert.Len(t, output.Resources, 2)
+	assert.Len(t, output.Resources, 3)
+	assert.Equal(t, "modified", output.Resources[2].Status)
 	assert.Equal(t, 1, output.Summary.Deleted)
+	assert.Equal(t, 1, output.Summary.Modified)
 	assert.Equal(t, 1, output.Summary.Failed)
 }
 
+func TestJSONRenderer_SuspendOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 3, Action: "suspend"})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true, Detail: "stopped"})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-456", Error: "access denied"})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-789", Success: true, Skipped: true, Detail: "instance is not running (stopped)"})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, "suspended", output.Resources[0].Status)
+	assert.Equal(t, "skipped", output.Resources[2].Status)
+	// Skipped resources were left untouched, so they are not counted as suspended
+	assert.Equal(t, 1, output.Summary.Succeeded)
+	assert.Equal(t, 1, output.Summary.Suspended)
+	assert.Equal(t, 1, output.Summary.Skipped)
+	assert.Zero(t, output.Summary.Deleted)
+	assert.Equal(t, 1, output.Summary.Failed)
+}
+
+func TestJSONRenderer_NukeInterrupted(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 3})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.NukeInterrupted{
+		Reason:       "interrupted by signal",
+		NotAttempted: reporting.Unattempted("ec2", "us-east-1", []string{"i-456", "i-789"}),
+	})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, "interrupted by signal", output.Interrupted)
+	assert.Equal(t, []UnattemptedResource{
+		{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-456"},
+		{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-789"},
+	}, output.NotAttempted)
+	assert.Equal(t, 1, output.Summary.Deleted)
+	assert.Equal(t, 2, output.Summary.NotAttempted)
+}
+
 func TestJSONRenderer_EmptyOutput(t *testing.T) {
 	var buf bytes.Buffer
 	r := NewJSONRenderer(&buf, JSONRendererConfig{
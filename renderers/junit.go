@@ -0,0 +1,127 @@
+package renderers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+)
+
+// JUnitRenderer outputs nuke results as JUnit XML, so CI systems show resources that failed to be
+// deleted as failed tests. Each resource type and region becomes a testsuite and each deletion attempt
+// a testcase: failures carry the error, warnings and resources an interrupted run did not attempt are
+// reported as skipped, and general errors become errored testcases of their own testsuite.
+// Like the JSON renderer, output is triggered by the Complete event and built from the same events.
+type JUnitRenderer struct {
+	results
+	writer  io.Writer
+	command string
+	regions []string
+}
+
+// NewJUnitRenderer creates a JUnit XML renderer.
+func NewJUnitRenderer(writer io.Writer, cfg JSONRendererConfig) *JUnitRenderer {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &JUnitRenderer{
+		results: newResults(),
+		writer:  writer,
+		command: cfg.Command,
+		regions: cfg.Regions,
+	}
+}
+
+// generalErrorsSuite is the name of the testsuite holding general errors.
+const generalErrorsSuite = "general-errors"
+
+// OnEvent collects events and outputs JUnit XML on Complete.
+func (r *JUnitRenderer) OnEvent(event reporting.Event) {
+	if !r.record(event) {
+		return
+	}
+
+	_, _ = io.WriteString(r.writer, xml.Header)
+	encoder := xml.NewEncoder(r.writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(r.report()); err != nil {
+		_, _ = fmt.Fprintf(r.writer, "Error rendering JUnit output: %v\n", err)
+		return
+	}
+	_, _ = io.WriteString(r.writer, "\n")
+}
+
+// report builds the JUnit report. Inspect runs attempt no deletions, so only their general errors
+// are reported.
+func (r *JUnitRenderer) report() JUnitTestSuites {
+	output := r.nukeOutput(r.command, r.regions)
+	report := JUnitTestSuites{Name: "cloud-nuke " + r.command}
+
+	suites := map[string]int{}
+	suite := func(resourceType, region string) (int, string) {
+		name := fmt.Sprintf("%s.%s", resourceType, region)
+		i, ok := suites[name]
+		if !ok {
+			i = len(report.Suites)
+			suites[name] = i
+			report.Suites = append(report.Suites, JUnitTestSuite{Name: name})
+		}
+		return i, name
+	}
+
+	for _, resource := range output.Resources {
+		i, name := suite(resource.ResourceType, resource.Region)
+
+		testCase := JUnitTestCase{Name: resource.Identifier, ClassName: name, SystemOut: resource.Detail}
+		switch resource.Status {
+		case "failed":
+			testCase.Failure = &JUnitMessage{Message: resource.Error, Type: "failed", Text: resource.Error}
+			report.Suites[i].Failures++
+		case "warned":
+			testCase.Skipped = &JUnitMessage{Message: "warning: " + resource.Error}
+			report.Suites[i].Skipped++
+		case "skipped":
+			testCase.Skipped = &JUnitMessage{Message: "skipped: " + resource.Detail}
+			report.Suites[i].Skipped++
+		}
+		report.Suites[i].Tests++
+		report.Suites[i].Cases = append(report.Suites[i].Cases, testCase)
+	}
+
+	// Resources an interrupted run did not attempt are errors, so that the run fails in CI
+	for _, resource := range output.NotAttempted {
+		i, name := suite(resource.ResourceType, resource.Region)
+		message := "not attempted: " + output.Interrupted
+		report.Suites[i].Cases = append(report.Suites[i].Cases, JUnitTestCase{
+			Name:      resource.Identifier,
+			ClassName: name,
+			Error:     &JUnitMessage{Message: message, Type: notAttemptedStatus, Text: message},
+		})
+		report.Suites[i].Tests++
+		report.Suites[i].Errors++
+	}
+
+	if len(output.Errors) > 0 {
+		suite := JUnitTestSuite{Name: generalErrorsSuite}
+		for _, e := range output.Errors {
+			suite.Cases = append(suite.Cases, JUnitTestCase{
+				Name:      e.Description,
+				ClassName: fmt.Sprintf("%s.%s", generalErrorsSuite, e.ResourceType),
+				Error:     &JUnitMessage{Message: e.Error, Type: "error", Text: e.Error},
+			})
+			suite.Tests++
+			suite.Errors++
+		}
+		report.Suites = append(report.Suites, suite)
+	}
+
+	for _, suite := range report.Suites {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Skipped += suite.Skipped
+	}
+	return report
+}
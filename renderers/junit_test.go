@@ -0,0 +1,91 @@
+package renderers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJUnitRenderer_NukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJUnitRenderer(&buf, JSONRendererConfig{Command: "aws", Regions: []string{"us-east-1"}})
+
+	r.OnEvent(reporting.NukeStarted{Total: 4})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-456", Error: "access denied"})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-1", Warning: true, Error: "DependencyViolation"})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-west-2", Identifier: "i-789", Success: true})
+	r.OnEvent(reporting.GeneralError{ResourceType: "s3", Description: "Failed to list", Error: "timeout"})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	require.True(t, strings.HasPrefix(buf.String(), xml.Header))
+	var output JUnitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &output))
+
+	assert.Equal(t, 5, output.Tests)
+	assert.Equal(t, 1, output.Failures)
+	assert.Equal(t, 1, output.Errors)
+	assert.Equal(t, 1, output.Skipped)
+
+	require.Len(t, output.Suites, 4)
+	assert.Equal(t, "ec2.us-east-1", output.Suites[0].Name)
+	assert.Equal(t, 2, output.Suites[0].Tests)
+	assert.Nil(t, output.Suites[0].Cases[0].Failure)
+	require.NotNil(t, output.Suites[0].Cases[1].Failure)
+	assert.Equal(t, "access denied", output.Suites[0].Cases[1].Failure.Message)
+
+	assert.Equal(t, "vpc.us-east-1", output.Suites[1].Name)
+	require.NotNil(t, output.Suites[1].Cases[0].Skipped)
+	assert.Equal(t, "warning: DependencyViolation", output.Suites[1].Cases[0].Skipped.Message)
+
+	assert.Equal(t, "ec2.us-west-2", output.Suites[2].Name)
+
+	assert.Equal(t, "general-errors", output.Suites[3].Name)
+	require.NotNil(t, output.Suites[3].Cases[0].Error)
+	assert.Equal(t, "timeout", output.Suites[3].Cases[0].Error.Message)
+}
+
+func TestJUnitRenderer_NukeInterrupted(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJUnitRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.NukeInterrupted{
+		Reason:       "interrupted by signal",
+		NotAttempted: reporting.Unattempted("ec2", "us-east-1", []string{"i-456"}),
+	})
+	r.OnEvent(reporting.Complete{})
+
+	var output JUnitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, 2, output.Tests)
+	// An interrupted run must fail in CI, so resources it did not attempt are errors, not skips
+	assert.Equal(t, 1, output.Errors)
+	assert.Zero(t, output.Skipped)
+	require.Len(t, output.Suites, 1)
+	require.Len(t, output.Suites[0].Cases, 2)
+	assert.Nil(t, output.Suites[0].Cases[1].Skipped)
+	require.NotNil(t, output.Suites[0].Cases[1].Error)
+	assert.Equal(t, "not attempted: interrupted by signal", output.Suites[0].Cases[1].Error.Message)
+}
+
+func TestJUnitRenderer_InspectOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJUnitRenderer(&buf, JSONRendererConfig{Command: "inspect-aws"})
+
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Nukable: true})
+	r.OnEvent(reporting.ScanComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output JUnitTestSuites
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, 0, output.Tests)
+	assert.Empty(t, output.Suites)
+}
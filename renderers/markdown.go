@@ -0,0 +1,102 @@
+package renderers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/util"
+)
+
+// MarkdownRenderer outputs results as GitHub-flavored Markdown, e.g. for a pull request comment: a
+// summary line, a table of resources with the configured columns, and the general errors.
+// Like the JSON renderer, output is triggered by the Complete event and built from the same events.
+type MarkdownRenderer struct {
+	results
+	writer  io.Writer
+	command string
+	query   *QueryParams
+	regions []string
+	columns []string
+}
+
+// NewMarkdownRenderer creates a Markdown renderer.
+func NewMarkdownRenderer(writer io.Writer, cfg JSONRendererConfig) *MarkdownRenderer {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &MarkdownRenderer{
+		results: newResults(),
+		writer:  writer,
+		command: cfg.Command,
+		query:   cfg.Query,
+		regions: cfg.Regions,
+		columns: cfg.Columns,
+	}
+}
+
+// OnEvent collects events and outputs Markdown on Complete.
+func (r *MarkdownRenderer) OnEvent(event reporting.Event) {
+	if !r.record(event) {
+		return
+	}
+
+	var b strings.Builder
+	var t table
+	var errors []GeneralError
+	fmt.Fprintf(&b, "## cloud-nuke %s results\n\n", r.command)
+	if r.nukeMode {
+		output := r.nukeOutput(r.command, r.regions)
+		t, errors = nukeTable(output, r.columns), output.Errors
+		fmt.Fprintf(&b, "**%d %s**, %d modified, %d failed, %d warned, %d general errors (%d resources found)\n\n",
+			output.Summary.Succeeded, strings.ToLower(actionPast(r.action)), output.Summary.Modified, output.Summary.Failed,
+			output.Summary.Warned, output.Summary.GeneralErrors, output.Summary.Found)
+		if output.Summary.Skipped > 0 {
+			fmt.Fprintf(&b, "%d resources were skipped and left untouched.\n\n", output.Summary.Skipped)
+		}
+		if output.Interrupted != "" {
+			fmt.Fprintf(&b, "> **Interrupted** (%s): %d resources were not attempted.\n\n", output.Interrupted, output.Summary.NotAttempted)
+		}
+	} else {
+		output := r.inspectOutput(r.command, r.query)
+		t, errors = inspectTable(output, r.columns), output.Errors
+		fmt.Fprintf(&b, "**%d resources found**, %d nukable, %d not nukable, %d general errors\n\n",
+			output.Summary.TotalResources, output.Summary.Nukable, output.Summary.NonNukable, output.Summary.GeneralErrors)
+	}
+
+	if t.summarized {
+		fmt.Fprintf(&b, "Showing summary by resource type and region. Use --output-format json for full details.\n\n")
+	}
+	if len(t.rows) > 0 {
+		writeMarkdownTable(&b, t.header, t.rows)
+	}
+
+	if len(errors) > 0 {
+		b.WriteString("### General errors\n\n")
+		rows := make([][]string, 0, len(errors))
+		for _, e := range errors {
+			rows = append(rows, []string{e.ResourceType, e.Description, e.Error})
+		}
+		writeMarkdownTable(&b, []string{"resource_type", "description", "error"}, rows)
+	}
+
+	if _, err := io.WriteString(r.writer, b.String()); err != nil {
+		_, _ = fmt.Fprintf(r.writer, "Error rendering Markdown output: %v\n", err)
+	}
+}
+
+// writeMarkdownTable writes a table, escaping the cells so they stay on one line and in one column.
+func writeMarkdownTable(b *strings.Builder, header []string, rows [][]string) {
+	b.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	b.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = strings.ReplaceAll(util.RemoveNewlines(cell), "|", `\|`)
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,199 @@
+package renderers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+)
+
+// results accumulates the events that make up a report. Renderers that output once on Complete build
+// their InspectOutput or NukeOutput from it, so every report format sees the same event stream.
+type results struct {
+	scan        *reporting.ScanStarted // query parameters of the scan, nil if ScanStarted was not received
+	found       []reporting.ResourceFound
+	deleted     []reporting.ResourceDeleted
+	errors      []reporting.GeneralError
+	interrupted *reporting.NukeInterrupted // nil unless the nuke operation was interrupted
+	nukeMode    bool                       // true if NukeStarted was received, determines output format
+	action      string                     // action of the nuke operation, from NukeStarted
+}
+
+func newResults() results {
+	return results{
+		found:   make([]reporting.ResourceFound, 0),
+		deleted: make([]reporting.ResourceDeleted, 0),
+		errors:  make([]reporting.GeneralError, 0),
+	}
+}
+
+// record collects an event. It returns true for Complete, when the report should be rendered.
+func (r *results) record(event reporting.Event) bool {
+	switch e := event.(type) {
+	case reporting.ScanStarted:
+		r.scan = &e
+	case reporting.ResourceFound:
+		r.found = append(r.found, e)
+	case reporting.ResourceDeleted:
+		r.deleted = append(r.deleted, e)
+	case reporting.GeneralError:
+		r.errors = append(r.errors, e)
+	case reporting.NukeStarted:
+		r.nukeMode = true
+		r.action = e.Action
+	case reporting.NukeInterrupted:
+		r.interrupted = &e
+	case reporting.Complete:
+		return true
+	}
+	return false
+}
+
+// inspectOutput builds the output of an inspect command.
+func (r *results) inspectOutput(command string, queryParams *QueryParams) InspectOutput {
+	byType := make(map[string]int)
+	byRegion := make(map[string]int)
+	nukableCount := 0
+	nonNukableCount := 0
+
+	resources := r.foundResources()
+	for _, e := range r.found {
+		byType[e.ResourceType]++
+		byRegion[e.Region]++
+		if e.Nukable {
+			nukableCount++
+		} else {
+			nonNukableCount++
+		}
+	}
+
+	query := QueryParams{}
+	if queryParams != nil {
+		query = *queryParams
+	}
+
+	return InspectOutput{
+		Timestamp: time.Now(),
+		Command:   command,
+		Query:     query,
+		Resources: resources,
+		Errors:    r.generalErrors(),
+		Summary: InspectSummary{
+			TotalResources: len(r.found),
+			Nukable:        nukableCount,
+			NonNukable:     nonNukableCount,
+			GeneralErrors:  len(r.errors),
+			ByType:         byType,
+			ByRegion:       byRegion,
+		},
+	}
+}
+
+// nukeOutput builds the output of a nuke command.
+func (r *results) nukeOutput(command string, regions []string) NukeOutput {
+	resources := make([]NukeResourceInfo, 0, len(r.deleted))
+	succeededCount := 0
+	modifiedCount := 0
+	skippedCount := 0
+	failedCount := 0
+	warnedCount := 0
+
+	for _, e := range r.deleted {
+		status := strings.ToLower(actionPast(r.action))
+		if !e.Success {
+			if e.Warning {
+				status = "warned"
+				warnedCount++
+			} else {
+				status = "failed"
+				failedCount++
+			}
+		} else if e.Modified {
+			status = "modified"
+			modifiedCount++
+		} else if e.Skipped {
+			status = "skipped"
+			skippedCount++
+		} else {
+			succeededCount++
+		}
+		resources = append(resources, NukeResourceInfo{
+			ResourceType: e.ResourceType,
+			Region:       e.Region,
+			Identifier:   e.Identifier,
+			Status:       status,
+			Error:        e.Error,
+			Detail:       e.Detail,
+		})
+	}
+
+	output := NukeOutput{
+		Timestamp: time.Now(),
+		Command:   command,
+		Action:    r.action,
+		Regions:   regions,
+		Found:     r.foundResources(),
+		Resources: resources,
+		Errors:    r.generalErrors(),
+		Summary: NukeSummary{
+			Found:         len(r.found),
+			Total:         len(r.deleted),
+			Succeeded:     succeededCount,
+			Modified:      modifiedCount,
+			Skipped:       skippedCount,
+			Failed:        failedCount,
+			Warned:        warnedCount,
+			GeneralErrors: len(r.errors),
+		},
+	}
+
+	switch r.action {
+	case "suspend":
+		output.Summary.Suspended = succeededCount
+	case "resume":
+		output.Summary.Resumed = succeededCount
+	default:
+		output.Summary.Deleted = succeededCount
+	}
+
+	if r.interrupted != nil {
+		output.Interrupted = r.interrupted.Reason
+		output.NotAttempted = make([]UnattemptedResource, 0, len(r.interrupted.NotAttempted))
+		for _, e := range r.interrupted.NotAttempted {
+			output.NotAttempted = append(output.NotAttempted, UnattemptedResource{
+				ResourceType: e.ResourceType,
+				Region:       e.Region,
+				Identifier:   e.Identifier,
+			})
+		}
+		output.Summary.NotAttempted = len(output.NotAttempted)
+	}
+	return output
+}
+
+func (r *results) foundResources() []ResourceInfo {
+	found := make([]ResourceInfo, 0, len(r.found))
+	for _, e := range r.found {
+		found = append(found, ResourceInfo{
+			ResourceType: e.ResourceType,
+			Region:       e.Region,
+			Identifier:   e.Identifier,
+			Nukable:      e.Nukable,
+			Reason:       e.Reason,
+			Detail:       e.Detail,
+		})
+	}
+	return found
+}
+
+func (r *results) generalErrors() []GeneralError {
+	errors := make([]GeneralError, 0, len(r.errors))
+	for _, e := range r.errors {
+		errors = append(errors, GeneralError{
+			ResourceType: e.ResourceType,
+			Description:  e.Description,
+			Error:        e.Error,
+		})
+	}
+	return errors
+}
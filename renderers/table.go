@@ -0,0 +1,151 @@
+package renderers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Default columns of the CSV and Markdown renderers. Columns are named after the JSON fields of
+// ResourceInfo and NukeResourceInfo.
+var (
+	DefaultInspectColumns = []string{"resource_type", "region", "identifier", "nukable", "reason", "detail"}
+	DefaultNukeColumns    = []string{"resource_type", "region", "identifier", "status", "error", "detail"}
+)
+
+// tableColumns are all columns the CSV and Markdown renderers support. Columns that a row lacks,
+// such as status for found resources, are left empty.
+var tableColumns = []string{"resource_type", "region", "identifier", "nukable", "reason", "status", "error", "detail"}
+
+// ValidateColumns returns an error if any of the columns is not supported by the CSV and Markdown
+// renderers.
+func ValidateColumns(columns []string) error {
+	for _, column := range columns {
+		if !slices.Contains(tableColumns, column) {
+			return fmt.Errorf("unknown output column %q: must be one of %s", column, strings.Join(tableColumns, ", "))
+		}
+	}
+	return nil
+}
+
+// table is the content of a CSV or Markdown report: a row per resource, or when there are more than
+// MaxResourcesForDetailedTable, a summary row per resource type and region.
+type table struct {
+	header     []string
+	rows       [][]string
+	summarized bool
+}
+
+// inspectTable builds the table of an inspect command's output.
+func inspectTable(output InspectOutput, columns []string) table {
+	if len(columns) == 0 {
+		columns = DefaultInspectColumns
+	}
+	if len(output.Resources) > MaxResourcesForDetailedTable {
+		return summaryTable(len(output.Resources), []string{"count", "nukable", "not_nukable"}, func(i int) (string, string, int) {
+			e := output.Resources[i]
+			if e.Nukable {
+				return e.ResourceType, e.Region, 1
+			}
+			return e.ResourceType, e.Region, 2
+		})
+	}
+
+	t := table{header: columns}
+	for _, e := range output.Resources {
+		t.rows = append(t.rows, tableRow(columns, map[string]string{
+			"resource_type": e.ResourceType,
+			"region":        e.Region,
+			"identifier":    e.Identifier,
+			"nukable":       fmt.Sprintf("%t", e.Nukable),
+			"reason":        e.Reason,
+			"detail":        e.Detail,
+		}))
+	}
+	return t
+}
+
+// nukeTable builds the table of a nuke command's output.
+func nukeTable(output NukeOutput, columns []string) table {
+	if len(columns) == 0 {
+		columns = DefaultNukeColumns
+	}
+	// Resources an interrupted run did not attempt are listed with the not_attempted status
+	resources := slices.Clone(output.Resources)
+	for _, e := range output.NotAttempted {
+		resources = append(resources, NukeResourceInfo{ResourceType: e.ResourceType, Region: e.Region, Identifier: e.Identifier, Status: notAttemptedStatus})
+	}
+
+	if len(resources) > MaxResourcesForDetailedTable {
+		succeeded := strings.ToLower(actionPast(output.Action))
+		outcomes := []string{succeeded, "modified", "failed", "warned"}
+		if output.Summary.Skipped > 0 {
+			outcomes = append(outcomes, "skipped")
+		}
+		if output.Interrupted != "" {
+			outcomes = append(outcomes, notAttemptedStatus)
+		}
+		return summaryTable(len(resources), append([]string{"count"}, outcomes...), func(i int) (string, string, int) {
+			e := resources[i]
+			return e.ResourceType, e.Region, 1 + max(slices.Index(outcomes, e.Status), 0)
+		})
+	}
+
+	t := table{header: columns}
+	for _, e := range resources {
+		t.rows = append(t.rows, tableRow(columns, map[string]string{
+			"resource_type": e.ResourceType,
+			"region":        e.Region,
+			"identifier":    e.Identifier,
+			"status":        e.Status,
+			"error":         e.Error,
+			"detail":        e.Detail,
+		}))
+	}
+	return t
+}
+
+// notAttemptedStatus is the status of the resources an interrupted run did not attempt.
+const notAttemptedStatus = "not_attempted"
+
+// tableRow returns the values of the columns, in order.
+func tableRow(columns []string, values map[string]string) []string {
+	row := make([]string, len(columns))
+	for i, column := range columns {
+		row[i] = values[column]
+	}
+	return row
+}
+
+// summaryTable counts n resources by resource type and region, like the CLI summary tables. The
+// counts columns start with the total; classify returns the resource type, region and the index of
+// the counts column each resource adds to.
+func summaryTable(n int, counts []string, classify func(int) (string, string, int)) table {
+	type key struct {
+		ResourceType string
+		Region       string
+	}
+
+	summary := make(map[key][]int)
+	var order []key
+	for i := 0; i < n; i++ {
+		resourceType, region, column := classify(i)
+		k := key{resourceType, region}
+		if _, exists := summary[k]; !exists {
+			summary[k] = make([]int, len(counts))
+			order = append(order, k)
+		}
+		summary[k][0]++
+		summary[k][column]++
+	}
+
+	t := table{header: append([]string{"resource_type", "region"}, counts...), summarized: true}
+	for _, k := range order {
+		row := []string{k.ResourceType, k.Region}
+		for _, count := range summary[k] {
+			row = append(row, fmt.Sprintf("%d", count))
+		}
+		t.rows = append(t.rows, row)
+	}
+	return t
+}
@@ -0,0 +1,104 @@
+package renderers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVRenderer_InspectOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCSVRenderer(&buf, JSONRendererConfig{Command: "inspect-aws", Columns: []string{"identifier", "nukable", "status"}})
+
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Nukable: true})
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i,456", Reason: "protected"})
+	r.OnEvent(reporting.ScanComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"identifier", "nukable", "status"},
+		{"i-123", "true", ""},
+		{"i,456", "false", ""},
+	}, records)
+}
+
+func TestCSVRenderer_NukeOutputDefaultColumns(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCSVRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-456", Error: "access denied"})
+	r.OnEvent(reporting.Complete{})
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultNukeColumns, records[0])
+	assert.Equal(t, []string{"ec2", "us-east-1", "i-123", "deleted", "", ""}, records[1])
+	assert.Equal(t, []string{"ec2", "us-east-1", "i-456", "failed", "access denied", ""}, records[2])
+}
+
+func TestCSVRenderer_NukeInterrupted(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCSVRenderer(&buf, JSONRendererConfig{Command: "aws", Columns: []string{"identifier", "status"}})
+
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.NukeInterrupted{
+		Reason:       "interrupted by signal",
+		NotAttempted: reporting.Unattempted("ec2", "us-east-1", []string{"i-456"}),
+	})
+	r.OnEvent(reporting.Complete{})
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"identifier", "status"},
+		{"i-123", "deleted"},
+		{"i-456", "not_attempted"},
+	}, records)
+}
+
+func TestMarkdownRenderer_InspectOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewMarkdownRenderer(&buf, JSONRendererConfig{Command: "inspect-aws", Columns: []string{"resource_type", "identifier", "reason"}})
+
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Reason: "a|b\nc"})
+	r.OnEvent(reporting.GeneralError{ResourceType: "s3", Description: "Failed to list", Error: "timeout"})
+	r.OnEvent(reporting.Complete{})
+
+	output := buf.String()
+	assert.Contains(t, output, "**1 resources found**")
+	assert.Contains(t, output, "| resource_type | identifier | reason |\n| --- | --- | --- |\n| ec2 | i-123 | a\\|b c |\n")
+	assert.Contains(t, output, "### General errors")
+	assert.Contains(t, output, "| s3 | Failed to list | timeout |")
+}
+
+func TestMarkdownRenderer_SummarizesLargeResults(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewMarkdownRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: MaxResourcesForDetailedTable + 1})
+	for i := 0; i <= MaxResourcesForDetailedTable; i++ {
+		r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: fmt.Sprintf("i-%d", i), Success: i > 0})
+	}
+	r.OnEvent(reporting.Complete{})
+
+	output := buf.String()
+	assert.Contains(t, output, "Showing summary")
+	assert.Contains(t, output, "| resource_type | region | count | deleted | modified | failed | warned |")
+	assert.Contains(t, output, fmt.Sprintf("| ec2 | us-east-1 | %d | %d | 0 | 1 | 0 |", MaxResourcesForDetailedTable+1, MaxResourcesForDetailedTable))
+}
+
+func TestValidateColumns(t *testing.T) {
+	assert.NoError(t, ValidateColumns(nil))
+	assert.NoError(t, ValidateColumns([]string{"identifier", "status"}))
+	assert.ErrorContains(t, ValidateColumns([]string{"identifier", "tags"}), `unknown output column "tags"`)
+}
@@ -1,6 +1,7 @@
 package renderers
 
 import (
+	"encoding/xml"
 	"time"
 )
 
@@ -32,6 +33,7 @@ type ResourceInfo struct {
 	Identifier   string `json:"identifier"`
 	Nukable      bool   `json:"nukable"`
 	Reason       string `json:"reason,omitempty"`
+	Detail       string `json:"detail,omitempty"`
 }
 
 // InspectSummary provides summary statistics for inspection results.
@@ -48,11 +50,16 @@ type InspectSummary struct {
 type NukeOutput struct {
 	Timestamp time.Time          `json:"timestamp"`
 	Command   string             `json:"command"`
+	Action    string             `json:"action,omitempty"`
 	Regions   []string           `json:"regions,omitempty"`
 	Found     []ResourceInfo     `json:"found"`
 	Resources []NukeResourceInfo `json:"resources"`
 	Errors    []GeneralError     `json:"general_errors,omitempty"`
 	Summary   NukeSummary        `json:"summary"`
+
+	// Interrupted is why the operation stopped early, e.g. on SIGINT, and NotAttempted the resources it never attempted
+	Interrupted  string                `json:"interrupted,omitempty"`
+	NotAttempted []UnattemptedResource `json:"not_attempted,omitempty"`
 }
 
 // NukeResourceInfo represents information about a resource deletion attempt.
@@ -60,8 +67,16 @@ type NukeResourceInfo struct {
 	ResourceType string `json:"resource_type"`
 	Region       string `json:"region"`
 	Identifier   string `json:"identifier"`
-	Status       string `json:"status"` // "deleted", "failed", or "warned"
+	Status       string `json:"status"` // "deleted" ("suspended" or "resumed" for those actions), "modified", "skipped", "failed", or "warned"
 	Error        string `json:"error,omitempty"`
+	Detail       string `json:"detail,omitempty"`
+}
+
+// UnattemptedResource represents a resource that an interrupted nuke operation did not attempt.
+type UnattemptedResource struct {
+	ResourceType string `json:"resource_type"`
+	Region       string `json:"region"`
+	Identifier   string `json:"identifier"`
 }
 
 // GeneralError represents a general error in JSON output.
@@ -75,15 +90,63 @@ type GeneralError struct {
 type NukeSummary struct {
 	Found         int `json:"found"`
 	Total         int `json:"total"`
+	Succeeded     int `json:"succeeded"` // resources deleted, suspended or resumed, depending on the action
 	Deleted       int `json:"deleted"`
+	Suspended     int `json:"suspended,omitempty"`
+	Resumed       int `json:"resumed,omitempty"`
+	Modified      int `json:"modified"`
+	Skipped       int `json:"skipped,omitempty"` // resources a suspend or resume left untouched
 	Failed        int `json:"failed"`
 	Warned        int `json:"warned"`
+	NotAttempted  int `json:"not_attempted,omitempty"`
 	GeneralErrors int `json:"general_errors"`
 }
 
-// JSONRendererConfig holds configuration for the JSON renderer.
+// JUnit XML Output Types
+
+// JUnitTestSuites is the root element of a JUnit XML report.
+type JUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite groups the testcases of one resource type and region.
+type JUnitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitTestCase is a single deletion attempt or general error.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *JUnitMessage `xml:"failure,omitempty"`
+	Error     *JUnitMessage `xml:"error,omitempty"`
+	Skipped   *JUnitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// JUnitMessage is the failure, error or skip reason of a testcase.
+type JUnitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// JSONRendererConfig holds configuration for the JSON renderer and the other report renderers built
+// on the same output.
 type JSONRendererConfig struct {
 	Command string
 	Query   *QueryParams
 	Regions []string
+	Columns []string // columns of the CSV and Markdown renderers; the defaults when empty
 }
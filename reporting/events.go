@@ -41,6 +41,7 @@ type ResourceFound struct {
 	Identifier   string
 	Nukable      bool
 	Reason       string // Why not nukable (e.g., "protected by config")
+	Detail       string // Optional details reported by the resource type (e.g., why it is orphaned)
 }
 
 func (ResourceFound) EventType() string { return "resource_found" }
@@ -54,6 +55,9 @@ type ResourceDeleted struct {
 	Success      bool
 	Warning      bool   // True if failure is transient/expected (e.g., DependencyViolation)
 	Error        string // Empty if success
+	Detail       string // Optional outcome details reported by the resource type
+	Modified     bool   // True if the resource was modified in place instead of deleted
+	Skipped      bool   // True if the action left the resource untouched; Detail says why
 }
 
 func (ResourceDeleted) EventType() string { return "resource_deleted" }
@@ -71,7 +75,8 @@ func (GeneralError) EventType() string { return "general_error" }
 // NukeStarted is emitted at the start of a nuke operation.
 // Used by CLI renderer to initialize the progress bar.
 type NukeStarted struct {
-	Total int // Total resources to nuke
+	Total  int    // Total resources to nuke
+	Action string // What is done to the resources: "delete", "suspend" or "resume". Empty means delete.
 }
 
 func (NukeStarted) EventType() string { return "nuke_started" }
@@ -86,6 +91,32 @@ type NukeProgress struct {
 
 func (NukeProgress) EventType() string { return "nuke_progress" }
 
+// NukeInterrupted is emitted before NukeComplete when a nuke operation stops early, e.g. on SIGINT
+// or SIGTERM. Batches in flight are finished and reported with ResourceDeleted; the resources of the
+// batches that were never started are listed in NotAttempted.
+type NukeInterrupted struct {
+	Reason       string
+	NotAttempted []UnattemptedResource
+}
+
+func (NukeInterrupted) EventType() string { return "nuke_interrupted" }
+
+// UnattemptedResource identifies a resource that an interrupted nuke operation did not attempt.
+type UnattemptedResource struct {
+	ResourceType string
+	Region       string
+	Identifier   string
+}
+
+// Unattempted lists the resources of a resource type in a region that were not attempted.
+func Unattempted(resourceType string, region string, identifiers []string) []UnattemptedResource {
+	resources := make([]UnattemptedResource, 0, len(identifiers))
+	for _, id := range identifiers {
+		resources = append(resources, UnattemptedResource{ResourceType: resourceType, Region: region, Identifier: id})
+	}
+	return resources
+}
+
 // NukeComplete is emitted when all nuke operations are finished.
 // Used by CLI renderer to stop progress bar and display deletion results.
 type NukeComplete struct{}
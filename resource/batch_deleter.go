@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -13,6 +14,9 @@ import (
 type NukeResult struct {
 	Identifier string
 	Error      error
+	Detail     string // Optional outcome details, e.g. the steps taken to delete the resource
+	Modified   bool   // True if the resource was modified in place instead of deleted, e.g. its retention bounded
+	Skipped    bool   // True if the action left the resource untouched, e.g. it was already stopped; Detail says why
 }
 
 // DeleteFunc is a function that deletes a single resource by ID.
@@ -24,20 +28,25 @@ type NukerFunc[C any] func(ctx context.Context, client C, scope Scope, resourceT
 
 // logStart checks if identifiers is empty and logs the start of deletion.
 // Returns true if empty (caller should return early), false otherwise.
-func logStart(identifiers []*string, resourceType string, scope Scope) bool {
+func logStart(ctx context.Context, identifiers []*string, resourceType string, scope Scope) bool {
 	if len(identifiers) == 0 {
-		logging.Debugf("No %s to nuke in %s", resourceType, scope)
+		logging.DebugfContext(ctx, "No %s to nuke in %s", resourceType, scope)
 		return true
 	}
-	logging.Infof("Deleting %d %s in %s", len(identifiers), resourceType, scope)
+	logging.InfofContext(ctx, "Deleting %d %s in %s", len(identifiers), resourceType, scope)
 	return false
 }
 
+// withIdentifier returns ctx carrying the identifier of the resource being deleted as a log field.
+func withIdentifier(ctx context.Context, id string) context.Context {
+	return logging.WithFields(ctx, logging.Fields{"identifier": id})
+}
+
 // SimpleBatchDeleter creates a nuker that deletes resources concurrently.
 // Concurrency is controlled by the parallelism value in ctx (see util.GetParallelism).
 func SimpleBatchDeleter[C any](deleteFn DeleteFunc[C]) NukerFunc[C] {
 	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
-		if logStart(identifiers, resourceType, scope) {
+		if logStart(ctx, identifiers, resourceType, scope) {
 			return nil
 		}
 
@@ -55,7 +64,7 @@ func SimpleBatchDeleter[C any](deleteFn DeleteFunc[C]) NukerFunc[C] {
 				defer func() { <-sem }()
 
 				idStr := util.DerefString(identifier)
-				err := deleteFn(ctx, client, identifier)
+				err := deleteFn(withIdentifier(ctx, idStr), client, identifier)
 
 				mu.Lock()
 				results[idx] = NukeResult{Identifier: idStr, Error: err}
@@ -72,14 +81,14 @@ func SimpleBatchDeleter[C any](deleteFn DeleteFunc[C]) NukerFunc[C] {
 // Use this for APIs with strict rate limits.
 func SequentialDeleter[C any](deleteFn DeleteFunc[C]) NukerFunc[C] {
 	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
-		if logStart(identifiers, resourceType, scope) {
+		if logStart(ctx, identifiers, resourceType, scope) {
 			return nil
 		}
 
 		results := make([]NukeResult, 0, len(identifiers))
 		for _, id := range identifiers {
 			idStr := util.DerefString(id)
-			err := deleteFn(ctx, client, id)
+			err := deleteFn(withIdentifier(ctx, idStr), client, id)
 			results = append(results, NukeResult{Identifier: idStr, Error: err})
 		}
 
@@ -87,6 +96,57 @@ func SequentialDeleter[C any](deleteFn DeleteFunc[C]) NukerFunc[C] {
 	}
 }
 
+// DeleteWithDetailFunc is a function that deletes a single resource by ID and returns details about
+// the deletion, e.g. the storage it reclaimed.
+type DeleteWithDetailFunc[C any] func(ctx context.Context, client C, id *string) (string, error)
+
+// SequentialDetailDeleter creates a nuker that deletes resources one at a time, like
+// SequentialDeleter, and reports the details returned by deleteFn.
+func SequentialDetailDeleter[C any](deleteFn DeleteWithDetailFunc[C]) NukerFunc[C] {
+	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
+		if logStart(ctx, identifiers, resourceType, scope) {
+			return nil
+		}
+
+		results := make([]NukeResult, 0, len(identifiers))
+		for _, id := range identifiers {
+			idStr := util.DerefString(id)
+			detail, err := deleteFn(withIdentifier(ctx, idStr), client, id)
+			results = append(results, NukeResult{Identifier: idStr, Error: err, Detail: detail})
+		}
+
+		return results
+	}
+}
+
+// ActionFunc applies a non-destructive action, such as suspend or resume, to a single resource and
+// returns details about what it did, e.g. the state it recorded. It returns a
+// util.SkippedResourceError to leave the resource untouched.
+type ActionFunc[C any] func(ctx context.Context, client C, id *string) (string, error)
+
+// SequentialActor creates a NukerFunc that applies an action to resources one at a time, logging it
+// with verb (e.g. "Suspending"). Use it for Resource.Suspender and Resource.Resumer.
+func SequentialActor[C any](verb string, actionFn ActionFunc[C]) NukerFunc[C] {
+	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
+		if len(identifiers) == 0 {
+			return nil
+		}
+		logging.InfofContext(ctx, "%s %d %s in %s", verb, len(identifiers), resourceType, scope)
+
+		results := make([]NukeResult, 0, len(identifiers))
+		for _, id := range identifiers {
+			detail, err := actionFn(withIdentifier(ctx, util.DerefString(id)), client, id)
+			var skipped util.SkippedResourceError
+			if errors.As(err, &skipped) {
+				results = append(results, NukeResult{Identifier: util.DerefString(id), Detail: skipped.Reason, Skipped: true})
+				continue
+			}
+			results = append(results, NukeResult{Identifier: util.DerefString(id), Error: err, Detail: detail})
+		}
+		return results
+	}
+}
+
 // BulkDeleteFunc is a function that deletes multiple resources in a single API call.
 type BulkDeleteFunc[C any] func(ctx context.Context, client C, ids []string) error
 
@@ -112,7 +172,7 @@ type BulkResultDeleteFunc[C any] func(ctx context.Context, client C, ids []strin
 // Use this for AWS APIs where some items can succeed while others fail in the same call.
 func BulkResultDeleter[C any](deleteFn BulkResultDeleteFunc[C]) NukerFunc[C] {
 	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
-		if logStart(identifiers, resourceType, scope) {
+		if logStart(ctx, identifiers, resourceType, scope) {
 			return nil
 		}
 
@@ -148,7 +208,7 @@ func DeleteThenWait[C any](deleteFn DeleteFunc[C], waitFn DeleteFunc[C]) DeleteF
 // Each resource is processed sequentially, but if any step fails for a resource, it moves to the next resource.
 func MultiStepDeleter[C any](steps ...DeleteFunc[C]) NukerFunc[C] {
 	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
-		if logStart(identifiers, resourceType, scope) {
+		if logStart(ctx, identifiers, resourceType, scope) {
 			return nil
 		}
 
@@ -158,7 +218,7 @@ func MultiStepDeleter[C any](steps ...DeleteFunc[C]) NukerFunc[C] {
 			var stepErr error
 
 			for i, step := range steps {
-				if err := step(ctx, client, id); err != nil {
+				if err := step(withIdentifier(ctx, idStr), client, id); err != nil {
 					stepErr = fmt.Errorf("step %d: %w", i+1, err)
 					break
 				}
@@ -190,7 +250,7 @@ type WaitAllFunc[C any] func(ctx context.Context, client C, ids []string) error
 //	)
 func SequentialDeleteThenWaitAll[C any](deleteFn DeleteFunc[C], waitAllFn WaitAllFunc[C]) NukerFunc[C] {
 	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
-		if logStart(identifiers, resourceType, scope) {
+		if logStart(ctx, identifiers, resourceType, scope) {
 			return nil
 		}
 
@@ -200,13 +260,14 @@ func SequentialDeleteThenWaitAll[C any](deleteFn DeleteFunc[C], waitAllFn WaitAl
 		// Phase 1: Delete all resources sequentially
 		for _, id := range identifiers {
 			idStr := util.DerefString(id)
-			err := deleteFn(ctx, client, id)
+			idCtx := withIdentifier(ctx, idStr)
+			err := deleteFn(idCtx, client, id)
 
 			if err != nil {
 				results = append(results, NukeResult{Identifier: idStr, Error: err})
 			} else {
 				deletedIds = append(deletedIds, idStr)
-				logging.Debugf("[Deleted] %s: %s (waiting for confirmation)", resourceType, idStr)
+				logging.DebugfContext(idCtx, "[Deleted] %s: %s (waiting for confirmation)", resourceType, idStr)
 			}
 		}
 
@@ -237,7 +298,7 @@ func SequentialDeleteThenWaitAll[C any](deleteFn DeleteFunc[C], waitAllFn WaitAl
 //	)
 func ConcurrentDeleteThenWaitAll[C any](deleteFn DeleteFunc[C], waitAllFn WaitAllFunc[C]) NukerFunc[C] {
 	return func(ctx context.Context, client C, scope Scope, resourceType string, identifiers []*string) []NukeResult {
-		if logStart(identifiers, resourceType, scope) {
+		if logStart(ctx, identifiers, resourceType, scope) {
 			return nil
 		}
 
@@ -259,11 +320,12 @@ func ConcurrentDeleteThenWaitAll[C any](deleteFn DeleteFunc[C], waitAllFn WaitAl
 				defer func() { <-sem }()
 
 				idStr := util.DerefString(identifier)
-				err := deleteFn(ctx, client, identifier)
+				idCtx := withIdentifier(ctx, idStr)
+				err := deleteFn(idCtx, client, identifier)
 				deleteResults[idx] = deleteResult{idStr: idStr, err: err}
 
 				if err == nil {
-					logging.Debugf("[Deleted] %s: %s (waiting for confirmation)", resourceType, idStr)
+					logging.DebugfContext(idCtx, "[Deleted] %s: %s (waiting for confirmation)", resourceType, idStr)
 				}
 			}(i, id)
 		}
@@ -75,6 +75,39 @@ func TestBulkResultDeleter_PartialFailure(t *testing.T) {
 	assert.NoError(t, results[2].Error)
 }
 
+// SequentialDetailDeleter
+
+func TestSequentialDetailDeleter(t *testing.T) {
+	nuker := SequentialDetailDeleter(func(_ context.Context, _ *mockClient, id *string) (string, error) {
+		if *id == "fail" {
+			return "", errors.New("delete failed")
+		}
+		return "reclaimed " + *id, nil
+	})
+	results := nuker(ctx, client, scope, "test", ids("a", "fail"))
+	require.Len(t, results, 2)
+	assert.Equal(t, NukeResult{Identifier: "a", Detail: "reclaimed a"}, results[0])
+	assert.EqualError(t, results[1].Error, "delete failed")
+	assert.Empty(t, results[1].Detail)
+}
+
+func TestSequentialActor(t *testing.T) {
+	nuker := SequentialActor("Suspending", func(_ context.Context, _ *mockClient, id *string) (string, error) {
+		switch *id {
+		case "fail":
+			return "", errors.New("stop failed")
+		case "stopped":
+			return "", util.SkippedResourceError{Reason: "already stopped"}
+		}
+		return "stopped " + *id, nil
+	})
+	results := nuker(ctx, client, scope, "test", ids("a", "fail", "stopped"))
+	require.Len(t, results, 3)
+	assert.Equal(t, NukeResult{Identifier: "a", Detail: "stopped a"}, results[0])
+	assert.EqualError(t, results[1].Error, "stop failed")
+	assert.Equal(t, NukeResult{Identifier: "stopped", Detail: "already stopped", Skipped: true}, results[2])
+}
+
 // DeleteThenWait
 
 func TestDeleteThenWait(t *testing.T) {
@@ -278,6 +311,7 @@ func TestNukerFuncs_Empty(t *testing.T) {
 	nukers := map[string]NukerFunc[*mockClient]{
 		"SimpleBatch":              SimpleBatchDeleter(noopDelete),
 		"Sequential":              SequentialDeleter(noopDelete),
+		"SequentialDetail":        SequentialDetailDeleter(func(_ context.Context, _ *mockClient, _ *string) (string, error) { return "", nil }),
 		"MultiStep":               MultiStepDeleter(noopDelete),
 		"Bulk":                    BulkDeleter(func(_ context.Context, _ *mockClient, _ []string) error { return nil }),
 		"BulkResult":              BulkResultDeleter(func(_ context.Context, _ *mockClient, _ []string) []NukeResult { return nil }),
@@ -294,7 +328,7 @@ func TestNukerFuncs_Empty(t *testing.T) {
 // logStart
 
 func TestLogStart(t *testing.T) {
-	assert.True(t, logStart(nil, "test", scope))
+	assert.True(t, logStart(context.Background(), nil, "test", scope))
 	id := "x"
-	assert.False(t, logStart([]*string{&id}, "test", scope))
+	assert.False(t, logStart(context.Background(), []*string{&id}, "test", scope))
 }
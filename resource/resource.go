@@ -33,6 +33,13 @@ func (s Scope) String() string {
 	return s.Region
 }
 
+// Permissions lists the provider permissions (e.g., IAM actions such as "ec2:DescribeVpcs") a resource
+// type needs. Nuking needs both lists; inspecting needs only List.
+type Permissions struct {
+	List []string // Called by the Lister, including tags written while listing
+	Nuke []string // Called by the Nuker and PermissionVerifier
+}
+
 // NukeableResource defines the common interface for all cloud resources that can be nuked.
 // This is embedded by provider-specific interfaces (AwsResource, GcpResource) which add
 // their own Init method with provider-specific config types.
@@ -46,6 +53,14 @@ type NukeableResource interface {
 	GetAndSetResourceConfig(config.Config) config.ResourceType
 }
 
+// Suspendable is implemented by resources that can be paused instead of deleted. Resource types
+// whose CanSuspend reports false are skipped by the suspend and resume actions.
+type Suspendable interface {
+	CanSuspend() bool
+	Suspend(ctx context.Context, identifiers []string) ([]NukeResult, error)
+	Resume(ctx context.Context, identifiers []string) ([]NukeResult, error)
+}
+
 // Resource is the universal struct for all nukeable resources.
 // C is the cloud service client type (e.g., *ec2.Client, *storage.Client).
 //
@@ -81,9 +96,21 @@ type Resource[C any] struct {
 	// Nuker deletes the resources. Use SimpleBatchDeleter, SequentialDeleter, or MultiStepDeleter.
 	Nuker NukerFunc[C]
 
+	// Suspender pauses the resources instead of deleting them, e.g. stops instances or scales to zero,
+	// and records their previous state so that Resumer can restore it (nil = cannot be suspended).
+	// Use SequentialActor.
+	Suspender NukerFunc[C]
+
+	// Resumer restores the state of resources paused by Suspender.
+	Resumer NukerFunc[C]
+
 	// PermissionVerifier performs optional dry-run permission checks (nil = skip verification)
 	PermissionVerifier func(ctx context.Context, client C, id *string) error
 
+	// Permissions declares the permissions used by Lister and Nuker. AWS resources whose client is an
+	// API interface may leave this empty; their IAM actions are derived from the interface methods.
+	Permissions Permissions
+
 	// === Runtime state (set during execution) ===
 
 	// Client is the typed cloud service client
@@ -155,12 +182,23 @@ func (r *Resource[C]) GetAndSetIdentifiers(ctx context.Context, configObj config
 		return nil, r.InitializationError
 	}
 
-	resourceCfg := r.ConfigGetter(configObj)
+	ctx = r.logContext(ctx)
+	resourceCfg := r.ConfigGetter(configObj).InRegion(r.Scope.Region)
 	identifiers, err := r.Lister(ctx, r.Client, r.Scope, resourceCfg)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to list resources in %s: %w", r.ResourceTypeName, r.Scope, err)
 	}
 
+	// Protected resources are marked before permission verification, which skips marked identifiers
+	for _, id := range identifiers {
+		if id == nil {
+			continue
+		}
+		if reason, ok := configObj.ProtectedIdentifiers[*id]; ok {
+			r.setNukableStatus(*id, util.ProtectedResourceError{Reason: reason})
+		}
+	}
+
 	// Run permission verification if configured
 	if r.PermissionVerifier != nil {
 		r.verifyNukablePermissions(identifiers, func(id *string) error {
@@ -175,6 +213,36 @@ func (r *Resource[C]) GetAndSetIdentifiers(ctx context.Context, configObj config
 // Nuke deletes the resources with the given identifiers (implements AwsResource/GcpResource interface)
 // Returns the results of each deletion attempt. The caller is responsible for reporting.
 func (r *Resource[C]) Nuke(ctx context.Context, identifiers []string) ([]NukeResult, error) {
+	if r.Nuker == nil && len(identifiers) > 0 {
+		return nil, fmt.Errorf("%s: Nuker function not configured", r.ResourceTypeName)
+	}
+	return r.apply(ctx, r.Nuker, "Deleted", identifiers)
+}
+
+// CanSuspend reports whether the resource type can be suspended and resumed (implements Suspendable).
+func (r *Resource[C]) CanSuspend() bool {
+	return r.Suspender != nil && r.Resumer != nil
+}
+
+// Suspend pauses the resources with the given identifiers instead of deleting them (implements Suspendable).
+func (r *Resource[C]) Suspend(ctx context.Context, identifiers []string) ([]NukeResult, error) {
+	if !r.CanSuspend() && len(identifiers) > 0 {
+		return nil, fmt.Errorf("%s: cannot be suspended", r.ResourceTypeName)
+	}
+	return r.apply(ctx, r.Suspender, "Suspended", identifiers)
+}
+
+// Resume restores the resources with the given identifiers paused by Suspend (implements Suspendable).
+func (r *Resource[C]) Resume(ctx context.Context, identifiers []string) ([]NukeResult, error) {
+	if !r.CanSuspend() && len(identifiers) > 0 {
+		return nil, fmt.Errorf("%s: cannot be resumed", r.ResourceTypeName)
+	}
+	return r.apply(ctx, r.Resumer, "Resumed", identifiers)
+}
+
+// apply runs fn on the resources with the given identifiers and logs the results, describing
+// successes with done.
+func (r *Resource[C]) apply(ctx context.Context, fn NukerFunc[C], done string, identifiers []string) ([]NukeResult, error) {
 	if len(identifiers) == 0 {
 		return nil, nil
 	}
@@ -183,32 +251,41 @@ func (r *Resource[C]) Nuke(ctx context.Context, identifiers []string) ([]NukeRes
 		return nil, r.InitializationError
 	}
 
-	if r.Nuker == nil {
-		return nil, fmt.Errorf("%s: Nuker function not configured", r.ResourceTypeName)
-	}
-
+	ctx = r.logContext(ctx)
 	ptrIdentifiers := util.ToStringPtrSlice(identifiers)
-	results := r.Nuker(ctx, r.Client, r.Scope, r.ResourceTypeName, ptrIdentifiers)
+	results := fn(ctx, r.Client, r.Scope, r.ResourceTypeName, ptrIdentifiers)
 
 	// Aggregate errors and log results (logging stays here, it's not reporting)
 	var allErrs *multierror.Error
 	for _, result := range results {
+		resultCtx := logging.WithFields(ctx, logging.Fields{"identifier": result.Identifier})
 		if result.Error != nil {
 			if util.IsWarningError(result.Error) {
-				logging.Warnf("[Warning] %s %s: %s (non-fatal, will retry next run)",
+				logging.WarnfContext(resultCtx, "[Warning] %s %s: %s (non-fatal, will retry next run)",
 					r.ResourceTypeName, result.Identifier, result.Error)
 			} else {
-				logging.Errorf("[Failed] %s %s: %s", r.ResourceTypeName, result.Identifier, result.Error)
+				logging.ErrorfContext(resultCtx, "[Failed] %s %s: %s", r.ResourceTypeName, result.Identifier, result.Error)
 				allErrs = multierror.Append(allErrs, fmt.Errorf("%s: %w", result.Identifier, result.Error))
 			}
 		} else {
-			logging.Debugf("[OK] Deleted %s: %s", r.ResourceTypeName, result.Identifier)
+			if result.Modified {
+				logging.DebugfContext(resultCtx, "[OK] Modified %s %s: %s", r.ResourceTypeName, result.Identifier, result.Detail)
+			} else if result.Skipped {
+				logging.DebugfContext(resultCtx, "[Skipped] %s %s: %s", r.ResourceTypeName, result.Identifier, result.Detail)
+			} else {
+				logging.DebugfContext(resultCtx, "[OK] %s %s: %s", done, r.ResourceTypeName, result.Identifier)
+			}
 		}
 	}
 
 	return results, errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
+// logContext returns ctx carrying the resource type and region as log fields.
+func (r *Resource[C]) logContext(ctx context.Context) context.Context {
+	return logging.WithFields(ctx, logging.Fields{"resource_type": r.ResourceTypeName, "region": r.Scope.Region})
+}
+
 // IsNukable checks if a resource can be nuked (implements AwsResource/GcpResource interface).
 // Returns (true, nil) if nukable, (false, error) if not.
 // If the identifier was never verified, returns (true, nil) - assuming nukable by default.
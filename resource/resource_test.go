@@ -156,6 +156,37 @@ func TestResource_PermissionVerification(t *testing.T) {
 	assert.Contains(t, err.Error(), "access denied")
 }
 
+func TestResource_ProtectedIdentifiers(t *testing.T) {
+	verified := []string{}
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		Lister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]*string, error) {
+			return util.ToStringPtrSlice([]string{"managed", "unmanaged"}), nil
+		},
+		ConfigGetter: func(c config.Config) config.ResourceType {
+			return config.ResourceType{}
+		},
+		PermissionVerifier: func(ctx context.Context, client *mockClient, id *string) error {
+			verified = append(verified, *id)
+			return nil
+		},
+	}
+	r.Init(nil)
+
+	cfg := config.Config{}
+	cfg.AddProtectedIdentifiers(map[string]string{"managed": "managed by terraform: aws_s3_bucket.logs"})
+	_, err := r.GetAndSetIdentifiers(context.Background(), cfg)
+	require.NoError(t, err)
+
+	nukable, err := r.IsNukable("managed")
+	assert.False(t, nukable)
+	assert.EqualError(t, err, "managed by terraform: aws_s3_bucket.logs")
+
+	nukable, _ = r.IsNukable("unmanaged")
+	assert.True(t, nukable)
+	assert.Equal(t, []string{"unmanaged"}, verified)
+}
+
 func TestResource_IsNukable(t *testing.T) {
 	r := &Resource[*mockClient]{}
 	r.Init(nil)
@@ -228,6 +259,42 @@ func TestSequentialDeleter_AccumulatesErrors(t *testing.T) {
 	assert.NoError(t, results[2].Error)
 }
 
+func TestResource_Suspend(t *testing.T) {
+	actor := SequentialActor("Stopping", func(ctx context.Context, client *mockClient, id *string) (string, error) {
+		if *id == "fail" {
+			return "", errors.New("stop failed")
+		}
+		return "stopped " + *id, nil
+	})
+	r := &Resource[*mockClient]{ResourceTypeName: "test", Suspender: actor, Resumer: actor}
+	r.Init(nil)
+
+	require.True(t, r.CanSuspend())
+	results, err := r.Suspend(context.Background(), []string{"id-1", "fail"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stop failed")
+	require.Len(t, results, 2)
+	assert.Equal(t, "stopped id-1", results[0].Detail)
+	assert.NoError(t, results[0].Error)
+	assert.Error(t, results[1].Error)
+}
+
+func TestResource_Suspend_Unsupported(t *testing.T) {
+	r := &Resource[*mockClient]{ResourceTypeName: "test"}
+
+	assert.False(t, r.CanSuspend())
+	_, err := r.Suspend(context.Background(), []string{"id-1"})
+	require.Error(t, err)
+	_, err = r.Resume(context.Background(), []string{"id-1"})
+	require.Error(t, err)
+
+	// Nothing to do is not an error
+	results, err := r.Resume(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, results)
+}
+
 func TestMultiStepDeleter(t *testing.T) {
 	steps := []string{}
 	deleter := MultiStepDeleter(
@@ -0,0 +1,181 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/go-commons/telemetry"
+)
+
+// DefaultMixpanelEndpoint is Gruntwork's telemetry collector, used by the default backend.
+const DefaultMixpanelEndpoint = "https://t.gruntwork.io/"
+
+// httpBackendTimeout bounds how long a single telemetry POST may block a command.
+const httpBackendTimeout = 5 * time.Second
+
+// Record is the fully-resolved form of an Event that is handed to a Backend.
+// It carries the run metadata shared by every event in a single invocation.
+type Record struct {
+	RunID      string                 `json:"run_id"`
+	Timestamp  time.Time              `json:"timestamp"`
+	App        string                 `json:"app"`
+	Version    string                 `json:"version"`
+	Command    string                 `json:"command"`
+	Event      string                 `json:"event"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Backend delivers telemetry records to a destination.
+// Implementations must be safe for concurrent use.
+type Backend interface {
+	// Track sends a single record. Errors are logged by the caller and never fail a command.
+	Track(record Record) error
+	// Destination returns a human-readable description of where records are sent.
+	Destination() string
+	// Close flushes pending records and releases the backend's resources. It is called once, when
+	// the command ends.
+	Close() error
+}
+
+// MixpanelBackend sends records to a Mixpanel-compatible collector. This is the default backend.
+type MixpanelBackend struct {
+	url     string
+	tracker telemetry.MixpanelTelemetryTracker
+}
+
+// NewMixpanelBackend creates a backend that sends events to the Mixpanel collector at url.
+func NewMixpanelBackend(url string, app string, version string) *MixpanelBackend {
+	return &MixpanelBackend{
+		url:     url,
+		tracker: telemetry.NewMixPanelTelemetryClient(url, app, version),
+	}
+}
+
+// Track implements Backend.
+func (b *MixpanelBackend) Track(record Record) error {
+	b.tracker.TrackEvent(telemetry.EventContext{
+		Command:   record.Command,
+		EventName: record.Event,
+	}, record.Properties)
+	return nil
+}
+
+// Close implements Backend. Mixpanel events are sent as they are tracked.
+func (b *MixpanelBackend) Close() error {
+	return nil
+}
+
+// Destination implements Backend.
+func (b *MixpanelBackend) Destination() string {
+	if b.url == DefaultMixpanelEndpoint {
+		return "Gruntwork"
+	}
+	return b.url
+}
+
+// FileBackend appends records to a local file as JSON lines.
+type FileBackend struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileBackend creates a backend that appends JSON-encoded records, one per line, to path.
+// The file is created if it does not exist.
+func NewFileBackend(path string) (*FileBackend, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileBackend{path: path, file: file}, nil
+}
+
+// Track implements Backend.
+func (b *FileBackend) Track(record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, err = b.file.Write(append(line, '\n'))
+	return err
+}
+
+// Destination implements Backend.
+func (b *FileBackend) Destination() string {
+	return b.path
+}
+
+// Close implements Backend by syncing and closing the file.
+func (b *FileBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.file.Sync(); err != nil {
+		_ = b.file.Close()
+		return err
+	}
+	return b.file.Close()
+}
+
+// HTTPBackend POSTs each record as a JSON document to an arbitrary endpoint.
+type HTTPBackend struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewHTTPBackend creates a backend that POSTs JSON-encoded records to url.
+// The given headers (e.g. an Authorization token) are added to every request.
+func NewHTTPBackend(url string, headers map[string]string) *HTTPBackend {
+	return &HTTPBackend{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: httpBackendTimeout},
+	}
+}
+
+// Track implements Backend.
+func (b *HTTPBackend) Track(record Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, b.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range b.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("telemetry endpoint %s returned %s", b.url, resp.Status)
+	}
+	return nil
+}
+
+// Close implements Backend. HTTP records are sent as they are tracked.
+func (b *HTTPBackend) Close() error {
+	return nil
+}
+
+// Destination implements Backend.
+func (b *HTTPBackend) Destination() string {
+	return b.url
+}
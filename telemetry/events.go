@@ -0,0 +1,196 @@
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+// Event is a single telemetry event. Name identifies the event; the remaining
+// fields are attached as properties when set, so every backend receives the
+// same structured shape regardless of how it serializes it.
+type Event struct {
+	Name         string
+	ResourceType string
+	Region       string
+	ErrorClass   string
+	Properties   map[string]interface{}
+}
+
+// NewEvent creates an event with the given name and no extra properties.
+func NewEvent(name string) Event {
+	return Event{Name: name}
+}
+
+// With returns a copy of the event with the given property set.
+func (e Event) With(key string, value interface{}) Event {
+	props := make(map[string]interface{}, len(e.Properties)+1)
+	for k, v := range e.Properties {
+		props[k] = v
+	}
+	props[key] = value
+	e.Properties = props
+	return e
+}
+
+// properties flattens the typed fields and free-form properties into a single map.
+func (e Event) properties() map[string]interface{} {
+	props := make(map[string]interface{}, len(e.Properties)+3)
+	for k, v := range e.Properties {
+		props[k] = v
+	}
+	if e.ResourceType != "" {
+		props["resourceType"] = e.ResourceType
+	}
+	if e.Region != "" {
+		props["region"] = e.Region
+	}
+	if e.ErrorClass != "" {
+		props["errorClass"] = e.ErrorClass
+	}
+	return props
+}
+
+// CommandStarted is tracked when a CLI command begins.
+func CommandStarted(command string) Event {
+	return NewEvent("Start " + command)
+}
+
+// CommandEnded is tracked when a CLI command returns.
+func CommandEnded(command string) Event {
+	return NewEvent("End " + command)
+}
+
+// GetIdentifiersError is tracked when listing a resource type fails in a region.
+func GetIdentifiersError(resourceType string, region string, err error) Event {
+	return Event{
+		Name:         "error:GetIdentifiers:" + resourceType,
+		ResourceType: resourceType,
+		Region:       region,
+		ErrorClass:   ErrorClass(err),
+	}
+}
+
+// GetIdentifiersDone is tracked after a resource type has been listed successfully.
+func GetIdentifiersDone(resourceType string, region string, recordCount int, actionTime time.Duration) Event {
+	return Event{
+		Name:         fmt.Sprintf("Done getting %s identifiers", resourceType),
+		ResourceType: resourceType,
+		Region:       region,
+		Properties: map[string]interface{}{
+			"recordCount": recordCount,
+			"actionTime":  actionTime.Seconds(),
+		},
+	}
+}
+
+// NukeError is tracked when deleting a batch of a resource type fails in a region.
+func NukeError(resourceType string, region string, err error) Event {
+	return Event{
+		Name:         "error:Nuke:" + resourceType,
+		ResourceType: resourceType,
+		Region:       region,
+		ErrorClass:   ErrorClass(err),
+	}
+}
+
+// RegionEvent creates a named event scoped to a region.
+func RegionEvent(name string, region string) Event {
+	return Event{Name: name, Region: region}
+}
+
+// Initialized is tracked once the CLI is set up.
+func Initialized() Event {
+	return NewEvent("initialized")
+}
+
+// ConfigRead is tracked before the config file is read.
+func ConfigRead() Event {
+	return NewEvent("Reading config file")
+}
+
+// ConfigReadError is tracked when the config file cannot be read.
+func ConfigReadError() Event {
+	return NewEvent("Error reading config file")
+}
+
+// GetResourcesError is tracked when listing the resources to nuke fails.
+func GetResourcesError() Event {
+	return NewEvent("Error getting resources")
+}
+
+// InspectResourcesError is tracked when listing the resources to inspect fails.
+func InspectResourcesError() Event {
+	return NewEvent("Error inspecting resources")
+}
+
+// NoResourcesToNuke is tracked when a nuke command finds nothing to nuke.
+func NoResourcesToNuke() Event {
+	return NewEvent("No resources to nuke")
+}
+
+// DryRunSkipped is tracked when --dry-run skips nuking.
+func DryRunSkipped() Event {
+	return NewEvent("Skipping nuke, dryrun set")
+}
+
+// NukeConfirmationAwaited is tracked when the user is prompted to confirm nuking.
+func NukeConfirmationAwaited() Event {
+	return NewEvent("Awaiting nuke confirmation")
+}
+
+// NukeConfirmationError is tracked when the confirmation prompt fails.
+func NukeConfirmationError() Event {
+	return NewEvent("Error confirming nuke")
+}
+
+// NukeAborted is tracked when the user declines to nuke.
+func NukeAborted() Event {
+	return NewEvent("User aborted nuke")
+}
+
+// NukeForced is tracked when --force skips the confirmation prompt.
+func NukeForced() Event {
+	return NewEvent("Forcing nuke in 10 seconds")
+}
+
+// NukeBegan is tracked when nuking starts.
+func NukeBegan() Event {
+	return NewEvent("Begin nuking resources")
+}
+
+// RegionNukeStarted is tracked when nuking starts in a region.
+func RegionNukeStarted(region string) Event {
+	return RegionEvent("Creating session for region", region)
+}
+
+// RegionNukeDone is tracked when nuking is done in a region with resourceCount resource types.
+func RegionNukeDone(region string, resourceCount int) Event {
+	return RegionEvent("Done Nuking Region", region).With("resourceCount", resourceCount)
+}
+
+// ErrorClass returns a short, non-identifying classification of an error
+// suitable for aggregation: the API error code for cloud API errors, or the
+// Go type of the innermost error otherwise. Error messages are never sent
+// because they may contain resource names or account details.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			break
+		}
+		err = next
+	}
+	return fmt.Sprintf("%T", err)
+}
@@ -1,39 +1,193 @@
 package telemetry
 
 import (
+	"fmt"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/gruntwork-io/go-commons/telemetry"
+	"github.com/google/uuid"
+	"github.com/gruntwork-io/cloud-nuke/logging"
 )
 
-var sendTelemetry = true
-var telemetryClient telemetry.MixpanelTelemetryTracker
-var cmd = ""
-var isCircleCi = false
-var account = ""
+// Environment variables that control where telemetry is sent.
+const (
+	// EnvDisableTelemetry disables telemetry entirely when set to any value.
+	EnvDisableTelemetry = "DISABLE_TELEMETRY"
+	// EnvTelemetryBackend selects the backend: "mixpanel" (default), "file" or "http".
+	EnvTelemetryBackend = "CLOUD_NUKE_TELEMETRY_BACKEND"
+	// EnvTelemetryFile is the path written by the "file" backend.
+	EnvTelemetryFile = "CLOUD_NUKE_TELEMETRY_FILE"
+	// EnvTelemetryEndpoint is the URL used by the "http" backend, or an alternate Mixpanel collector.
+	EnvTelemetryEndpoint = "CLOUD_NUKE_TELEMETRY_ENDPOINT"
+	// EnvTelemetryHeaders is a comma-separated list of key=value headers sent by the "http" backend.
+	EnvTelemetryHeaders = "CLOUD_NUKE_TELEMETRY_HEADERS"
+)
+
+// Backend names accepted by EnvTelemetryBackend.
+const (
+	BackendMixpanel = "mixpanel"
+	BackendFile     = "file"
+	BackendHTTP     = "http"
+)
+
+var (
+	mu         sync.RWMutex
+	backend    Backend
+	app        = ""
+	appVersion = ""
+	runID      = ""
+	cmd        = ""
+	isCircleCi = false
+	account    = ""
+)
 
+// InitTelemetry configures the telemetry backend from the environment.
+// Telemetry is sent to Gruntwork's Mixpanel collector unless DISABLE_TELEMETRY is set
+// or CLOUD_NUKE_TELEMETRY_BACKEND selects a different backend. A misconfigured backend
+// disables telemetry with a warning rather than failing the command.
 func InitTelemetry(name string, version string) {
-	_, disableTelemetryFlag := os.LookupEnv("DISABLE_TELEMETRY")
+	mu.Lock()
+	defer mu.Unlock()
+
+	app = name
+	appVersion = version
+	runID = uuid.New().String()
 	isCircleCi = os.Getenv("CIRCLECI") == "true"
-	sendTelemetry = !disableTelemetryFlag
-	if sendTelemetry {
-		telemetryClient = telemetry.NewMixPanelTelemetryClient("https://t.gruntwork.io/", name, version)
-	}
 	if len(os.Args) > 1 {
 		cmd = os.Args[1]
 	}
+
+	backend = nil
+	if _, disabled := os.LookupEnv(EnvDisableTelemetry); disabled {
+		return
+	}
+
+	b, err := backendFromEnv(name, version)
+	if err != nil {
+		logging.Warnf("Telemetry disabled: %v", err)
+		return
+	}
+	backend = b
+}
+
+// backendFromEnv builds the Backend selected by the CLOUD_NUKE_TELEMETRY_* environment variables.
+func backendFromEnv(name string, version string) (Backend, error) {
+	endpoint := os.Getenv(EnvTelemetryEndpoint)
+
+	switch kind := strings.ToLower(os.Getenv(EnvTelemetryBackend)); kind {
+	case "", BackendMixpanel:
+		if endpoint == "" {
+			endpoint = DefaultMixpanelEndpoint
+		}
+		return NewMixpanelBackend(endpoint, name, version), nil
+	case BackendFile:
+		path := os.Getenv(EnvTelemetryFile)
+		if path == "" {
+			return nil, fmt.Errorf("%s=%s requires %s to be set", EnvTelemetryBackend, kind, EnvTelemetryFile)
+		}
+		return NewFileBackend(path)
+	case BackendHTTP:
+		if endpoint == "" {
+			return nil, fmt.Errorf("%s=%s requires %s to be set", EnvTelemetryBackend, kind, EnvTelemetryEndpoint)
+		}
+		headers, err := parseHeaders(os.Getenv(EnvTelemetryHeaders))
+		if err != nil {
+			return nil, err
+		}
+		return NewHTTPBackend(endpoint, headers), nil
+	default:
+		return nil, fmt.Errorf("unknown %s %q (expected %s, %s or %s)", EnvTelemetryBackend, kind, BackendMixpanel, BackendFile, BackendHTTP)
+	}
+}
+
+// parseHeaders parses a comma-separated list of key=value pairs.
+func parseHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	if value == "" {
+		return headers, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid %s entry %q: expected key=value", EnvTelemetryHeaders, pair)
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers, nil
+}
+
+// SetBackend replaces the telemetry backend. Pass nil to disable telemetry.
+// This is useful when importing cloud-nuke as a library and routing events
+// to an in-process sink. It should be called after InitTelemetry, if at all.
+func SetBackend(b Backend) {
+	mu.Lock()
+	defer mu.Unlock()
+	backend = b
+}
+
+// Destination describes where telemetry is sent, or returns an empty string if telemetry is disabled.
+func Destination() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if backend == nil {
+		return ""
+	}
+	return backend.Destination()
+}
+
+// RunID returns the identifier of this run, which is attached to every telemetry record.
+func RunID() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return runID
 }
 
 func SetAccountId(accountId string) {
+	mu.Lock()
+	defer mu.Unlock()
 	account = accountId
 }
 
-func TrackEvent(ctx telemetry.EventContext, extraProperties map[string]interface{}) {
-	if sendTelemetry {
-		ctx.Command = cmd
-		extraProperties["isCircleCi"] = isCircleCi
-		extraProperties["accountId"] = account
-		telemetryClient.TrackEvent(ctx, extraProperties)
+// Track sends an event to the configured backend. It is a no-op if telemetry is disabled.
+func Track(event Event) {
+	mu.RLock()
+	b := backend
+	record := Record{
+		RunID:      runID,
+		Timestamp:  time.Now(),
+		App:        app,
+		Version:    appVersion,
+		Command:    cmd,
+		Event:      event.Name,
+		Properties: event.properties(),
+	}
+	record.Properties["isCircleCi"] = isCircleCi
+	record.Properties["accountId"] = account
+	mu.RUnlock()
+
+	if b == nil {
+		return
+	}
+	if err := b.Track(record); err != nil {
+		logging.Debugf("Failed to send telemetry event %q to %s: %v", event.Name, b.Destination(), err)
+	}
+}
+
+// Close closes the configured backend, flushing the records it buffers, and disables telemetry.
+// It is called once the command has ended.
+func Close() {
+	mu.Lock()
+	b := backend
+	backend = nil
+	mu.Unlock()
+
+	if b == nil {
+		return
+	}
+	if err := b.Close(); err != nil {
+		logging.Debugf("Failed to close telemetry backend %s: %v", b.Destination(), err)
 	}
 }
 
@@ -46,13 +200,9 @@ func TrackEvent(ctx telemetry.EventContext, extraProperties map[string]interface
 //	    // ... command implementation
 //	}
 func TrackCommandLifecycle(commandName string) func() {
-	TrackEvent(telemetry.EventContext{
-		EventName: "Start " + commandName,
-	}, map[string]interface{}{})
+	Track(CommandStarted(commandName))
 
 	return func() {
-		TrackEvent(telemetry.EventContext{
-			EventName: "End " + commandName,
-		}, map[string]interface{}{})
+		Track(CommandEnded(commandName))
 	}
 }
@@ -0,0 +1,190 @@
+package telemetry
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIdentifiersErrorEvent(t *testing.T) {
+	err := fmt.Errorf("ec2: failed to list resources in us-east-1: %w",
+		&smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "not allowed"})
+
+	event := GetIdentifiersError("ec2", "us-east-1", err)
+
+	require.Equal(t, "error:GetIdentifiers:ec2", event.Name)
+	require.Equal(t, map[string]interface{}{
+		"resourceType": "ec2",
+		"region":       "us-east-1",
+		"errorClass":   "UnauthorizedOperation",
+	}, event.properties())
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected string
+	}{
+		"nil":       {err: nil, expected: ""},
+		"api error": {err: &smithy.GenericAPIError{Code: "ThrottlingException"}, expected: "ThrottlingException"},
+		"wrapped":   {err: fmt.Errorf("outer: %w", errors.New("inner")), expected: "*errors.errorString"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ErrorClass(tc.err))
+		})
+	}
+}
+
+func TestEventWithDoesNotMutateOriginal(t *testing.T) {
+	base := RegionEvent("Done Nuking Region", "us-west-2")
+	extended := base.With("resourceCount", 3)
+
+	require.Nil(t, base.Properties)
+	require.Equal(t, 3, extended.properties()["resourceCount"])
+	require.Equal(t, "us-west-2", extended.properties()["region"])
+}
+
+func TestFileBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.jsonl")
+	backend, err := NewFileBackend(path)
+	require.NoError(t, err)
+
+	require.NoError(t, backend.Track(Record{Event: "first", Properties: map[string]interface{}{"region": "us-east-1"}}))
+	require.NoError(t, backend.Track(Record{Event: "second"}))
+	require.NoError(t, backend.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var events []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		events = append(events, record.Event)
+	}
+	require.Equal(t, []string{"first", "second"}, events)
+}
+
+func TestHTTPBackend(t *testing.T) {
+	var received Record
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	backend := NewHTTPBackend(server.URL, map[string]string{"Authorization": "Bearer token"})
+	require.NoError(t, backend.Track(Record{Event: "error:Nuke:vpc", Command: "aws"}))
+
+	require.Equal(t, "Bearer token", authHeader)
+	require.Equal(t, "error:Nuke:vpc", received.Event)
+	require.Equal(t, "aws", received.Command)
+}
+
+func TestHTTPBackendErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	backend := NewHTTPBackend(server.URL, nil)
+	require.Error(t, backend.Track(Record{Event: "initialized"}))
+}
+
+func TestBackendFromEnv(t *testing.T) {
+	t.Run("default is mixpanel", func(t *testing.T) {
+		t.Setenv(EnvTelemetryBackend, "")
+		t.Setenv(EnvTelemetryEndpoint, "")
+		b, err := backendFromEnv("cloud-nuke", "v1")
+		require.NoError(t, err)
+		require.IsType(t, &MixpanelBackend{}, b)
+		require.Equal(t, "Gruntwork", b.Destination())
+	})
+
+	t.Run("file requires path", func(t *testing.T) {
+		t.Setenv(EnvTelemetryBackend, BackendFile)
+		t.Setenv(EnvTelemetryFile, "")
+		_, err := backendFromEnv("cloud-nuke", "v1")
+		require.Error(t, err)
+	})
+
+	t.Run("http with headers", func(t *testing.T) {
+		t.Setenv(EnvTelemetryBackend, "HTTP")
+		t.Setenv(EnvTelemetryEndpoint, "https://telemetry.example.com/events")
+		t.Setenv(EnvTelemetryHeaders, "Authorization=Bearer abc, X-Team=platform")
+		b, err := backendFromEnv("cloud-nuke", "v1")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"Authorization": "Bearer abc", "X-Team": "platform"}, b.(*HTTPBackend).headers)
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		t.Setenv(EnvTelemetryBackend, "syslog")
+		_, err := backendFromEnv("cloud-nuke", "v1")
+		require.Error(t, err)
+	})
+}
+
+type recordingBackend struct {
+	records []Record
+}
+
+func (b *recordingBackend) Track(record Record) error {
+	b.records = append(b.records, record)
+	return nil
+}
+
+func (b *recordingBackend) Destination() string { return "memory" }
+func (b *recordingBackend) Close() error        { return nil }
+
+func TestTrackAddsRunMetadata(t *testing.T) {
+	rec := &recordingBackend{}
+	SetBackend(rec)
+	defer SetBackend(nil)
+	SetAccountId("123456789012")
+	defer SetAccountId("")
+
+	Track(NukeError("s3", "global", errors.New("boom")))
+
+	require.Len(t, rec.records, 1)
+	require.Equal(t, "error:Nuke:s3", rec.records[0].Event)
+	require.Equal(t, "123456789012", rec.records[0].Properties["accountId"])
+	require.Equal(t, "global", rec.records[0].Properties["region"])
+}
+
+type closingBackend struct {
+	recordingBackend
+	closed int
+}
+
+func (b *closingBackend) Close() error {
+	b.closed++
+	return nil
+}
+
+func TestCloseDisablesBackend(t *testing.T) {
+	b := &closingBackend{}
+	SetBackend(b)
+	defer SetBackend(nil)
+
+	Close()
+	Close()
+	Track(Initialized())
+	require.Equal(t, 1, b.closed)
+	require.Empty(t, b.records)
+	require.Empty(t, Destination())
+}
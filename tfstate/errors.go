@@ -0,0 +1,12 @@
+package tfstate
+
+import "fmt"
+
+// UnsupportedStateVersionError is returned for state files written by Terraform versions older than 0.12.
+type UnsupportedStateVersionError struct {
+	Version int
+}
+
+func (err UnsupportedStateVersionError) Error() string {
+	return fmt.Sprintf("unsupported state version %d: only version 4 state files and `terraform show -json` output are supported", err.Version)
+}
@@ -0,0 +1,135 @@
+// Package tfstate reads the resources managed by Terraform from state files, so that cloud-nuke can
+// protect them. Both the raw state format written by Terraform (version 4) and the output of
+// `terraform show -json` are supported.
+package tfstate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// identifierAttributes are the resource attributes whose values are matched against the identifiers
+// returned by listers: the Terraform resource ID, which is usually the cloud provider's ID or name,
+// and the ARN.
+var identifierAttributes = []string{"id", "arn"}
+
+// rawState is the subset of the raw state format that records managed resources.
+type rawState struct {
+	Version   int           `json:"version"`
+	Resources []rawResource `json:"resources"`
+}
+
+type rawResource struct {
+	Module    string        `json:"module"`
+	Mode      string        `json:"mode"`
+	Type      string        `json:"type"`
+	Name      string        `json:"name"`
+	Instances []rawInstance `json:"instances"`
+}
+
+type rawInstance struct {
+	IndexKey   any            `json:"index_key"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// showState is the subset of the `terraform show -json` output that records managed resources.
+type showState struct {
+	Values *struct {
+		RootModule showModule `json:"root_module"`
+	} `json:"values"`
+}
+
+type showModule struct {
+	Resources    []showResource `json:"resources"`
+	ChildModules []showModule   `json:"child_modules"`
+}
+
+type showResource struct {
+	Address string         `json:"address"`
+	Mode    string         `json:"mode"`
+	Values  map[string]any `json:"values"`
+}
+
+// Load reads the given state files and returns the address of the managed resource behind every
+// identifier they record. Data sources are skipped, since Terraform does not manage them.
+func Load(paths []string) (map[string]string, error) {
+	addresses := make(map[string]string)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := parse(data, addresses); err != nil {
+			return nil, fmt.Errorf("parsing Terraform state %s: %w", path, err)
+		}
+	}
+	return addresses, nil
+}
+
+func parse(data []byte, addresses map[string]string) error {
+	var show showState
+	if err := json.Unmarshal(data, &show); err != nil {
+		return err
+	}
+	if show.Values != nil {
+		addShowModule(show.Values.RootModule, addresses)
+		return nil
+	}
+
+	var raw rawState
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Version != 4 {
+		return UnsupportedStateVersionError{Version: raw.Version}
+	}
+	for _, resource := range raw.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			addIdentifiers(rawAddress(resource, instance), instance.Attributes, addresses)
+		}
+	}
+	return nil
+}
+
+func addShowModule(module showModule, addresses map[string]string) {
+	for _, resource := range module.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		addIdentifiers(resource.Address, resource.Values, addresses)
+	}
+	for _, child := range module.ChildModules {
+		addShowModule(child, addresses)
+	}
+}
+
+func addIdentifiers(address string, attributes map[string]any, addresses map[string]string) {
+	for _, name := range identifierAttributes {
+		if value, ok := attributes[name].(string); ok && value != "" {
+			addresses[value] = address
+		}
+	}
+}
+
+// rawAddress builds the resource address, e.g. module.network.aws_subnet.private["a"], that
+// Terraform itself would print for a resource instance.
+func rawAddress(resource rawResource, instance rawInstance) string {
+	parts := []string{resource.Type + "." + resource.Name}
+	if resource.Module != "" {
+		parts = append([]string{resource.Module}, parts...)
+	}
+	address := strings.Join(parts, ".")
+
+	switch key := instance.IndexKey.(type) {
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	}
+	return address
+}
@@ -0,0 +1,107 @@
+package tfstate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rawStateJSON = `{
+  "version": 4,
+  "terraform_version": "1.9.0",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "instances": [{"attributes": {"id": "my-logs", "arn": "arn:aws:s3:::my-logs"}}]
+    },
+    {
+      "module": "module.network",
+      "mode": "managed",
+      "type": "aws_subnet",
+      "name": "private",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "subnet-0", "arn": "arn:aws:ec2:us-east-1:123456789012:subnet/subnet-0"}},
+        {"index_key": "b", "attributes": {"id": "subnet-b"}}
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "aws_vpc",
+      "name": "default",
+      "instances": [{"attributes": {"id": "vpc-default"}}]
+    }
+  ]
+}`
+
+const showStateJSON = `{
+  "format_version": "1.0",
+  "values": {
+    "root_module": {
+      "resources": [
+        {"address": "aws_iam_role.ci", "mode": "managed", "values": {"id": "ci", "arn": "arn:aws:iam::123456789012:role/ci"}},
+        {"address": "data.aws_caller_identity.current", "mode": "data", "values": {"id": "123456789012"}}
+      ],
+      "child_modules": [
+        {
+          "resources": [
+            {"address": "module.db.aws_db_instance.main", "mode": "managed", "values": {"id": "db-main", "arn": ""}}
+          ]
+        }
+      ]
+    }
+  }
+}`
+
+func writeState(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadRawState(t *testing.T) {
+	addresses, err := Load([]string{writeState(t, "terraform.tfstate", rawStateJSON)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"my-logs":              "aws_s3_bucket.logs",
+		"arn:aws:s3:::my-logs": "aws_s3_bucket.logs",
+		"subnet-0":             "module.network.aws_subnet.private[0]",
+		"arn:aws:ec2:us-east-1:123456789012:subnet/subnet-0": "module.network.aws_subnet.private[0]",
+		"subnet-b": `module.network.aws_subnet.private["b"]`,
+	}, addresses)
+}
+
+func TestLoadShowJSON(t *testing.T) {
+	addresses, err := Load([]string{writeState(t, "show.json", showStateJSON)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"ci":                                "aws_iam_role.ci",
+		"arn:aws:iam::123456789012:role/ci": "aws_iam_role.ci",
+		"db-main":                           "module.db.aws_db_instance.main",
+	}, addresses)
+}
+
+func TestLoadMultipleFiles(t *testing.T) {
+	addresses, err := Load([]string{
+		writeState(t, "raw.tfstate", rawStateJSON),
+		writeState(t, "show.json", showStateJSON),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "aws_s3_bucket.logs", addresses["my-logs"])
+	assert.Equal(t, "aws_iam_role.ci", addresses["ci"])
+}
+
+func TestLoadRejectsUnsupportedState(t *testing.T) {
+	_, err := Load([]string{writeState(t, "old.tfstate", `{"version": 3, "modules": []}`)})
+	require.ErrorAs(t, err, &UnsupportedStateVersionError{})
+
+	_, err = Load([]string{writeState(t, "bad.tfstate", `not json`)})
+	require.Error(t, err)
+
+	_, err = Load([]string{filepath.Join(t.TempDir(), "missing.tfstate")})
+	require.Error(t, err)
+}
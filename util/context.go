@@ -3,6 +3,7 @@ package util
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // ContextKey is a custom type to avoid collisions when using context.WithValue
@@ -37,3 +38,37 @@ func GetParallelism(ctx context.Context) int {
 	}
 	return DefaultParallelism
 }
+
+// ErrInterrupted is the cause of the context canceled when cloud-nuke receives SIGINT or SIGTERM.
+var ErrInterrupted = errors.New("interrupted by signal")
+
+// InterruptGracePeriod is how long a batch of deletions that is in flight when its context is canceled
+// may keep running before it is canceled too.
+const InterruptGracePeriod = 2 * time.Minute
+
+// WithGracePeriod returns a context with the values of ctx that is canceled grace after ctx is, so
+// that calls in flight can finish or time out instead of being aborted at once. The returned cancel
+// function must be called once the work is done.
+func WithGracePeriod(ctx context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	graceCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(ctx, func() {
+		timer := time.AfterFunc(grace, cancel)
+		context.AfterFunc(graceCtx, func() { timer.Stop() })
+	})
+	return graceCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// Sleep pauses for d, or until ctx is canceled. It returns false if ctx was canceled.
+func Sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
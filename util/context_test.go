@@ -0,0 +1,45 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithGracePeriod(t *testing.T) {
+	t.Parallel()
+	parent, cancelParent := context.WithCancel(context.WithValue(context.Background(), ParallelismKey, 3))
+	ctx, cancel := WithGracePeriod(parent, 50*time.Millisecond)
+	defer cancel()
+
+	require.Equal(t, 3, GetParallelism(ctx))
+
+	// Work in flight keeps running for the grace period after the parent is canceled
+	cancelParent()
+	require.NoError(t, ctx.Err())
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context was not canceled after the grace period")
+	}
+}
+
+func TestWithGracePeriod_Cancel(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := WithGracePeriod(context.Background(), time.Hour)
+	cancel()
+	require.Error(t, ctx.Err())
+}
+
+func TestSleep(t *testing.T) {
+	t.Parallel()
+	require.True(t, Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	require.False(t, Sleep(ctx, time.Minute))
+	require.Less(t, time.Since(start), time.Second)
+}
@@ -65,6 +65,25 @@ func (err ResourceExecutionTimeout) Error() string {
 	return fmt.Sprintf("execution timed out after: %v", err.Timeout)
 }
 
+// ProtectedResourceError marks a resource that must not be nuked, e.g. because Terraform manages it.
+type ProtectedResourceError struct {
+	Reason string
+}
+
+func (err ProtectedResourceError) Error() string {
+	return err.Reason
+}
+
+// SkippedResourceError marks a resource that an action left untouched, e.g. because resume found
+// nothing to restore. It is reported as skipped rather than as a failure.
+type SkippedResourceError struct {
+	Reason string
+}
+
+func (err SkippedResourceError) Error() string {
+	return err.Reason
+}
+
 // IsThrottlingError checks if the error is an AWS API throttling error
 // using structured error code matching via smithy.APIError.
 func IsThrottlingError(err error) bool {